@@ -8,9 +8,9 @@ import (
 	"crypto/ecdsa"
 	"errors"
 	"fmt"
-	"log"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/OCharless/eth-interfaces/customerrors"
 	"github.com/OCharless/eth-interfaces/inferences/IERC165"
@@ -24,16 +24,21 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
 
 // BaseInteractions holds the context, client, sender address, private key, disperse contract, and explorer URL.
 type BaseInteractions struct {
-	Ctx      context.Context
-	Client   simulated.Client
-	Address  common.Address
-	pk       *ecdsa.PrivateKey
-	disperse *Disperse.Disperse
-	explorer *string
+	Ctx             context.Context
+	Client          simulated.Client
+	Address         common.Address
+	pk              *ecdsa.PrivateKey
+	disperse        *Disperse.Disperse
+	disperseAddress common.Address
+	explorer        *Explorer
+	observer        Observer
+	mineTimeout     time.Duration
 }
 
 // IBaseInteractions defines the interface for verifying transactions.
@@ -41,43 +46,58 @@ type IBaseInteractions interface {
 	VerifyTransaction(ctx context.Context, to common.Address, data []byte, value int64) error
 }
 
-// NewBaseInteractions creates a new instance of BaseInteractions for blockchain interaction.
-func NewBaseInteractions(client simulated.Client, pk *ecdsa.PrivateKey, explorer *string) *BaseInteractions {
-	ctx := context.TODO()
-	_, err := client.BlockNumber(ctx)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	publicKey := pk.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		log.Fatal("error casting public key to ECDSA")
-	}
+// DefaultMineTimeout is how long CatchTx and WaitMined wait for a transaction to be mined unless SetMineTimeout is called.
+const DefaultMineTimeout = 5 * time.Minute
 
-	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
-	return &BaseInteractions{ctx, client, fromAddress, pk, nil, explorer}
+// NewBaseInteractions creates a new instance of BaseInteractions for blockchain interaction.
+// No RPC call is made: an unreachable client is reported by the first call going through it.
+func NewBaseInteractions(client simulated.Client, pk *ecdsa.PrivateKey, explorer *Explorer) *BaseInteractions {
+	fromAddress := crypto.PubkeyToAddress(pk.PublicKey)
+	return &BaseInteractions{context.TODO(), client, fromAddress, pk, nil, common.Address{}, explorer, nil, DefaultMineTimeout}
 }
 
 // SetDisperse initializes the disperse contract for multi-address fund transfers.
 func (b *BaseInteractions) SetDisperse(address string) error {
 	var err error
-	b.disperse, err = Disperse.NewDisperse(common.HexToAddress(address), b.Client)
+	b.disperseAddress = common.HexToAddress(address)
+	b.disperse, err = Disperse.NewDisperse(b.disperseAddress, b.Client)
 	return err
 }
 
+// DisperseAddress returns the address of the disperse contract, the zero address when it is not initialized.
+func (b *BaseInteractions) DisperseAddress() common.Address {
+	return b.disperseAddress
+}
+
+// Explorer returns the block explorer of the chain, nil when none is set.
+func (b *BaseInteractions) Explorer() *Explorer {
+	return b.explorer
+}
+
+// SetExplorer sets the block explorer used to link the transactions and contracts, such as ExplorerForChain(chainID).
+func (b *BaseInteractions) SetExplorer(explorer *Explorer) {
+	b.explorer = explorer
+}
+
+// SetMineTimeout sets how long CatchTx and WaitMined wait for a transaction to be mined before giving up on it.
+func (b *BaseInteractions) SetMineTimeout(timeout time.Duration) {
+	b.mineTimeout = timeout
+}
+
 // BaseTxSetup sets up transaction options (nonce, gas price, chain ID, etc.) for sending a transaction.
 func (b *BaseInteractions) BaseTxSetup() (*bind.TransactOpts, error) {
-	gasPrice, err := b.Client.SuggestGasPrice(b.Ctx)
+	gasPrice, err := b.suggestGasPrice()
 	if err != nil {
 		return nil, fmt.Errorf("failed to suggest gas price: %v", err)
 	}
-	nonce, err := b.Client.PendingNonceAt(b.Ctx, b.Address)
+	nonce, err := Observe(b, ReadOperation, "base.PendingNonceAt()", func() (uint64, error) {
+		return b.Client.PendingNonceAt(b.Ctx, b.Address)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user nonce: %v", err)
 	}
 
-	chainID, err := b.Client.ChainID(b.Ctx)
+	chainID, err := b.chainID()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get chain ID: %v", err)
 	}
@@ -102,20 +122,47 @@ func (b *BaseInteractions) BaseCallSetup() *bind.CallOpts {
 	}
 }
 
-// CatchTx waits for a transaction to be mined and returns its hash or an error message.
+// SignTypedData signs EIP-712 typed data with the account key. The recovery id of the signature is 27 or 28, as contracts expect it.
+func (b *BaseInteractions) SignTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash typed data: %w", err)
+	}
+	signature, err := crypto.Sign(hash, b.pk)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// CatchTx waits for a transaction to be mined and returns its explorer link, its hash when no explorer is set, or an error message.
+// The wait is bounded by the mine timeout: a transaction that is still pending then, such as an underpriced one, is reported
+// with an error wrapping context.DeadlineExceeded and can be bumped through SpeedUp or handed to a TxWatcher.
+// ErrNonceAlreadyUsed is returned when another transaction, such as a replacement, was mined with its nonce.
 func (b *BaseInteractions) CatchTx(tx *types.Transaction, err error) (string, error) {
 	if err != nil {
 		return FailedTx(err)
 	}
-	receipt, err := bind.WaitMined(context.Background(), b.Client, tx)
-	if receipt == nil {
-		return FailedTx(err)
+	receipt, err := b.WaitMined(tx)
+	if err != nil {
+		return FailedTx(fmt.Errorf("failed to wait for tx %s: %w", tx.Hash().Hex(), err))
 	}
 	if b.explorer != nil {
-		return SuccessTx(fmt.Sprintf(*b.explorer, "/tx/", receipt.TxHash.Hex()))
+		return SuccessTx(b.explorer.TxURL(receipt.TxHash))
 	}
 	return SuccessTx(receipt.TxHash.Hex())
+}
 
+// WaitMined waits for a transaction to be mined and returns its receipt, reverted or not. Like CatchTx, the wait is bounded
+// by the mine timeout and ErrNonceAlreadyUsed is returned when another transaction was mined with its nonce.
+func (b *BaseInteractions) WaitMined(tx *types.Transaction) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(b.Ctx, b.mineTimeout)
+	defer cancel()
+	return Observe(b, ReadOperation, "base.WaitMined()", func() (*types.Receipt, error) {
+		_, receipt, err := b.WaitReplaced(ctx, DefaultWatcherConfig.PollInterval, tx)
+		return receipt, err
+	})
 }
 
 // VerifyTransaction simulates a contract call to verify transaction validity.
@@ -127,35 +174,74 @@ func (b *BaseInteractions) VerifyTransaction(ctx context.Context, to common.Addr
 		Value: big.NewInt(value),
 	}
 
-	_, err := b.Client.CallContract(ctx, callMsg, nil)
+	_, err := Observe(b, SimulationOperation, "base.VerifyTransaction()", func() ([]byte, error) {
+		return b.Client.CallContract(ctx, callMsg, nil)
+	})
 	return err
 }
 
 // Disperse uses the disperse contract to send funds to multiple addresses.
+// It is limited to values fitting a uint, DisperseEther takes any value.
 func (b *BaseInteractions) Disperse(addresses []common.Address, totalValue uint) (string, error) {
+	return b.DisperseEther(addresses, new(big.Int).SetUint64(uint64(totalValue)))
+}
+
+// DisperseEther uses the disperse contract to split totalValue of ether evenly between multiple addresses.
+// The whole value is sent with the transaction and the disperse contract refunds the remainder of the division.
+func (b *BaseInteractions) DisperseEther(addresses []common.Address, totalValue *big.Int) (string, error) {
+	if b.disperse == nil {
+		return FailedTx(fmt.Errorf("disperse contract not initialized"))
+	}
+	if len(addresses) == 0 {
+		return FailedTx(fmt.Errorf("no address to disperse to"))
+	}
+	opts, err := b.BaseTxSetup()
+	if err != nil {
+		return FailedTx(err)
+	}
+	amount := new(big.Int).Div(totalValue, big.NewInt(int64(len(addresses))))
+	amounts := []*big.Int{}
+	for range addresses {
+		amounts = append(amounts, amount)
+	}
+	opts.Value = totalValue
+	return b.CatchTx(Observe(b, SendOperation, "base.DisperseEther()", func() (*types.Transaction, error) {
+		return b.disperse.DisperseEther(opts, addresses, amounts)
+	}))
+}
+
+// DisperseToken uses the disperse contract to split totalValue of token evenly between multiple addresses.
+// The disperse contract must be allowed to transfer totalValue of the token from the account beforehand.
+func (b *BaseInteractions) DisperseToken(token common.Address, addresses []common.Address, totalValue *big.Int) (string, error) {
 	if b.disperse == nil {
 		return FailedTx(fmt.Errorf("disperse contract not initialized"))
 	}
+	if len(addresses) == 0 {
+		return FailedTx(fmt.Errorf("no address to disperse to"))
+	}
 	opts, err := b.BaseTxSetup()
 	if err != nil {
 		return FailedTx(err)
 	}
+	amount := new(big.Int).Div(totalValue, big.NewInt(int64(len(addresses))))
 	amounts := []*big.Int{}
 	for range addresses {
-		amounts = append(amounts, big.NewInt(int64(totalValue)/int64(len(addresses))))
+		amounts = append(amounts, amount)
 	}
-	opts.Value = big.NewInt(int64(totalValue))
-	fmt.Println("Dispersing...")
-	return b.CatchTx(b.disperse.DisperseEther(opts, addresses, amounts))
+	return b.CatchTx(Observe(b, SendOperation, "base.DisperseToken()", func() (*types.Transaction, error) {
+		return b.disperse.DisperseToken(opts, token, addresses, amounts)
+	}))
 }
 
 // SendAllFunds transfers the entire balance to a designated address after fee estimation.
 func (b *BaseInteractions) SendAllFunds(to common.Address) (*types.Transaction, error) {
-	bn, err := b.Client.BlockNumber(b.Ctx)
+	bn, err := Observe(b, ReadOperation, "base.BlockNumber()", func() (uint64, error) {
+		return b.Client.BlockNumber(b.Ctx)
+	})
 	if err != nil {
 		return nil, err
 	}
-	balance, err := b.Client.BalanceAt(b.Ctx, b.Address, big.NewInt(int64(bn)))
+	balance, err := b.balanceAt(b.Address, new(big.Int).SetUint64(bn))
 	if err != nil {
 		return nil, err
 	}
@@ -167,23 +253,26 @@ func (b *BaseInteractions) SendAllFunds(to common.Address) (*types.Transaction,
 		Data:  nil,
 	}
 
-	gasLimit, err := b.Client.EstimateGas(b.Ctx, msg)
+	gasLimit, err := Observe(b, SimulationOperation, "base.EstimateGas()", func() (uint64, error) {
+		return b.Client.EstimateGas(b.Ctx, msg)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	gasPrice, err := b.Client.SuggestGasPrice(context.Background())
+	gasPrice, err := b.suggestGasPrice()
 	if err != nil {
 		return nil, err
 	}
 
-	value := big.NewInt(0).Add(balance, big.NewInt(int64(gasLimit*gasPrice.Uint64())))
-	if value.Int64() < balance.Int64() {
-		return b.TransferETH(to, value)
+	fees := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasLimit))
+	value := new(big.Int).Sub(balance, fees)
+	if value.Sign() > 0 {
+		return b.sendETH(to, value, gasLimit, gasPrice, "base.SendAllFunds()")
 	}
 	return nil, fmt.Errorf(
 		"fees exceed balances\nfees : %f ETH\nbalance : %f ETH",
-		utils.ParseEther(big.NewInt(0).Sub(balance, value)),
+		utils.ParseEther(fees),
 		utils.ParseEther(balance),
 	)
 }
@@ -191,19 +280,21 @@ func (b *BaseInteractions) SendAllFunds(to common.Address) (*types.Transaction,
 // TransferETH transfers Ether to the specified address, ensuring sufficient balance and proper fee estimation.
 func (b *BaseInteractions) TransferETH(to common.Address, value *big.Int) (*types.Transaction, error) {
 
-	balance, err := b.Client.BalanceAt(b.Ctx, to, nil)
+	balance, err := b.balanceAt(to, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	msg := ethereum.CallMsg{From: b.Address, To: &to, Value: balance, Data: nil}
 
-	gasLimit, err := b.Client.EstimateGas(b.Ctx, msg)
+	gasLimit, err := Observe(b, SimulationOperation, "base.EstimateGas()", func() (uint64, error) {
+		return b.Client.EstimateGas(b.Ctx, msg)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	gasPrice, err := b.Client.SuggestGasPrice(context.Background())
+	gasPrice, err := b.suggestGasPrice()
 	if err != nil {
 		return nil, err
 	}
@@ -217,7 +308,14 @@ func (b *BaseInteractions) TransferETH(to common.Address, value *big.Int) (*type
 		)
 	}
 
-	nonce, err := b.Client.PendingNonceAt(context.Background(), b.Address)
+	return b.sendETH(to, value, gasLimit, gasPrice, "base.TransferETH()")
+}
+
+// sendETH signs and broadcasts a legacy Ether transfer with the given gas limit and price.
+func (b *BaseInteractions) sendETH(to common.Address, value *big.Int, gasLimit uint64, gasPrice *big.Int, method string) (*types.Transaction, error) {
+	nonce, err := Observe(b, ReadOperation, "base.PendingNonceAt()", func() (uint64, error) {
+		return b.Client.PendingNonceAt(b.Ctx, b.Address)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -231,7 +329,7 @@ func (b *BaseInteractions) TransferETH(to common.Address, value *big.Int) (*type
 		GasPrice: gasPrice,
 	})
 	// Get the chain ID
-	chainID, err := b.Client.ChainID(b.Ctx)
+	chainID, err := b.chainID()
 	if err != nil {
 		return nil, err
 	}
@@ -243,7 +341,9 @@ func (b *BaseInteractions) TransferETH(to common.Address, value *big.Int) (*type
 	}
 
 	// Broadcast the transaction
-	err = b.Client.SendTransaction(context.Background(), signedTx)
+	_, err = Observe(b, SendOperation, method, func() (*types.Transaction, error) {
+		return signedTx, b.Client.SendTransaction(context.Background(), signedTx)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to send the tx: %w", err)
 	}
@@ -251,6 +351,25 @@ func (b *BaseInteractions) TransferETH(to common.Address, value *big.Int) (*type
 	return signedTx, nil
 }
 
+// suggestGasPrice, chainID and balanceAt run the RPC reads shared by the transaction setups through Observe.
+func (b *BaseInteractions) suggestGasPrice() (*big.Int, error) {
+	return Observe(b, ReadOperation, "base.SuggestGasPrice()", func() (*big.Int, error) {
+		return b.Client.SuggestGasPrice(b.Ctx)
+	})
+}
+
+func (b *BaseInteractions) chainID() (*big.Int, error) {
+	return Observe(b, ReadOperation, "base.ChainID()", func() (*big.Int, error) {
+		return b.Client.ChainID(b.Ctx)
+	})
+}
+
+func (b *BaseInteractions) balanceAt(account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return Observe(b, ReadOperation, "base.BalanceAt()", func() (*big.Int, error) {
+		return b.Client.BalanceAt(b.Ctx, account, blockNumber)
+	})
+}
+
 // SupportsInterface checks if a contract supports a specific interface.
 func (b *BaseInteractions) SupportsInterface(address common.Address, signature [4]byte) (bool, error) {
 	ierc165, err := IERC165.NewIERC165(address, b.Client)
@@ -259,20 +378,69 @@ func (b *BaseInteractions) SupportsInterface(address common.Address, signature [
 	}
 
 	callopts := b.BaseCallSetup()
-	return ierc165.SupportsInterface(callopts, signature)
+	return Observe(b, ReadOperation, "base.SupportsInterface()", func() (bool, error) {
+		return ierc165.SupportsInterface(callopts, signature)
+	})
+}
+
+// ERC165GasLimit is the maximum amount of gas a supportsInterface call may use according to ERC-165.
+const ERC165GasLimit = 30_000
+
+// SupportsERC165 runs the ERC-165 compliance check on a contract: within a 30000 gas cap,
+// supportsInterface(0x01ffc9a7) must return true and supportsInterface(0xffffffff) must return false.
+func (b *BaseInteractions) SupportsERC165(address common.Address) (bool, error) {
+	supported, err := b.SupportsInterfaceWithGasCap(address, utils.IERC165_INTERFACE_ID)
+	if err != nil || !supported {
+		return false, err
+	}
+	supported, err = b.SupportsInterfaceWithGasCap(address, utils.INVALID_INTERFACE_ID)
+	if err != nil {
+		return false, err
+	}
+	return !supported, nil
+}
+
+// SupportsInterfaceWithGasCap calls supportsInterface the way ERC-165 requires: with a 30000 gas cap, reporting false when the call fails.
+// An error is only returned when the node could not run the call.
+func (b *BaseInteractions) SupportsInterfaceWithGasCap(address common.Address, interfaceID [4]byte) (bool, error) {
+	data, err := utils.GetEncodedFunction(IERC165.IERC165ABI, "supportsInterface", interfaceID)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := Observe(b, ReadOperation, "base.SupportsInterface()", func() ([]byte, error) {
+		return b.Client.CallContract(b.Ctx, ethereum.CallMsg{
+			From: b.Address,
+			To:   &address,
+			Gas:  ERC165GasLimit,
+			Data: data,
+		}, nil)
+	})
+	if err != nil {
+		var rpcErr rpc.Error
+		if errors.As(err, &rpcErr) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to call supportsInterface: %w", err)
+	}
+	if len(result) < 32 {
+		return false, nil
+	}
+	return new(big.Int).SetBytes(result[:32]).Cmp(common.Big1) == 0, nil
 }
 
 // CheckSignatures checks if a contract supports specific function signatures.
 func (b *BaseInteractions) CheckSignatures(contractAddress common.Address, signatures []utils.Signature) error {
-	byteCode, err := b.Client.CodeAt(b.Ctx, contractAddress, nil)
+	byteCode, err := Observe(b, ReadOperation, "base.CheckSignatures()", func() ([]byte, error) {
+		return b.Client.CodeAt(b.Ctx, contractAddress, nil)
+	})
 	if err != nil {
 		return fmt.Errorf("failed to get contract bytecode: %w", err)
 	}
 	notSupported := ""
-	byteCodeHex := common.Bytes2Hex(byteCode)
 	for _, signature := range signatures {
 		selector := utils.GetFunctionSelector(signature)
-		if !strings.Contains(byteCodeHex, selector) {
+		if !utils.ContainsSelector(byteCode, signature) {
 			if notSupported != "" {
 				notSupported = fmt.Sprintf("%s, %s: %s", notSupported, signature, selector)
 			} else {
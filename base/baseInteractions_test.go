@@ -0,0 +1,94 @@
+package base_test
+
+// Package base_test contains tests for the base interactions.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/OCharless/eth-interfaces/inferences/ERC721Complete"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_NewBaseInteractions verifies that an unreachable client is reported as an error by the calls instead of stopping the process.
+func Test_NewBaseInteractions(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	// Nothing listens on port 1, every call fails to connect.
+	client, err := ethclient.Dial("http://127.0.0.1:1")
+	assert.Nil(t, err)
+	defer client.Close()
+
+	baseInteractions := base.NewBaseInteractions(client, privKey, nil)
+	assert.Equal(t, crypto.PubkeyToAddress(privKey.PublicKey), baseInteractions.Address)
+	_, err = baseInteractions.BaseTxSetup()
+	assert.Error(t, err)
+}
+
+// Test_SupportsERC165 verifies the ERC-165 compliance check against compliant and non compliant contracts.
+func Test_SupportsERC165(t *testing.T) {
+	backend, auth, erc721Addr, privKey, err := utils.SetupBlockchain(t,
+		ERC721Complete.ERC721CompleteABI,
+		ERC721Complete.ERC721CompleteBin,
+		"MyNFT",
+		"MNFT",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer backend.Close()
+
+	emptyContract, err := utils.DeployEmptyContract(auth, backend)
+	if err != nil {
+		t.Fatalf("failed to deploy empty contract: %s", err)
+	}
+
+	erc20Addr, tx, _, err := utils.DeployContract(auth, backend.Client(), ERC20Burnable.ERC20BurnableABI, ERC20Burnable.ERC20BurnableBin)
+	if err != nil {
+		t.Fatalf("failed to deploy ERC20 contract: %s", err)
+	}
+	backend.Commit()
+	receipt, err := backend.Client().TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil || receipt.Status != 1 {
+		t.Fatalf("failed to deploy ERC20 contract: %s", err)
+	}
+
+	testCases := []struct {
+		Name           string
+		ContractAddr   common.Address
+		ExpectedResult bool
+	}{
+		{
+			Name:           "OK - ERC721 is compliant",
+			ContractAddr:   *erc721Addr,
+			ExpectedResult: true,
+		},
+		{
+			Name:         "OK - ERC20 without supportsInterface",
+			ContractAddr: erc20Addr,
+		},
+		{
+			Name:         "OK - Empty contract",
+			ContractAddr: *emptyContract,
+		},
+		{
+			Name:         "OK - No contract",
+			ContractAddr: common.HexToAddress("1"),
+		},
+	}
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			compliant, err := baseInteractions.SupportsERC165(tt.ContractAddr)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.ExpectedResult, compliant)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package base
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Explorer builds the links of a block explorer from URL templates, in which {hash}, {address}, {id} and {number}
+// are replaced by the transaction hash, the account or token address, the token id and the block number.
+// Links are empty when their template is, or when the explorer is nil.
+type Explorer struct {
+	Name    string
+	Tx      string
+	Address string
+	Token   string
+	TokenID string
+	Block   string
+}
+
+// NewExplorer returns the templates of an Etherscan-like explorer hosted at baseURL.
+func NewExplorer(name, baseURL string) *Explorer {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	return &Explorer{
+		Name:    name,
+		Tx:      baseURL + "/tx/{hash}",
+		Address: baseURL + "/address/{address}",
+		Token:   baseURL + "/token/{address}",
+		TokenID: baseURL + "/nft/{address}/{id}",
+		Block:   baseURL + "/block/{number}",
+	}
+}
+
+// NewBlockscout returns the templates of a Blockscout explorer hosted at baseURL.
+func NewBlockscout(name, baseURL string) *Explorer {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	return &Explorer{
+		Name:    name,
+		Tx:      baseURL + "/tx/{hash}",
+		Address: baseURL + "/address/{address}",
+		Token:   baseURL + "/token/{address}",
+		TokenID: baseURL + "/token/{address}/instance/{id}",
+		Block:   baseURL + "/block/{number}",
+	}
+}
+
+// Explorers holds the explorer presets of common chains, keyed by chain ID.
+var Explorers = map[uint64]*Explorer{
+	1:        NewExplorer("Etherscan", "https://etherscan.io"),
+	11155111: NewExplorer("Etherscan Sepolia", "https://sepolia.etherscan.io"),
+	17000:    NewExplorer("Etherscan Holesky", "https://holesky.etherscan.io"),
+	10:       NewExplorer("Optimism Etherscan", "https://optimistic.etherscan.io"),
+	56:       NewExplorer("BscScan", "https://bscscan.com"),
+	100:      NewBlockscout("Gnosis Blockscout", "https://gnosis.blockscout.com"),
+	137:      NewExplorer("PolygonScan", "https://polygonscan.com"),
+	8453:     NewExplorer("BaseScan", "https://basescan.org"),
+	84532:    NewExplorer("BaseScan Sepolia", "https://sepolia.basescan.org"),
+	42161:    NewExplorer("Arbiscan", "https://arbiscan.io"),
+	421614:   NewExplorer("Arbiscan Sepolia", "https://sepolia.arbiscan.io"),
+	43114:    NewExplorer("Snowtrace", "https://snowtrace.io"),
+	59144:    NewExplorer("LineaScan", "https://lineascan.build"),
+	534352:   NewExplorer("Scrollscan", "https://scrollscan.com"),
+}
+
+// ExplorerForChain returns the explorer preset of a chain, nil when there is none.
+func ExplorerForChain(chainID *big.Int) *Explorer {
+	if chainID == nil || !chainID.IsUint64() {
+		return nil
+	}
+	return Explorers[chainID.Uint64()]
+}
+
+// TxURL returns the link of a transaction.
+func (e *Explorer) TxURL(hash common.Hash) string {
+	if e == nil {
+		return ""
+	}
+	return expand(e.Tx, "{hash}", hash.Hex())
+}
+
+// AddressURL returns the link of an account or a contract.
+func (e *Explorer) AddressURL(address common.Address) string {
+	if e == nil {
+		return ""
+	}
+	return expand(e.Address, "{address}", address.Hex())
+}
+
+// TokenURL returns the link of a token contract.
+func (e *Explorer) TokenURL(token common.Address) string {
+	if e == nil {
+		return ""
+	}
+	return expand(e.Token, "{address}", token.Hex())
+}
+
+// TokenIDURL returns the link of a token of an ERC721 or ERC1155 contract.
+func (e *Explorer) TokenIDURL(token common.Address, id *big.Int) string {
+	if e == nil || id == nil {
+		return ""
+	}
+	return expand(e.TokenID, "{address}", token.Hex(), "{id}", id.String())
+}
+
+// BlockURL returns the link of a block.
+func (e *Explorer) BlockURL(number *big.Int) string {
+	if e == nil || number == nil {
+		return ""
+	}
+	return expand(e.Block, "{number}", number.String())
+}
+
+func expand(template string, replacements ...string) string {
+	if template == "" {
+		return ""
+	}
+	return strings.NewReplacer(replacements...).Replace(template)
+}
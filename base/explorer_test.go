@@ -0,0 +1,74 @@
+package base_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Explorer verifies the links built from the explorer templates.
+func Test_Explorer(t *testing.T) {
+	hash := common.HexToHash("0xabc")
+	token := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	id := big.NewInt(42)
+
+	testCases := []struct {
+		Name     string
+		Explorer *base.Explorer
+		Expected []string
+	}{
+		{
+			Name:     "OK - Etherscan preset",
+			Explorer: base.ExplorerForChain(big.NewInt(1)),
+			Expected: []string{
+				"https://etherscan.io/tx/" + hash.Hex(),
+				"https://etherscan.io/address/" + token.Hex(),
+				"https://etherscan.io/token/" + token.Hex(),
+				"https://etherscan.io/nft/" + token.Hex() + "/42",
+				"https://etherscan.io/block/42",
+			},
+		},
+		{
+			Name:     "OK - Blockscout",
+			Explorer: base.NewBlockscout("Test", "https://blockscout.test/"),
+			Expected: []string{
+				"https://blockscout.test/tx/" + hash.Hex(),
+				"https://blockscout.test/address/" + token.Hex(),
+				"https://blockscout.test/token/" + token.Hex(),
+				"https://blockscout.test/token/" + token.Hex() + "/instance/42",
+				"https://blockscout.test/block/42",
+			},
+		},
+		{
+			Name:     "OK - Custom templates",
+			Explorer: &base.Explorer{Tx: "https://scan.test/transaction/{hash}", TokenID: "https://scan.test/{address}?id={id}"},
+			Expected: []string{
+				"https://scan.test/transaction/" + hash.Hex(),
+				"",
+				"",
+				"https://scan.test/" + token.Hex() + "?id=42",
+				"",
+			},
+		},
+		{
+			Name:     "OK - Unknown chain",
+			Explorer: base.ExplorerForChain(big.NewInt(1337)),
+			Expected: []string{"", "", "", "", ""},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.Expected, []string{
+				tc.Explorer.TxURL(hash),
+				tc.Explorer.AddressURL(token),
+				tc.Explorer.TokenURL(token),
+				tc.Explorer.TokenIDURL(token, id),
+				tc.Explorer.BlockURL(id),
+			})
+		})
+	}
+}
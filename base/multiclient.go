@@ -0,0 +1,491 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+)
+
+var (
+	// ErrNoQuorum is returned when not enough endpoints agree on the result of a critical read.
+	ErrNoQuorum = errors.New("endpoints did not reach quorum")
+	// ErrChainIDMismatch is returned when an endpoint reports another chain ID than the other endpoints.
+	ErrChainIDMismatch = errors.New("endpoints report different chain IDs")
+)
+
+// MultiClientConfig configures the failover and consistency rules of a MultiClient.
+type MultiClientConfig struct {
+	// MaxBlockLag is the number of blocks an endpoint may be behind the highest head before it is considered unhealthy.
+	MaxBlockLag uint64
+	// HealthCheckInterval is the delay between two background health checks, zero disables them.
+	HealthCheckInterval time.Duration
+	// Quorum is the number of endpoints that must return the same result for critical reads, one or less disables it.
+	Quorum int
+}
+
+// endpoint tracks the state of a single RPC endpoint.
+type endpoint struct {
+	client  simulated.Client
+	healthy bool
+	head    uint64
+	chainID *big.Int
+	lastErr error
+}
+
+// MultiClient implements simulated.Client over several endpoints of the same chain.
+// Reads are spread round-robin over healthy endpoints and fail over on errors, critical reads (balances, nonces, code,
+// storage and calls) can require a quorum, and transactions are broadcast to every endpoint.
+type MultiClient struct {
+	mu        sync.RWMutex
+	endpoints []*endpoint
+	chainID   *big.Int
+	next      atomic.Uint64
+	config    MultiClientConfig
+	stop      chan struct{}
+	stopOnce  sync.Once
+}
+
+var _ simulated.Client = (*MultiClient)(nil)
+
+// NewMultiClient creates a MultiClient over the given clients, runs a first health check and starts the background checks if configured.
+// It fails with ErrChainIDMismatch when the endpoints do not all report the same chain ID.
+func NewMultiClient(config MultiClientConfig, clients ...simulated.Client) (*MultiClient, error) {
+	if len(clients) == 0 {
+		return nil, errors.New("at least one client is required")
+	}
+	if config.Quorum > len(clients) {
+		return nil, fmt.Errorf("quorum of %d cannot be reached with %d clients", config.Quorum, len(clients))
+	}
+
+	m := &MultiClient{config: config, stop: make(chan struct{})}
+	for _, client := range clients {
+		m.endpoints = append(m.endpoints, &endpoint{client: client, healthy: true})
+	}
+	if err := m.CheckHealth(context.Background()); err != nil {
+		return nil, err
+	}
+
+	if config.HealthCheckInterval > 0 {
+		go func() {
+			ticker := time.NewTicker(config.HealthCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-m.stop:
+					return
+				case <-ticker.C:
+					_ = m.CheckHealth(context.Background())
+				}
+			}
+		}()
+	}
+	return m, nil
+}
+
+// Close stops the background health checks.
+func (m *MultiClient) Close() {
+	m.stopOnce.Do(func() { close(m.stop) })
+}
+
+// CheckHealth queries the head and the chain ID of every endpoint and marks as unhealthy the ones failing or lagging behind
+// the highest head. The chain ID of the first endpoint to report one becomes the chain ID of the client: endpoints reporting
+// another one are marked as unhealthy, left out of reads and broadcasts, and fail the check with ErrChainIDMismatch.
+// An error is also returned when no endpoint is healthy.
+func (m *MultiClient) CheckHealth(ctx context.Context) error {
+	heads := make([]uint64, len(m.endpoints))
+	chainIDs := make([]*big.Int, len(m.endpoints))
+	errs := make([]error, len(m.endpoints))
+	var wg sync.WaitGroup
+	for idx, e := range m.endpoints {
+		wg.Add(1)
+		go func(idx int, client simulated.Client) {
+			defer wg.Done()
+			heads[idx], errs[idx] = client.BlockNumber(ctx)
+			if errs[idx] == nil {
+				chainIDs[idx], errs[idx] = client.ChainID(ctx)
+			}
+		}(idx, e.client)
+	}
+	wg.Wait()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var mismatches []error
+	for idx, e := range m.endpoints {
+		if chainIDs[idx] == nil {
+			continue
+		}
+		e.chainID = chainIDs[idx]
+		if m.chainID == nil {
+			m.chainID = chainIDs[idx]
+		}
+		if chainIDs[idx].Cmp(m.chainID) != 0 {
+			errs[idx] = fmt.Errorf("%w: endpoint %d is on chain %s instead of %s", ErrChainIDMismatch, idx, chainIDs[idx], m.chainID)
+			mismatches = append(mismatches, errs[idx])
+		}
+	}
+
+	var best uint64
+	for idx := range m.endpoints {
+		if errs[idx] == nil && heads[idx] > best {
+			best = heads[idx]
+		}
+	}
+
+	healthy := 0
+	for idx, e := range m.endpoints {
+		e.head, e.lastErr = heads[idx], errs[idx]
+		e.healthy = errs[idx] == nil && best-heads[idx] <= m.config.MaxBlockLag
+		if e.healthy {
+			healthy++
+		}
+	}
+	if len(mismatches) > 0 {
+		return errors.Join(mismatches...)
+	}
+	if healthy == 0 {
+		return fmt.Errorf("no healthy endpoint: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// onChain tells whether the endpoint is not known to be on another chain than the client. The lock must be held.
+func (m *MultiClient) onChain(e *endpoint) bool {
+	return e.chainID == nil || m.chainID == nil || e.chainID.Cmp(m.chainID) == 0
+}
+
+// HealthyEndpoints returns the indexes, in the order clients were given, of the endpoints currently considered healthy.
+func (m *MultiClient) HealthyEndpoints() []int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	healthy := []int{}
+	for idx, e := range m.endpoints {
+		if e.healthy {
+			healthy = append(healthy, idx)
+		}
+	}
+	return healthy
+}
+
+// candidates returns the endpoints to use for a read, healthy ones first starting from the round-robin position.
+// Endpoints on another chain are left out.
+func (m *MultiClient) candidates() []*endpoint {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	start := int(m.next.Add(1) % uint64(len(m.endpoints)))
+	healthy, unhealthy := []*endpoint{}, []*endpoint{}
+	for offset := range m.endpoints {
+		e := m.endpoints[(start+offset)%len(m.endpoints)]
+		if !m.onChain(e) {
+			continue
+		}
+		if e.healthy {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+// markFailed flags the endpoint as unhealthy until the next successful health check.
+func (m *MultiClient) markFailed(e *endpoint, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e.healthy = false
+	e.lastErr = err
+}
+
+// isTerminal tells whether an error comes from the request itself rather than from the endpoint, so that failing over is pointless.
+func isTerminal(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, ethereum.NotFound) {
+		return true
+	}
+	if _, reverted := ethclient.RevertErrorData(err); reverted {
+		return true
+	}
+	return strings.Contains(err.Error(), "execution reverted")
+}
+
+// read runs fn on the endpoints in round-robin order until one succeeds.
+func read[T any](m *MultiClient, ctx context.Context, fn func(simulated.Client) (T, error)) (T, error) {
+	var errs []error
+	for _, e := range m.candidates() {
+		result, err := fn(e.client)
+		if err == nil {
+			return result, nil
+		}
+		if isTerminal(ctx, err) {
+			return result, err
+		}
+		m.markFailed(e, err)
+		errs = append(errs, err)
+	}
+	var zero T
+	return zero, fmt.Errorf("all endpoints failed: %w", errors.Join(errs...))
+}
+
+// quorumRead runs fn on every healthy endpoint and returns the result shared by at least Quorum of them.
+// Without quorum configured it falls back to read.
+func quorumRead[T any](m *MultiClient, ctx context.Context, fn func(simulated.Client) (T, error), key func(T) string) (T, error) {
+	var zero T
+	if m.config.Quorum <= 1 {
+		return read(m, ctx, fn)
+	}
+
+	endpoints := m.candidates()
+	results := make([]T, len(endpoints))
+	errs := make([]error, len(endpoints))
+	var wg sync.WaitGroup
+	for idx, e := range endpoints {
+		wg.Add(1)
+		go func(idx int, client simulated.Client) {
+			defer wg.Done()
+			results[idx], errs[idx] = fn(client)
+		}(idx, e.client)
+	}
+	wg.Wait()
+
+	votes := map[string]int{}
+	for idx, e := range endpoints {
+		if errs[idx] != nil {
+			if isTerminal(ctx, errs[idx]) {
+				votes["error:"+errs[idx].Error()]++
+				if votes["error:"+errs[idx].Error()] >= m.config.Quorum {
+					return zero, errs[idx]
+				}
+				continue
+			}
+			m.markFailed(e, errs[idx])
+			continue
+		}
+		k := key(results[idx])
+		votes[k]++
+		if votes[k] >= m.config.Quorum {
+			return results[idx], nil
+		}
+	}
+	return zero, fmt.Errorf("%w: %d required, errors: %v", ErrNoQuorum, m.config.Quorum, errors.Join(errs...))
+}
+
+func bigKey(value *big.Int) string { return value.String() }
+func bytesKey(value []byte) string { return common.Bytes2Hex(value) }
+func uintKey(value uint64) string  { return fmt.Sprint(value) }
+
+// BlockNumber returns the most recent block number.
+func (m *MultiClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return read(m, ctx, func(c simulated.Client) (uint64, error) { return c.BlockNumber(ctx) })
+}
+
+// BlockByHash returns the block with the given hash.
+func (m *MultiClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return read(m, ctx, func(c simulated.Client) (*types.Block, error) { return c.BlockByHash(ctx, hash) })
+}
+
+// BlockByNumber returns the block with the given number, nil meaning the latest block.
+func (m *MultiClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return read(m, ctx, func(c simulated.Client) (*types.Block, error) { return c.BlockByNumber(ctx, number) })
+}
+
+// HeaderByHash returns the header of the block with the given hash.
+func (m *MultiClient) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return read(m, ctx, func(c simulated.Client) (*types.Header, error) { return c.HeaderByHash(ctx, hash) })
+}
+
+// HeaderByNumber returns the header of the block with the given number, nil meaning the latest block.
+func (m *MultiClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return read(m, ctx, func(c simulated.Client) (*types.Header, error) { return c.HeaderByNumber(ctx, number) })
+}
+
+// TransactionCount returns the number of transactions in the given block.
+func (m *MultiClient) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
+	return read(m, ctx, func(c simulated.Client) (uint, error) { return c.TransactionCount(ctx, blockHash) })
+}
+
+// TransactionInBlock returns the transaction at the given index of a block.
+func (m *MultiClient) TransactionInBlock(ctx context.Context, blockHash common.Hash, index uint) (*types.Transaction, error) {
+	return read(m, ctx, func(c simulated.Client) (*types.Transaction, error) { return c.TransactionInBlock(ctx, blockHash, index) })
+}
+
+// SubscribeNewHead subscribes to new block headers on the first healthy endpoint.
+func (m *MultiClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return read(m, ctx, func(c simulated.Client) (ethereum.Subscription, error) { return c.SubscribeNewHead(ctx, ch) })
+}
+
+// BalanceAt returns the balance of the account, agreed on by a quorum of endpoints when configured.
+func (m *MultiClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return quorumRead(m, ctx, func(c simulated.Client) (*big.Int, error) { return c.BalanceAt(ctx, account, blockNumber) }, bigKey)
+}
+
+// StorageAt returns the value of a storage slot, agreed on by a quorum of endpoints when configured.
+func (m *MultiClient) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	return quorumRead(m, ctx, func(c simulated.Client) ([]byte, error) { return c.StorageAt(ctx, account, key, blockNumber) }, bytesKey)
+}
+
+// CodeAt returns the code of the account, agreed on by a quorum of endpoints when configured.
+func (m *MultiClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return quorumRead(m, ctx, func(c simulated.Client) ([]byte, error) { return c.CodeAt(ctx, account, blockNumber) }, bytesKey)
+}
+
+// NonceAt returns the nonce of the account, agreed on by a quorum of endpoints when configured.
+func (m *MultiClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return quorumRead(m, ctx, func(c simulated.Client) (uint64, error) { return c.NonceAt(ctx, account, blockNumber) }, uintKey)
+}
+
+// CallContract executes a message call, agreed on by a quorum of endpoints when configured.
+func (m *MultiClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return quorumRead(m, ctx, func(c simulated.Client) ([]byte, error) { return c.CallContract(ctx, call, blockNumber) }, bytesKey)
+}
+
+// EstimateGas estimates the gas needed to execute the message.
+func (m *MultiClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return read(m, ctx, func(c simulated.Client) (uint64, error) { return c.EstimateGas(ctx, call) })
+}
+
+// SuggestGasPrice returns the gas price suggested by an endpoint.
+func (m *MultiClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return read(m, ctx, func(c simulated.Client) (*big.Int, error) { return c.SuggestGasPrice(ctx) })
+}
+
+// SuggestGasTipCap returns the priority fee suggested by an endpoint.
+func (m *MultiClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return read(m, ctx, func(c simulated.Client) (*big.Int, error) { return c.SuggestGasTipCap(ctx) })
+}
+
+// FeeHistory returns the fee market history.
+func (m *MultiClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	return read(m, ctx, func(c simulated.Client) (*ethereum.FeeHistory, error) {
+		return c.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+	})
+}
+
+// FilterLogs executes a log filter query.
+func (m *MultiClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return read(m, ctx, func(c simulated.Client) ([]types.Log, error) { return c.FilterLogs(ctx, q) })
+}
+
+// SubscribeFilterLogs subscribes to the results of a log filter query on the first healthy endpoint.
+func (m *MultiClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return read(m, ctx, func(c simulated.Client) (ethereum.Subscription, error) { return c.SubscribeFilterLogs(ctx, q, ch) })
+}
+
+// PendingBalanceAt returns the balance of the account in the pending state.
+func (m *MultiClient) PendingBalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
+	return read(m, ctx, func(c simulated.Client) (*big.Int, error) { return c.PendingBalanceAt(ctx, account) })
+}
+
+// PendingStorageAt returns the value of a storage slot in the pending state.
+func (m *MultiClient) PendingStorageAt(ctx context.Context, account common.Address, key common.Hash) ([]byte, error) {
+	return read(m, ctx, func(c simulated.Client) ([]byte, error) { return c.PendingStorageAt(ctx, account, key) })
+}
+
+// PendingCodeAt returns the code of the account in the pending state.
+func (m *MultiClient) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return read(m, ctx, func(c simulated.Client) ([]byte, error) { return c.PendingCodeAt(ctx, account) })
+}
+
+// PendingNonceAt returns the highest nonce of the account seen by the endpoints, pending transactions included.
+// Since pools are not shared, every endpoint is asked so that no pending transaction gets its nonce reused.
+func (m *MultiClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	var (
+		highest uint64
+		errs    []error
+		success bool
+	)
+	for _, e := range m.candidates() {
+		nonce, err := e.client.PendingNonceAt(ctx, account)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		success = true
+		if nonce > highest {
+			highest = nonce
+		}
+	}
+	if !success {
+		return 0, fmt.Errorf("all endpoints failed: %w", errors.Join(errs...))
+	}
+	return highest, nil
+}
+
+// PendingTransactionCount returns the number of transactions in the pending block.
+func (m *MultiClient) PendingTransactionCount(ctx context.Context) (uint, error) {
+	return read(m, ctx, func(c simulated.Client) (uint, error) { return c.PendingTransactionCount(ctx) })
+}
+
+// PendingCallContract executes a message call against the pending state.
+func (m *MultiClient) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	return read(m, ctx, func(c simulated.Client) ([]byte, error) { return c.PendingCallContract(ctx, call) })
+}
+
+// TransactionByHash returns the transaction with the given hash.
+func (m *MultiClient) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	type result struct {
+		tx        *types.Transaction
+		isPending bool
+	}
+	r, err := read(m, ctx, func(c simulated.Client) (result, error) {
+		tx, isPending, err := c.TransactionByHash(ctx, txHash)
+		return result{tx, isPending}, err
+	})
+	return r.tx, r.isPending, err
+}
+
+// TransactionReceipt returns the receipt of a mined transaction.
+func (m *MultiClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return read(m, ctx, func(c simulated.Client) (*types.Receipt, error) { return c.TransactionReceipt(ctx, txHash) })
+}
+
+// SendTransaction broadcasts the transaction to every endpoint of the chain. It succeeds as soon as one endpoint accepts it,
+// endpoints already knowing the transaction count as accepting it.
+func (m *MultiClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	m.mu.RLock()
+	endpoints := []*endpoint{}
+	for _, e := range m.endpoints {
+		if m.onChain(e) {
+			endpoints = append(endpoints, e)
+		}
+	}
+	m.mu.RUnlock()
+
+	errs := make([]error, len(endpoints))
+	var wg sync.WaitGroup
+	for idx, e := range endpoints {
+		wg.Add(1)
+		go func(idx int, client simulated.Client) {
+			defer wg.Done()
+			errs[idx] = client.SendTransaction(ctx, tx)
+		}(idx, e.client)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err == nil || strings.Contains(err.Error(), "already known") {
+			return nil
+		}
+	}
+	return fmt.Errorf("no endpoint accepted the tx: %w", errors.Join(errs...))
+}
+
+// ChainID returns the chain ID the endpoints agreed on in the health checks, asking them when none reported it yet.
+func (m *MultiClient) ChainID(ctx context.Context) (*big.Int, error) {
+	m.mu.RLock()
+	chainID := m.chainID
+	m.mu.RUnlock()
+	if chainID != nil {
+		return new(big.Int).Set(chainID), nil
+	}
+	return read(m, ctx, func(c simulated.Client) (*big.Int, error) { return c.ChainID(ctx) })
+}
@@ -0,0 +1,166 @@
+package base_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupBackends starts one simulated chain per balance, funding the same account with the given balance in ether.
+func setupBackends(t *testing.T, balances ...int64) ([]*simulated.Backend, []simulated.Client, *ecdsa.PrivateKey) {
+	privKey, _ := crypto.GenerateKey()
+	account := crypto.PubkeyToAddress(privKey.PublicKey)
+	backends := []*simulated.Backend{}
+	clients := []simulated.Client{}
+	for _, balance := range balances {
+		backend := simulated.NewBackend(types.GenesisAlloc{
+			account: {Balance: new(big.Int).Mul(big.NewInt(balance), big.NewInt(params.Ether))},
+		})
+		t.Cleanup(func() { backend.Close() })
+		backends = append(backends, backend)
+		clients = append(clients, backend.Client())
+	}
+	return backends, clients, privKey
+}
+
+// Test_MultiClientFailover verifies that reads keep working when an endpoint goes down or lags behind.
+func Test_MultiClientFailover(t *testing.T) {
+	backends, clients, privKey := setupBackends(t, 100, 100, 100)
+	account := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	multiClient, err := base.NewMultiClient(base.MultiClientConfig{MaxBlockLag: 1}, clients...)
+	assert.Nil(t, err)
+	defer multiClient.Close()
+	assert.Equal(t, []int{0, 1, 2}, multiClient.HealthyEndpoints())
+
+	t.Run("OK - Lagging endpoints are excluded", func(t *testing.T) {
+		for range 3 {
+			backends[2].Commit()
+		}
+		assert.Nil(t, multiClient.CheckHealth(context.Background()))
+		assert.Equal(t, []int{2}, multiClient.HealthyEndpoints())
+
+		blockNumber, err := multiClient.BlockNumber(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(3), blockNumber)
+	})
+
+	t.Run("OK - Reads fail over when an endpoint is down", func(t *testing.T) {
+		backends[2].Close()
+		for range 3 {
+			backends[1].Commit()
+		}
+
+		balance, err := multiClient.BalanceAt(context.Background(), account, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether)), balance)
+		assert.Nil(t, multiClient.CheckHealth(context.Background()))
+		assert.Equal(t, []int{1}, multiClient.HealthyEndpoints())
+	})
+
+	t.Run("OK - Base interactions over several endpoints", func(t *testing.T) {
+		baseInteractions := base.NewBaseInteractions(multiClient, privKey, nil)
+		assert.Equal(t, account, baseInteractions.Address)
+	})
+}
+
+// Test_MultiClientQuorum verifies that critical reads only succeed when enough endpoints agree.
+func Test_MultiClientQuorum(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Balances    []int64
+		Quorum      int
+		Expected    int64
+		ExpectError bool
+	}{
+		{Name: "OK - Majority agrees", Balances: []int64{100, 50, 100}, Quorum: 2, Expected: 100},
+		{Name: "OK - Quorum disabled", Balances: []int64{100, 100}, Quorum: 0, Expected: 100},
+		{Name: "KO - No two endpoints agree", Balances: []int64{100, 50, 25}, Quorum: 2, ExpectError: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			_, clients, privKey := setupBackends(t, tt.Balances...)
+			multiClient, err := base.NewMultiClient(base.MultiClientConfig{Quorum: tt.Quorum}, clients...)
+			assert.Nil(t, err)
+			defer multiClient.Close()
+
+			balance, err := multiClient.BalanceAt(context.Background(), crypto.PubkeyToAddress(privKey.PublicKey), nil)
+			if tt.ExpectError {
+				assert.ErrorIs(t, err, base.ErrNoQuorum)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, new(big.Int).Mul(big.NewInt(tt.Expected), big.NewInt(params.Ether)), balance)
+		})
+	}
+
+	_, clients, _ := setupBackends(t, 100)
+	_, err := base.NewMultiClient(base.MultiClientConfig{Quorum: 2}, clients...)
+	assert.Error(t, err)
+}
+
+// Test_MultiClientBroadcast verifies that transactions are sent to every endpoint.
+func Test_MultiClientBroadcast(t *testing.T) {
+	backends, clients, privKey := setupBackends(t, 100, 100)
+	multiClient, err := base.NewMultiClient(base.MultiClientConfig{}, clients...)
+	assert.Nil(t, err)
+	defer multiClient.Close()
+
+	chainID, err := multiClient.ChainID(context.Background())
+	assert.Nil(t, err)
+	to := common.HexToAddress("0x000000000000000000000000000000000000beef")
+	tx, err := types.SignNewTx(privKey, types.LatestSignerForChainID(chainID), &types.LegacyTx{
+		GasPrice: big.NewInt(2 * params.GWei),
+		Gas:      21_000,
+		To:       &to,
+		Value:    big.NewInt(params.Ether),
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, multiClient.SendTransaction(context.Background(), tx))
+	assert.Nil(t, multiClient.SendTransaction(context.Background(), tx), "rebroadcasting a known tx must succeed")
+
+	for _, backend := range backends {
+		backend.Commit()
+		receipt, err := backend.Client().TransactionReceipt(context.Background(), tx.Hash())
+		assert.Nil(t, err)
+		assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	}
+}
+
+// Test_MultiClientChainID verifies that endpoints of different chains are rejected.
+func Test_MultiClientChainID(t *testing.T) {
+	_, clients, _ := setupBackends(t, 100, 100)
+
+	t.Run("OK - Chain ID of the endpoints", func(t *testing.T) {
+		multiClient, err := base.NewMultiClient(base.MultiClientConfig{}, clients...)
+		assert.Nil(t, err)
+		defer multiClient.Close()
+		chainID, err := multiClient.ChainID(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, params.AllDevChainProtocolChanges.ChainID, chainID)
+	})
+
+	t.Run("KO - Endpoints on different chains", func(t *testing.T) {
+		config := *params.AllDevChainProtocolChanges
+		config.ChainID = big.NewInt(1)
+		other := simulated.NewBackend(types.GenesisAlloc{}, func(_ *node.Config, ethConf *ethconfig.Config) {
+			ethConf.Genesis.Config = &config
+		})
+		defer other.Close()
+
+		_, err := base.NewMultiClient(base.MultiClientConfig{}, append(clients, other.Client())...)
+		assert.ErrorIs(t, err, base.ErrChainIDMismatch)
+	})
+}
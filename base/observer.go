@@ -0,0 +1,61 @@
+package base
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// OperationKind tells whether an observed operation reads the chain, simulates a transaction or sends one.
+type OperationKind string
+
+const (
+	ReadOperation       OperationKind = "read"
+	SimulationOperation OperationKind = "simulation"
+	SendOperation       OperationKind = "send"
+)
+
+// Operation describes an observed call. Method holds the same identifier as CallError.Field, such as "erc20.BalanceOf()".
+type Operation struct {
+	Kind   OperationKind
+	Method string
+}
+
+// OperationResult describes the outcome of an observed call. Gas is the gas limit of sent transactions, zero otherwise.
+type OperationResult struct {
+	Duration time.Duration
+	Gas      uint64
+	Err      error
+}
+
+// Observer is notified before and after every observed read, simulation and transaction send.
+// The context returned by Before is handed back to After, letting tracers carry their span.
+type Observer interface {
+	Before(ctx context.Context, op Operation) context.Context
+	After(ctx context.Context, op Operation, result OperationResult)
+}
+
+// SetObserver sets the observer notified of the calls made through these interactions, nil disables observation.
+func (b *BaseInteractions) SetObserver(observer Observer) {
+	b.observer = observer
+}
+
+// Observe runs fn and reports it to the observer of the base interactions, if any.
+// When fn returns a transaction, its gas limit is reported.
+func Observe[T any](b *BaseInteractions, kind OperationKind, method string, fn func() (T, error)) (T, error) {
+	if b.observer == nil {
+		return fn()
+	}
+
+	op := Operation{Kind: kind, Method: method}
+	ctx := b.observer.Before(b.Ctx, op)
+	start := time.Now()
+	result, err := fn()
+	observed := OperationResult{Duration: time.Since(start), Err: err}
+	if tx, ok := any(result).(*types.Transaction); ok && tx != nil {
+		observed.Gas = tx.Gas()
+	}
+	b.observer.After(ctx, op, observed)
+	return result, err
+}
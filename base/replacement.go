@@ -0,0 +1,251 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// MinReplacementBump is the fee increase, in percent, nodes require to accept a transaction replacing a pending one.
+const MinReplacementBump = 10
+
+var (
+	// ErrNonceAlreadyUsed is returned when the nonce of the transaction to replace has been consumed by a mined transaction.
+	ErrNonceAlreadyUsed = errors.New("nonce already used by a mined transaction")
+)
+
+// SpeedUp resends the transaction with the same nonce and its fees multiplied by factor.
+// The fees are always raised by at least MinReplacementBump percent so nodes accept the replacement.
+func (b *BaseInteractions) SpeedUp(tx *types.Transaction, factor float64) (*types.Transaction, error) {
+	return b.replace(tx, factor, false)
+}
+
+// Cancel replaces the transaction by an empty transfer to the sender itself, using the same nonce and the minimum fee bump.
+func (b *BaseInteractions) Cancel(tx *types.Transaction) (*types.Transaction, error) {
+	return b.replace(tx, 1, true)
+}
+
+// replace signs and broadcasts a transaction using the nonce of tx and bumped fees.
+// When cancel is set the payload is dropped and the transaction is sent to the sender.
+func (b *BaseInteractions) replace(tx *types.Transaction, factor float64, cancel bool) (*types.Transaction, error) {
+	chainID, err := b.chainID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %v", err)
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	sender, err := types.Sender(signer, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover the tx sender: %w", err)
+	}
+	if sender != b.Address {
+		return nil, fmt.Errorf("transaction %s was sent by %s, not %s", tx.Hash().Hex(), sender.Hex(), b.Address.Hex())
+	}
+
+	nonce, err := Observe(b, ReadOperation, "base.NonceAt()", func() (uint64, error) {
+		return b.Client.NonceAt(b.Ctx, b.Address, nil)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user nonce: %v", err)
+	}
+	if nonce > tx.Nonce() {
+		return nil, ErrNonceAlreadyUsed
+	}
+
+	to, value, data, gas := tx.To(), tx.Value(), tx.Data(), tx.Gas()
+	if cancel {
+		to, value, data, gas = &b.Address, new(big.Int), nil, 21_000
+	}
+
+	var replacement types.TxData
+	switch tx.Type() {
+	case types.LegacyTxType:
+		replacement = &types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: bumpFee(tx.GasPrice(), factor),
+			Gas:      gas,
+			To:       to,
+			Value:    value,
+			Data:     data,
+		}
+	case types.AccessListTxType:
+		replacement = &types.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      tx.Nonce(),
+			GasPrice:   bumpFee(tx.GasPrice(), factor),
+			Gas:        gas,
+			To:         to,
+			Value:      value,
+			Data:       data,
+			AccessList: tx.AccessList(),
+		}
+	case types.DynamicFeeTxType:
+		replacement = &types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      tx.Nonce(),
+			GasTipCap:  bumpFee(tx.GasTipCap(), factor),
+			GasFeeCap:  bumpFee(tx.GasFeeCap(), factor),
+			Gas:        gas,
+			To:         to,
+			Value:      value,
+			Data:       data,
+			AccessList: tx.AccessList(),
+		}
+	default:
+		return nil, fmt.Errorf("replacing transactions of type %d is not supported", tx.Type())
+	}
+
+	signedTx, err := types.SignNewTx(b.pk, signer, replacement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the tx: %w", err)
+	}
+	_, err = Observe(b, SendOperation, "base.ReplaceTransaction()", func() (*types.Transaction, error) {
+		return signedTx, b.Client.SendTransaction(b.Ctx, signedTx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send the tx: %w", err)
+	}
+	return signedTx, nil
+}
+
+// bumpFee multiplies the fee by factor, rounding up to the minimum increase accepted for replacements.
+func bumpFee(fee *big.Int, factor float64) *big.Int {
+	scaled, _ := new(big.Float).Mul(new(big.Float).SetInt(fee), big.NewFloat(factor)).Int(nil)
+	minimum := new(big.Int).Mul(fee, big.NewInt(100+MinReplacementBump))
+	minimum.Add(minimum, big.NewInt(99)).Div(minimum, big.NewInt(100))
+	if scaled.Cmp(minimum) < 0 {
+		scaled = minimum
+	}
+	if scaled.Cmp(fee) <= 0 {
+		scaled = new(big.Int).Add(fee, common.Big1)
+	}
+	return scaled
+}
+
+// WaitReplaced waits until one of the given transactions, all sharing the same nonce, is mined and returns it with its receipt.
+// ErrNonceAlreadyUsed is returned if the nonce was consumed by another transaction.
+func (b *BaseInteractions) WaitReplaced(ctx context.Context, pollInterval time.Duration, attempts ...*types.Transaction) (*types.Transaction, *types.Receipt, error) {
+	if len(attempts) == 0 {
+		return nil, nil, errors.New("no transaction to wait for")
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		nonce, err := Observe(b, ReadOperation, "base.NonceAt()", func() (uint64, error) {
+			return b.Client.NonceAt(ctx, b.Address, nil)
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get user nonce: %w", err)
+		}
+		// Like bind.WaitMined, receipt lookup errors are retried since nodes report them while indexing.
+		retrying := false
+		for _, tx := range attempts {
+			receipt, err := Observe(b, ReadOperation, "base.TransactionReceipt()", func() (*types.Receipt, error) {
+				return b.Client.TransactionReceipt(ctx, tx.Hash())
+			})
+			if err == nil && receipt != nil {
+				return tx, receipt, nil
+			}
+			retrying = retrying || !errors.Is(err, ethereum.NotFound)
+		}
+		if nonce > attempts[0].Nonce() && !retrying {
+			return nil, nil, ErrNonceAlreadyUsed
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// WatcherConfig configures how a TxWatcher handles a transaction that is not mined in time.
+type WatcherConfig struct {
+	// Timeout is how long each attempt is given to be mined before it is escalated or rebroadcast.
+	Timeout time.Duration
+	// PollInterval is the delay between two receipt lookups.
+	PollInterval time.Duration
+	// Factor multiplies the fees of the last attempt on each escalation.
+	Factor float64
+	// MaxReplacements bounds the number of fee escalations, the last attempt is rebroadcast afterwards.
+	MaxReplacements int
+}
+
+// DefaultWatcherConfig escalates the fees by 12.5% every two minutes, up to three times.
+var DefaultWatcherConfig = WatcherConfig{
+	Timeout:         2 * time.Minute,
+	PollInterval:    time.Second,
+	Factor:          1.125,
+	MaxReplacements: 3,
+}
+
+// WatchResult holds every transaction sent for a nonce and the one that was mined.
+type WatchResult struct {
+	Attempts []*types.Transaction
+	Mined    *types.Transaction
+	Receipt  *types.Receipt
+	// Link is the explorer link of the mined transaction, empty when no explorer is set.
+	Link string
+}
+
+// TxWatcher follows a pending transaction and escalates or rebroadcasts it when it is not mined in time.
+type TxWatcher struct {
+	b      *BaseInteractions
+	config WatcherConfig
+}
+
+// NewTxWatcher creates a new TxWatcher sending replacements from the base interactions account.
+func (b *BaseInteractions) NewTxWatcher(config WatcherConfig) *TxWatcher {
+	return &TxWatcher{b, config}
+}
+
+// Watch waits for the transaction to be mined. Each time an attempt times out, its fees are bumped through SpeedUp
+// until MaxReplacements is reached, then the last attempt is rebroadcast. Watch returns once an attempt is mined or ctx is done.
+func (w *TxWatcher) Watch(ctx context.Context, tx *types.Transaction) (*WatchResult, error) {
+	result := &WatchResult{Attempts: []*types.Transaction{tx}}
+	for {
+		start := time.Now()
+		waitCtx, cancel := context.WithTimeout(ctx, w.config.Timeout)
+		mined, receipt, err := w.b.WaitReplaced(waitCtx, w.config.PollInterval, result.Attempts...)
+		cancel()
+		if err == nil {
+			result.Mined, result.Receipt = mined, receipt
+			result.Link = w.b.explorer.TxURL(mined.Hash())
+			return result, nil
+		}
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		// An expired wait can surface as a transport error rather than context.DeadlineExceeded, so the elapsed time is checked instead.
+		if time.Since(start) < w.config.Timeout {
+			return result, err
+		}
+
+		latest := result.Attempts[len(result.Attempts)-1]
+		if len(result.Attempts) <= w.config.MaxReplacements {
+			replacement, err := w.b.SpeedUp(latest, w.config.Factor)
+			if errors.Is(err, ErrNonceAlreadyUsed) {
+				continue
+			}
+			if err != nil {
+				return result, err
+			}
+			result.Attempts = append(result.Attempts, replacement)
+			continue
+		}
+		_, err = Observe(w.b, SendOperation, "base.Rebroadcast()", func() (*types.Transaction, error) {
+			return latest, w.b.Client.SendTransaction(ctx, latest)
+		})
+		if err != nil && !strings.Contains(err.Error(), "already known") {
+			return result, fmt.Errorf("failed to rebroadcast the tx: %w", err)
+		}
+	}
+}
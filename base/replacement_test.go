@@ -0,0 +1,174 @@
+package base_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+var recipient = common.HexToAddress("0x000000000000000000000000000000000000beef")
+
+// setupPendingTx starts a simulated chain and sends a transfer to recipient that stays pending until the next commit.
+func setupPendingTx(t *testing.T, dynamic bool) (*simulated.Backend, *ecdsa.PrivateKey, *types.Transaction) {
+	privKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(privKey.PublicKey)
+	backend := simulated.NewBackend(types.GenesisAlloc{sender: {Balance: utils.MAX_UINT256}})
+
+	chainID, err := backend.Client().ChainID(context.Background())
+	assert.Nil(t, err)
+
+	var txData types.TxData = &types.LegacyTx{
+		GasPrice: big.NewInt(2 * params.GWei),
+		Gas:      21_000,
+		To:       &recipient,
+		Value:    big.NewInt(params.Ether),
+	}
+	if dynamic {
+		txData = &types.DynamicFeeTx{
+			ChainID:   chainID,
+			GasTipCap: big.NewInt(params.GWei),
+			GasFeeCap: big.NewInt(3 * params.GWei),
+			Gas:       21_000,
+			To:        &recipient,
+			Value:     big.NewInt(params.Ether),
+		}
+	}
+	tx, err := types.SignNewTx(privKey, types.LatestSignerForChainID(chainID), txData)
+	assert.Nil(t, err)
+	assert.Nil(t, backend.Client().SendTransaction(context.Background(), tx))
+	return backend, privKey, tx
+}
+
+// Test_SpeedUp verifies that legacy and EIP-1559 transactions are replaced with bumped fees and that the replacement is the one mined.
+func Test_SpeedUp(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Dynamic bool
+		Factor  float64
+	}{
+		{Name: "OK - Legacy transaction, factor below the minimum bump", Factor: 1.01},
+		{Name: "OK - Legacy transaction", Factor: 1.5},
+		{Name: "OK - EIP-1559 transaction, factor below the minimum bump", Dynamic: true, Factor: 1.01},
+		{Name: "OK - EIP-1559 transaction", Dynamic: true, Factor: 2},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			backend, privKey, tx := setupPendingTx(t, tt.Dynamic)
+			defer backend.Close()
+			baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+
+			replacement, err := baseInteractions.SpeedUp(tx, tt.Factor)
+			assert.Nil(t, err)
+			assert.Equal(t, tx.Nonce(), replacement.Nonce())
+			assert.Equal(t, tx.Type(), replacement.Type())
+			minimumFeeCap := new(big.Int).Div(new(big.Int).Mul(tx.GasFeeCap(), big.NewInt(110)), big.NewInt(100))
+			assert.True(t, replacement.GasFeeCap().Cmp(minimumFeeCap) >= 0)
+			minimumTipCap := new(big.Int).Div(new(big.Int).Mul(tx.GasTipCap(), big.NewInt(110)), big.NewInt(100))
+			assert.True(t, replacement.GasTipCap().Cmp(minimumTipCap) >= 0)
+
+			backend.Commit()
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			mined, receipt, err := baseInteractions.WaitReplaced(ctx, 10*time.Millisecond, tx, replacement)
+			assert.Nil(t, err)
+			assert.Equal(t, replacement.Hash(), mined.Hash())
+			assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+			_, err = baseInteractions.SpeedUp(tx, tt.Factor)
+			assert.ErrorIs(t, err, base.ErrNonceAlreadyUsed)
+		})
+	}
+}
+
+// Test_Cancel verifies that a cancelled transfer never reaches its recipient.
+func Test_Cancel(t *testing.T) {
+	backend, privKey, tx := setupPendingTx(t, true)
+	defer backend.Close()
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+
+	cancellation, err := baseInteractions.Cancel(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, baseInteractions.Address, *cancellation.To())
+	assert.Equal(t, int64(0), cancellation.Value().Int64())
+	backend.Commit()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	mined, _, err := baseInteractions.WaitReplaced(ctx, 10*time.Millisecond, tx, cancellation)
+	assert.Nil(t, err)
+	assert.Equal(t, cancellation.Hash(), mined.Hash())
+
+	balance, err := backend.Client().BalanceAt(context.Background(), recipient, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), balance.Int64())
+}
+
+// Test_Watcher verifies that the watcher escalates a stuck transaction up to the configured limit and reports the mined attempt.
+func Test_Watcher(t *testing.T) {
+	backend, privKey, tx := setupPendingTx(t, false)
+	defer backend.Close()
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, base.NewExplorer("Test", "https://explorer.test/"))
+
+	watcher := baseInteractions.NewTxWatcher(base.WatcherConfig{
+		Timeout:         20 * time.Millisecond,
+		PollInterval:    5 * time.Millisecond,
+		Factor:          1.2,
+		MaxReplacements: 2,
+	})
+
+	go func() {
+		time.Sleep(300 * time.Millisecond)
+		backend.Commit()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	result, err := watcher.Watch(ctx, tx)
+	assert.Nil(t, err)
+	assert.Len(t, result.Attempts, 3)
+	assert.Equal(t, result.Attempts[2].Hash(), result.Mined.Hash())
+	assert.Equal(t, types.ReceiptStatusSuccessful, result.Receipt.Status)
+	assert.Equal(t, "https://explorer.test/tx/"+result.Mined.Hash().Hex(), result.Link)
+}
+
+// Test_CatchTx verifies that CatchTx gives up on a transaction that is not mined in time and reports a replaced one.
+func Test_CatchTx(t *testing.T) {
+	t.Run("KO - Not mined in time", func(t *testing.T) {
+		backend, privKey, tx := setupPendingTx(t, false)
+		defer backend.Close()
+		baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+		baseInteractions.SetMineTimeout(50 * time.Millisecond)
+
+		_, err := baseInteractions.CatchTx(tx, nil)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		backend.Commit()
+		hash, err := baseInteractions.CatchTx(tx, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, tx.Hash().Hex(), hash)
+	})
+
+	t.Run("KO - Replaced", func(t *testing.T) {
+		backend, privKey, tx := setupPendingTx(t, true)
+		defer backend.Close()
+		baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+
+		_, err := baseInteractions.SpeedUp(tx, 2)
+		assert.Nil(t, err)
+		backend.Commit()
+		_, err = baseInteractions.CatchTx(tx, nil)
+		assert.ErrorIs(t, err, base.ErrNonceAlreadyUsed)
+	})
+}
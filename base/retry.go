@@ -0,0 +1,320 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/rpc"
+	"golang.org/x/time/rate"
+)
+
+// RetryConfig configures the backoff and rate limiting applied by a RetryClient.
+type RetryConfig struct {
+	// MaxAttempts is the total number of attempts for a request, the first one included.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, doubled on each following retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts.
+	MaxDelay time.Duration
+	// RequestsPerSecond is the rate of the token bucket, zero disables rate limiting.
+	RequestsPerSecond float64
+	// Burst is the capacity of the token bucket.
+	Burst int
+}
+
+// DefaultRetryConfig retries up to five times, starting at 250ms, and does not limit the request rate.
+var DefaultRetryConfig = RetryConfig{
+	MaxAttempts: 5,
+	BaseDelay:   250 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+}
+
+// retryableMessages are fragments of the errors returned by throttled or unavailable providers.
+var retryableMessages = []string{
+	"rate limit",
+	"too many requests",
+	"limit exceeded",
+	"capacity exceeded",
+	"timeout",
+	"timed out",
+	"connection refused",
+	"connection reset",
+	"service unavailable",
+	"bad gateway",
+	"header not found",
+	"eof",
+}
+
+// IsRetryable classifies an RPC error. Throttling, server and transport failures are retryable, while reverts,
+// missing items, cancelled contexts and any other error are terminal.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+	if _, reverted := ethclient.RevertErrorData(err); reverted {
+		return false
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests || httpErr.StatusCode >= http.StatusInternalServerError
+	}
+	var rpcErr rpc.Error
+	// -32005 is the "limit exceeded" code used by most providers.
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == -32005 {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	message := strings.ToLower(err.Error())
+	if strings.Contains(message, "execution reverted") {
+		return false
+	}
+	for _, fragment := range retryableMessages {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryClient wraps a client, rate limiting its requests with a token bucket and retrying retryable errors with jittered exponential backoff.
+type RetryClient struct {
+	client  simulated.Client
+	config  RetryConfig
+	limiter *rate.Limiter
+}
+
+var _ simulated.Client = (*RetryClient)(nil)
+
+// NewRetryClient wraps the client of a single endpoint. Wrap each endpoint separately to get a rate limit per endpoint.
+func NewRetryClient(client simulated.Client, config RetryConfig) *RetryClient {
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = 1
+	}
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	if config.RequestsPerSecond > 0 {
+		burst := config.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		limiter = rate.NewLimiter(rate.Limit(config.RequestsPerSecond), burst)
+	}
+	return &RetryClient{client, config, limiter}
+}
+
+// backoff returns the delay before the given retry, a random duration up to the exponential delay ("full jitter").
+func (r *RetryClient) backoff(retry int) time.Duration {
+	delay := r.config.BaseDelay << retry
+	if delay <= 0 || (r.config.MaxDelay > 0 && delay > r.config.MaxDelay) {
+		delay = r.config.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+// retry runs fn until it succeeds, fails with a terminal error, or MaxAttempts is reached. Every attempt waits for the rate limiter.
+func retry[T any](r *RetryClient, ctx context.Context, fn func() (T, error)) (T, error) {
+	var (
+		result T
+		err    error
+	)
+	for attempt := 0; attempt < r.config.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return result, ctx.Err()
+			case <-time.After(r.backoff(attempt - 1)):
+			}
+		}
+		if waitErr := r.limiter.Wait(ctx); waitErr != nil {
+			return result, waitErr
+		}
+		result, err = fn()
+		if !IsRetryable(err) {
+			return result, err
+		}
+	}
+	return result, err
+}
+
+// BlockNumber returns the most recent block number.
+func (r *RetryClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return retry(r, ctx, func() (uint64, error) { return r.client.BlockNumber(ctx) })
+}
+
+// BlockByHash returns the block with the given hash.
+func (r *RetryClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return retry(r, ctx, func() (*types.Block, error) { return r.client.BlockByHash(ctx, hash) })
+}
+
+// BlockByNumber returns the block with the given number, nil meaning the latest block.
+func (r *RetryClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return retry(r, ctx, func() (*types.Block, error) { return r.client.BlockByNumber(ctx, number) })
+}
+
+// HeaderByHash returns the header of the block with the given hash.
+func (r *RetryClient) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return retry(r, ctx, func() (*types.Header, error) { return r.client.HeaderByHash(ctx, hash) })
+}
+
+// HeaderByNumber returns the header of the block with the given number, nil meaning the latest block.
+func (r *RetryClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return retry(r, ctx, func() (*types.Header, error) { return r.client.HeaderByNumber(ctx, number) })
+}
+
+// TransactionCount returns the number of transactions in the given block.
+func (r *RetryClient) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
+	return retry(r, ctx, func() (uint, error) { return r.client.TransactionCount(ctx, blockHash) })
+}
+
+// TransactionInBlock returns the transaction at the given index of a block.
+func (r *RetryClient) TransactionInBlock(ctx context.Context, blockHash common.Hash, index uint) (*types.Transaction, error) {
+	return retry(r, ctx, func() (*types.Transaction, error) { return r.client.TransactionInBlock(ctx, blockHash, index) })
+}
+
+// SubscribeNewHead subscribes to new block headers.
+func (r *RetryClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return retry(r, ctx, func() (ethereum.Subscription, error) { return r.client.SubscribeNewHead(ctx, ch) })
+}
+
+// BalanceAt returns the balance of the account.
+func (r *RetryClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return retry(r, ctx, func() (*big.Int, error) { return r.client.BalanceAt(ctx, account, blockNumber) })
+}
+
+// StorageAt returns the value of a storage slot.
+func (r *RetryClient) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	return retry(r, ctx, func() ([]byte, error) { return r.client.StorageAt(ctx, account, key, blockNumber) })
+}
+
+// CodeAt returns the code of the account.
+func (r *RetryClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return retry(r, ctx, func() ([]byte, error) { return r.client.CodeAt(ctx, account, blockNumber) })
+}
+
+// NonceAt returns the nonce of the account.
+func (r *RetryClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return retry(r, ctx, func() (uint64, error) { return r.client.NonceAt(ctx, account, blockNumber) })
+}
+
+// CallContract executes a message call. Reverts are terminal and returned as is so their custom errors can be decoded.
+func (r *RetryClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return retry(r, ctx, func() ([]byte, error) { return r.client.CallContract(ctx, call, blockNumber) })
+}
+
+// EstimateGas estimates the gas needed to execute the message.
+func (r *RetryClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return retry(r, ctx, func() (uint64, error) { return r.client.EstimateGas(ctx, call) })
+}
+
+// SuggestGasPrice returns the suggested gas price.
+func (r *RetryClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return retry(r, ctx, func() (*big.Int, error) { return r.client.SuggestGasPrice(ctx) })
+}
+
+// SuggestGasTipCap returns the suggested priority fee.
+func (r *RetryClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return retry(r, ctx, func() (*big.Int, error) { return r.client.SuggestGasTipCap(ctx) })
+}
+
+// FeeHistory returns the fee market history.
+func (r *RetryClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	return retry(r, ctx, func() (*ethereum.FeeHistory, error) {
+		return r.client.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+	})
+}
+
+// FilterLogs executes a log filter query.
+func (r *RetryClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return retry(r, ctx, func() ([]types.Log, error) { return r.client.FilterLogs(ctx, q) })
+}
+
+// SubscribeFilterLogs subscribes to the results of a log filter query.
+func (r *RetryClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return retry(r, ctx, func() (ethereum.Subscription, error) { return r.client.SubscribeFilterLogs(ctx, q, ch) })
+}
+
+// PendingBalanceAt returns the balance of the account in the pending state.
+func (r *RetryClient) PendingBalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
+	return retry(r, ctx, func() (*big.Int, error) { return r.client.PendingBalanceAt(ctx, account) })
+}
+
+// PendingStorageAt returns the value of a storage slot in the pending state.
+func (r *RetryClient) PendingStorageAt(ctx context.Context, account common.Address, key common.Hash) ([]byte, error) {
+	return retry(r, ctx, func() ([]byte, error) { return r.client.PendingStorageAt(ctx, account, key) })
+}
+
+// PendingCodeAt returns the code of the account in the pending state.
+func (r *RetryClient) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return retry(r, ctx, func() ([]byte, error) { return r.client.PendingCodeAt(ctx, account) })
+}
+
+// PendingNonceAt returns the nonce of the account, pending transactions included.
+func (r *RetryClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return retry(r, ctx, func() (uint64, error) { return r.client.PendingNonceAt(ctx, account) })
+}
+
+// PendingTransactionCount returns the number of transactions in the pending block.
+func (r *RetryClient) PendingTransactionCount(ctx context.Context) (uint, error) {
+	return retry(r, ctx, func() (uint, error) { return r.client.PendingTransactionCount(ctx) })
+}
+
+// PendingCallContract executes a message call against the pending state.
+func (r *RetryClient) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	return retry(r, ctx, func() ([]byte, error) { return r.client.PendingCallContract(ctx, call) })
+}
+
+// TransactionByHash returns the transaction with the given hash.
+func (r *RetryClient) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	type result struct {
+		tx        *types.Transaction
+		isPending bool
+	}
+	res, err := retry(r, ctx, func() (result, error) {
+		tx, isPending, err := r.client.TransactionByHash(ctx, txHash)
+		return result{tx, isPending}, err
+	})
+	return res.tx, res.isPending, err
+}
+
+// TransactionReceipt returns the receipt of a mined transaction.
+func (r *RetryClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return retry(r, ctx, func() (*types.Receipt, error) { return r.client.TransactionReceipt(ctx, txHash) })
+}
+
+// SendTransaction broadcasts a signed transaction. Resending the same signed transaction is safe,
+// so a retry rejected because the node already knows the transaction means an earlier attempt went through.
+func (r *RetryClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	attempts := 0
+	_, err := retry(r, ctx, func() (struct{}, error) {
+		attempts++
+		err := r.client.SendTransaction(ctx, tx)
+		if err != nil && attempts > 1 && strings.Contains(err.Error(), "already known") {
+			return struct{}{}, nil
+		}
+		return struct{}{}, err
+	})
+	return err
+}
+
+// ChainID returns the chain ID of the endpoint.
+func (r *RetryClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return retry(r, ctx, func() (*big.Int, error) { return r.client.ChainID(ctx) })
+}
@@ -0,0 +1,121 @@
+package base_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/inferences/AccessControlOwnable"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyClient fails the first balance and call requests with the configured error and counts every request.
+type flakyClient struct {
+	simulated.Client
+	failures int
+	err      error
+	calls    int
+}
+
+func (f *flakyClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, f.err
+	}
+	return f.Client.BalanceAt(ctx, account, blockNumber)
+}
+
+func (f *flakyClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, f.err
+	}
+	return f.Client.CallContract(ctx, call, blockNumber)
+}
+
+// Test_IsRetryable verifies the classification of RPC errors.
+func Test_IsRetryable(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Err       error
+		Retryable bool
+	}{
+		{Name: "HTTP 429", Err: rpc.HTTPError{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests"}, Retryable: true},
+		{Name: "HTTP 503", Err: rpc.HTTPError{StatusCode: http.StatusServiceUnavailable, Status: "503 Service Unavailable"}, Retryable: true},
+		{Name: "HTTP 401", Err: rpc.HTTPError{StatusCode: http.StatusUnauthorized, Status: "401 Unauthorized"}, Retryable: false},
+		{Name: "Provider rate limit message", Err: errors.New("daily request count exceeded, request rate limited"), Retryable: true},
+		{Name: "Not found", Err: ethereum.NotFound, Retryable: false},
+		{Name: "Cancelled context", Err: context.Canceled, Retryable: false},
+		{Name: "Revert", Err: errors.New("execution reverted: OwnableUnauthorizedAccount"), Retryable: false},
+		{Name: "Unknown error", Err: errors.New("invalid argument 0: hex string has odd length"), Retryable: false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Retryable, base.IsRetryable(tt.Err))
+		})
+	}
+}
+
+// Test_RetryClient verifies that throttled requests are retried while reverts are returned at once.
+func Test_RetryClient(t *testing.T) {
+	backend, _, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		AccessControlOwnable.AccessControlOwnableABI,
+		AccessControlOwnable.AccessControlOwnableBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	config := base.RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+	throttled := rpc.HTTPError{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests"}
+
+	t.Run("OK - Throttled read succeeds after retries", func(t *testing.T) {
+		flaky := &flakyClient{Client: backend.Client(), failures: 2, err: throttled}
+		baseInteractions := base.NewBaseInteractions(base.NewRetryClient(flaky, config), privKey, nil)
+		_, err := baseInteractions.Client.BalanceAt(context.Background(), baseInteractions.Address, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, flaky.calls)
+	})
+
+	t.Run("KO - Attempts exhausted", func(t *testing.T) {
+		flaky := &flakyClient{Client: backend.Client(), failures: 5, err: throttled}
+		client := base.NewRetryClient(flaky, config)
+		_, err := client.BalanceAt(context.Background(), common.Address{}, nil)
+		assert.ErrorAs(t, err, &rpc.HTTPError{})
+		assert.Equal(t, 3, flaky.calls)
+	})
+
+	t.Run("KO - Revert is not retried and still decodable", func(t *testing.T) {
+		flaky := &flakyClient{Client: backend.Client()}
+		baseInteractions := base.NewBaseInteractions(base.NewRetryClient(flaky, config), privKey, nil)
+		data, err := utils.GetEncodedFunction(AccessControlOwnable.AccessControlOwnableABI, "renounceRole", [32]byte{}, common.Address{})
+		assert.Nil(t, err)
+		err = baseInteractions.VerifyTransaction(context.Background(), *contractAddr, data, 0)
+		assert.Equal(t, 1, flaky.calls)
+		callErr := baseInteractions.WrapCallError(AccessControlOwnable.AccessControlOwnableABI, "access.RenounceRole()", err)
+		assert.Contains(t, callErr.Error(), "AccessControlBadConfirmation")
+	})
+}
+
+// Test_RateLimit verifies that requests are spaced by the token bucket.
+func Test_RateLimit(t *testing.T) {
+	backend := simulated.NewBackend(nil)
+	defer backend.Close()
+
+	client := base.NewRetryClient(backend.Client(), base.RetryConfig{MaxAttempts: 1, RequestsPerSecond: 50, Burst: 1})
+	start := time.Now()
+	for range 6 {
+		_, err := client.BlockNumber(context.Background())
+		assert.Nil(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
@@ -1,19 +1,70 @@
 package basetokens
 
+// Package basetokens defines the interfaces shared by the token interactions of every standard and a factory building them from a contract address.
+
 import (
 	"math/big"
 
+	"github.com/OCharless/eth-interfaces/erc1155"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/models"
+	"github.com/OCharless/eth-interfaces/nft"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 )
 
-type BaseToken interface {
+// Asset is implemented by the interactions of every token standard.
+type Asset interface {
+	GetAddress() common.Address
+}
+
+// Fungible is implemented by ERC20 token interactions.
+type Fungible interface {
+	Asset
 	Name() (string, error)
 	Symbol() (string, error)
-	TokenURI(tokenID *big.Int) (string, error)
+	Decimals() (uint8, error)
+	TotalSupply() (*big.Int, error)
 	GetBalance() (*big.Int, error)
-	TransferTo(to common.Address, tokenID *big.Int) (*types.Transaction, error)
+	BalanceOf(owner common.Address) (*big.Int, error)
+	Allowance(owner, spender common.Address) (*big.Int, error)
+	TransferTo(to common.Address, amount *big.Int) (*types.Transaction, error)
+	Approve(spender common.Address, amount *big.Int) (*types.Transaction, error)
+	TokenMetaInfos() (*models.TokenMeta, error)
+}
+
+// NonFungible is implemented by ERC721 token interactions.
+type NonFungible interface {
+	Asset
+	Name() (string, error)
+	Symbol() (string, error)
+	TokenURI(tokenID *big.Int) (string, error)
 	TotalSupply() (*big.Int, error)
+	GetBalance() (*big.Int, error)
 	BalanceOf(owner common.Address) (*big.Int, error)
+	OwnerOf(tokenID *big.Int) (common.Address, error)
+	GetApproved(tokenID *big.Int) (common.Address, error)
+	TransferTo(to common.Address, tokenID *big.Int) (*types.Transaction, error)
 	Approve(to common.Address, tokenID *big.Int) (*types.Transaction, error)
+	TokenMetaInfos(tokenID *big.Int) (*models.TokenMeta, error)
 }
+
+// MultiToken is implemented by ERC1155 token interactions.
+type MultiToken interface {
+	Asset
+	URI(id *big.Int) (string, error)
+	TokenURI(id *big.Int) (string, error)
+	GetBalance(id *big.Int) (*big.Int, error)
+	BalanceOf(owner common.Address, id *big.Int) (*big.Int, error)
+	BalanceOfBatch(owners []common.Address, ids []*big.Int) ([]*big.Int, error)
+	IsApprovedForAll(owner, operator common.Address) (bool, error)
+	SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error)
+	TransferTo(to common.Address, id, amount *big.Int) (*types.Transaction, error)
+	BatchTransferTo(to common.Address, ids, amounts []*big.Int) (*types.Transaction, error)
+}
+
+var (
+	_ Fungible    = (*erc20.ERC20Interactions)(nil)
+	_ NonFungible = (*nft.ERC721Interactions)(nil)
+	_ MultiToken  = (*erc1155.ERC1155Interactions)(nil)
+)
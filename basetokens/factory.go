@@ -0,0 +1,69 @@
+package basetokens
+
+import (
+	"fmt"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/erc1155"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/probe"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Standard names the token standard implemented by an asset.
+type Standard string
+
+const (
+	ERC20   Standard = "ERC20"
+	ERC721  Standard = "ERC721"
+	ERC1155 Standard = "ERC1155"
+	Unknown Standard = ""
+)
+
+// StandardOf returns the token standard implemented by an asset.
+func StandardOf(asset Asset) Standard {
+	switch asset.(type) {
+	case Fungible:
+		return ERC20
+	case NonFungible:
+		return ERC721
+	case MultiToken:
+		return ERC1155
+	default:
+		return Unknown
+	}
+}
+
+// NewAsset probes the contract deployed at the given address and returns the interactions matching its standard:
+// a *erc20.ERC20Interactions, a *nft.ERC721Interactions or a *erc1155.ERC1155Interactions.
+func NewAsset(baseInteractions *base.BaseInteractions, address common.Address, transactOps ...*bind.TransactOpts) (Asset, error) {
+	capabilities, err := probe.Probe(baseInteractions, address)
+	if err != nil {
+		return nil, err
+	}
+	return NewAssetFromCapabilities(baseInteractions, capabilities, transactOps...)
+}
+
+// NewAssetFromCapabilities returns the interactions matching a probe report, without probing the contract again.
+func NewAssetFromCapabilities(baseInteractions *base.BaseInteractions, capabilities *probe.Capabilities, transactOps ...*bind.TransactOpts) (Asset, error) {
+	switch {
+	case capabilities.ERC721:
+		return asset(nft.NewERC721Interactions(baseInteractions, capabilities.Address, []nft.BaseNFTSignature{}, transactOps...))
+	case capabilities.ERC1155:
+		return asset(erc1155.NewERC1155Interactions(baseInteractions, capabilities.Address, []erc1155.BaseERC1155Signature{}, transactOps...))
+	case capabilities.ERC20:
+		return asset(erc20.NewIERC20Interactions(baseInteractions, capabilities.Address, []erc20.BaseERC20Signature{}, transactOps...))
+	default:
+		return nil, fmt.Errorf("contract %s implements no supported token standard", capabilities.Address.Hex())
+	}
+}
+
+// asset converts the result of an interactions constructor, keeping a nil Asset on error instead of a typed nil pointer.
+func asset[T Asset](interactions T, err error) (Asset, error) {
+	if err != nil {
+		return nil, err
+	}
+	return interactions, nil
+}
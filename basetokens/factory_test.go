@@ -0,0 +1,97 @@
+package basetokens_test
+
+// Package basetokens_test contains tests for the asset factory.
+
+import (
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/basetokens"
+	"github.com/OCharless/eth-interfaces/erc1155"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/ERC1155Complete"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/OCharless/eth-interfaces/inferences/ERC721Complete"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_NewAsset verifies that the factory returns the interactions matching the standard of each bundled contract.
+func Test_NewAsset(t *testing.T) {
+	backend, auth, erc721Addr, privKey, err := utils.SetupBlockchain(t,
+		ERC721Complete.ERC721CompleteABI,
+		ERC721Complete.ERC721CompleteBin,
+		"MyNFT",
+		"MNFT",
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	erc20Addr, _, _, err := utils.DeployContract(auth, backend.Client(), ERC20Burnable.ERC20BurnableABI, ERC20Burnable.ERC20BurnableBin)
+	assert.Nil(t, err)
+	erc1155Addr, _, _, err := utils.DeployContract(auth, backend.Client(), ERC1155Complete.ERC1155CompleteABI, ERC1155Complete.ERC1155CompleteBin)
+	assert.Nil(t, err)
+	backend.Commit()
+	emptyContract, err := utils.DeployEmptyContract(auth, backend)
+	assert.Nil(t, err)
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+
+	testCases := []struct {
+		Name             string
+		ContractAddr     common.Address
+		ExpectedStandard basetokens.Standard
+		ExpectError      bool
+	}{
+		{
+			Name:             "OK - ERC20",
+			ContractAddr:     erc20Addr,
+			ExpectedStandard: basetokens.ERC20,
+		},
+		{
+			Name:             "OK - ERC721",
+			ContractAddr:     *erc721Addr,
+			ExpectedStandard: basetokens.ERC721,
+		},
+		{
+			Name:             "OK - ERC1155",
+			ContractAddr:     erc1155Addr,
+			ExpectedStandard: basetokens.ERC1155,
+		},
+		{
+			Name:         "KO - Empty contract",
+			ContractAddr: *emptyContract,
+			ExpectError:  true,
+		},
+		{
+			Name:         "KO - No contract",
+			ContractAddr: common.HexToAddress("0x000000000000000000000000000000000000dead"),
+			ExpectError:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			asset, err := basetokens.NewAsset(baseInteractions, tc.ContractAddr, auth)
+			if tc.ExpectError {
+				assert.Error(t, err)
+				assert.Nil(t, asset)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.ContractAddr, asset.GetAddress())
+			assert.Equal(t, tc.ExpectedStandard, basetokens.StandardOf(asset))
+
+			switch tc.ExpectedStandard {
+			case basetokens.ERC20:
+				assert.IsType(t, &erc20.ERC20Interactions{}, asset)
+			case basetokens.ERC721:
+				assert.IsType(t, &nft.ERC721Interactions{}, asset)
+			case basetokens.ERC1155:
+				assert.IsType(t, &erc1155.ERC1155Interactions{}, asset)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package cache
+
+// Package cache provides a read cache for token metadata that never or rarely changes, such as name, symbol, decimals and token URIs.
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Key identifies a cached value. TokenID holds the decimal token id for per-token fields such as the token URI, and is empty for contract-level fields.
+type Key struct {
+	ChainID uint64
+	Address common.Address
+	Field   string
+	TokenID string
+}
+
+// Store holds cached values. Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the value stored under key, if any and not expired.
+	Get(key Key) (string, bool)
+	// Set stores value under key. A zero expiresAt means the value never expires.
+	Set(key Key, value string, expiresAt time.Time)
+	// Delete removes the value stored under key.
+	Delete(key Key)
+	// DeleteMatching removes every value whose key matches.
+	DeleteMatching(match func(Key) bool)
+}
+
+// Config configures the lifetime and the number of cached values. A zero TTL means values never expire.
+type Config struct {
+	// TTL is the lifetime of contract-level fields such as name, symbol and decimals.
+	TTL time.Duration
+	// TokenTTL is the lifetime of per-token fields such as token URIs.
+	TokenTTL time.Duration
+	// MaxEntries bounds the size of the default memory store, zero means unbounded.
+	MaxEntries int
+}
+
+// DefaultConfig keeps contract-level fields for a day and token URIs for an hour, up to 10000 entries.
+var DefaultConfig = Config{
+	TTL:        24 * time.Hour,
+	TokenTTL:   time.Hour,
+	MaxEntries: 10000,
+}
+
+// Cache is a metadata read cache backed by a Store.
+type Cache struct {
+	config Config
+	store  Store
+}
+
+// New creates a new Cache. It uses the given store, or a memory store bounded by config.MaxEntries when none is given.
+func New(config Config, store ...Store) *Cache {
+	if len(store) == 0 || store[0] == nil {
+		return &Cache{config, NewMemoryStore(config.MaxEntries)}
+	}
+	return &Cache{config, store[0]}
+}
+
+// Store returns the store backing the cache.
+func (c *Cache) Store() Store {
+	return c.store
+}
+
+// Fetch returns the value cached under key, or calls fetch and caches its result when there is none. Errors are not cached.
+func (c *Cache) Fetch(key Key, fetch func() (string, error)) (string, error) {
+	if value, ok := c.store.Get(key); ok {
+		return value, nil
+	}
+	value, err := fetch()
+	if err != nil {
+		return "", err
+	}
+
+	ttl := c.config.TTL
+	if key.TokenID != "" {
+		ttl = c.config.TokenTTL
+	}
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+	c.store.Set(key, value, expiresAt)
+	return value, nil
+}
+
+// InvalidateToken removes the cached per-token fields of a token.
+func (c *Cache) InvalidateToken(chainID uint64, address common.Address, tokenID *big.Int) {
+	id := tokenID.String()
+	c.store.DeleteMatching(func(key Key) bool {
+		return key.ChainID == chainID && key.Address == address && key.TokenID == id
+	})
+}
+
+// InvalidateTokens removes the cached per-token fields of every token id between from and to, both included.
+func (c *Cache) InvalidateTokens(chainID uint64, address common.Address, from, to *big.Int) {
+	c.store.DeleteMatching(func(key Key) bool {
+		if key.ChainID != chainID || key.Address != address || key.TokenID == "" {
+			return false
+		}
+		id, ok := new(big.Int).SetString(key.TokenID, 10)
+		return ok && id.Cmp(from) >= 0 && id.Cmp(to) <= 0
+	})
+}
+
+// InvalidateContract removes every cached field of a contract.
+func (c *Cache) InvalidateContract(chainID uint64, address common.Address) {
+	c.store.DeleteMatching(func(key Key) bool {
+		return key.ChainID == chainID && key.Address == address
+	})
+}
+
+// Contract returns a view of the cache bound to a contract.
+func (c *Cache) Contract(chainID uint64, address common.Address) *ContractCache {
+	return &ContractCache{c, chainID, address}
+}
+
+// ContractCache is a view of a Cache bound to a single contract.
+// A nil ContractCache is valid and caches nothing, letting interactions call it unconditionally.
+type ContractCache struct {
+	cache   *Cache
+	chainID uint64
+	address common.Address
+}
+
+// Cache returns the underlying cache.
+func (c *ContractCache) Cache() *Cache {
+	return c.cache
+}
+
+// ChainID returns the chain id the view is bound to.
+func (c *ContractCache) ChainID() uint64 {
+	return c.chainID
+}
+
+// Fetch returns the cached value of field, or calls fetch and caches its result. tokenID is nil for contract-level fields.
+func (c *ContractCache) Fetch(field string, tokenID *big.Int, fetch func() (string, error)) (string, error) {
+	if c == nil {
+		return fetch()
+	}
+	key := Key{ChainID: c.chainID, Address: c.address, Field: field}
+	if tokenID != nil {
+		key.TokenID = tokenID.String()
+	}
+	return c.cache.Fetch(key, fetch)
+}
@@ -0,0 +1,230 @@
+package cache_test
+
+// Package cache_test contains tests for the metadata cache, its memory store and the ERC-4906 watcher.
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/cache"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/OCharless/eth-interfaces/inferences/ERC4906Metadata"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingObserver counts the observed calls per method.
+type countingObserver struct {
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (o *countingObserver) Before(ctx context.Context, op base.Operation) context.Context {
+	return ctx
+}
+
+func (o *countingObserver) After(ctx context.Context, op base.Operation, result base.OperationResult) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.calls[op.Method]++
+}
+
+func (o *countingObserver) count(method string) int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.calls[method]
+}
+
+// Test_MemoryStore verifies expiry and least recently used eviction of the memory store.
+func Test_MemoryStore(t *testing.T) {
+	key := func(field string) cache.Key {
+		return cache.Key{ChainID: 1, Address: common.HexToAddress("0x01"), Field: field}
+	}
+
+	t.Run("OK - Expiry", func(t *testing.T) {
+		store := cache.NewMemoryStore(0)
+		store.Set(key("name"), "expired", time.Now().Add(-time.Second))
+		store.Set(key("symbol"), "valid", time.Now().Add(time.Hour))
+		store.Set(key("decimals"), "forever", time.Time{})
+
+		_, ok := store.Get(key("name"))
+		assert.False(t, ok)
+		value, ok := store.Get(key("symbol"))
+		assert.True(t, ok)
+		assert.Equal(t, "valid", value)
+		value, ok = store.Get(key("decimals"))
+		assert.True(t, ok)
+		assert.Equal(t, "forever", value)
+	})
+
+	t.Run("OK - Eviction", func(t *testing.T) {
+		store := cache.NewMemoryStore(2)
+		store.Set(key("name"), "name", time.Time{})
+		store.Set(key("symbol"), "symbol", time.Time{})
+		_, _ = store.Get(key("name"))
+		store.Set(key("decimals"), "18", time.Time{})
+
+		assert.Equal(t, 2, store.Len())
+		_, ok := store.Get(key("symbol"))
+		assert.False(t, ok)
+		_, ok = store.Get(key("name"))
+		assert.True(t, ok)
+		_, ok = store.Get(key("decimals"))
+		assert.True(t, ok)
+	})
+}
+
+// Test_Invalidate verifies that invalidation only drops the targeted entries.
+func Test_Invalidate(t *testing.T) {
+	address := common.HexToAddress("0x01")
+	other := common.HexToAddress("0x02")
+	fill := func() *cache.Cache {
+		c := cache.New(cache.Config{})
+		for _, addr := range []common.Address{address, other} {
+			view := c.Contract(1, addr)
+			_, _ = view.Fetch("name", nil, func() (string, error) { return "name", nil })
+			for id := int64(0); id < 5; id++ {
+				_, _ = view.Fetch("tokenURI", big.NewInt(id), func() (string, error) { return "uri", nil })
+			}
+		}
+		return c
+	}
+	cached := func(c *cache.Cache, addr common.Address, id int64) bool {
+		key := cache.Key{ChainID: 1, Address: addr, Field: "tokenURI", TokenID: big.NewInt(id).String()}
+		_, ok := c.Store().Get(key)
+		return ok
+	}
+	nameCached := func(c *cache.Cache, addr common.Address) bool {
+		_, ok := c.Store().Get(cache.Key{ChainID: 1, Address: addr, Field: "name"})
+		return ok
+	}
+
+	t.Run("OK - Token", func(t *testing.T) {
+		c := fill()
+		c.InvalidateToken(1, address, big.NewInt(2))
+		assert.False(t, cached(c, address, 2))
+		assert.True(t, cached(c, address, 1))
+		assert.True(t, cached(c, other, 2))
+		assert.True(t, nameCached(c, address))
+	})
+
+	t.Run("OK - Range", func(t *testing.T) {
+		c := fill()
+		c.InvalidateTokens(1, address, big.NewInt(1), big.NewInt(3))
+		assert.True(t, cached(c, address, 0))
+		assert.False(t, cached(c, address, 1))
+		assert.False(t, cached(c, address, 3))
+		assert.True(t, cached(c, address, 4))
+		assert.True(t, cached(c, other, 2))
+		assert.True(t, nameCached(c, address))
+	})
+
+	t.Run("OK - Contract", func(t *testing.T) {
+		c := fill()
+		c.InvalidateContract(1, address)
+		assert.False(t, cached(c, address, 0))
+		assert.False(t, nameCached(c, address))
+		assert.True(t, nameCached(c, other))
+	})
+}
+
+// Test_ERC20Cache verifies that cached token fields are only fetched once.
+func Test_ERC20Cache(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC20Burnable.ERC20BurnableABI,
+		ERC20Burnable.ERC20BurnableBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	observer := &countingObserver{calls: map[string]int{}}
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	baseInteractions.SetObserver(observer)
+	token, err := erc20.NewIERC20Interactions(baseInteractions, *contractAddr, []erc20.BaseERC20Signature{}, auth)
+	assert.Nil(t, err)
+	assert.Nil(t, token.SetCache(cache.New(cache.DefaultConfig)))
+
+	for range 3 {
+		meta, err := token.TokenMetaInfos()
+		assert.Nil(t, err)
+		assert.NotEmpty(t, meta.Name)
+		decimals, err := token.Decimals()
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(18), decimals)
+	}
+	assert.Equal(t, 1, observer.count("erc20.Name()"))
+	assert.Equal(t, 1, observer.count("erc20.Symbol()"))
+	assert.Equal(t, 1, observer.count("erc20.Decimals()"))
+
+	assert.Nil(t, token.SetCache(nil))
+	_, err = token.Name()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, observer.count("erc20.Name()"))
+}
+
+// Test_ERC4906Watcher verifies that metadata update events invalidate the cached token URIs.
+func Test_ERC4906Watcher(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC4906Metadata.ERC4906MetadataABI,
+		ERC4906Metadata.ERC4906MetadataBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	observer := &countingObserver{calls: map[string]int{}}
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	baseInteractions.SetObserver(observer)
+	collection, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{}, auth)
+	assert.Nil(t, err)
+
+	_, err = collection.WatchMetadataUpdates()
+	assert.Error(t, err)
+
+	assert.Nil(t, collection.SetCache(cache.New(cache.DefaultConfig)))
+	watcher, err := collection.WatchMetadataUpdates()
+	assert.Nil(t, err)
+	defer watcher.Close()
+
+	emitter, err := ERC4906Metadata.NewERC4906Metadata(*contractAddr, backend.Client())
+	assert.Nil(t, err)
+
+	uri := func(id int64) string {
+		value, err := collection.TokenURI(big.NewInt(id))
+		assert.Nil(t, err)
+		return value
+	}
+	assert.Equal(t, "uri-0", uri(1))
+	assert.Equal(t, "uri-0", uri(2))
+	assert.Equal(t, 2, observer.count("nft.TokenURI()"))
+
+	t.Run("OK - MetadataUpdate", func(t *testing.T) {
+		_, err := emitter.UpdateToken(auth, big.NewInt(1))
+		assert.Nil(t, err)
+		backend.Commit()
+
+		assert.Eventually(t, func() bool { return uri(1) == "uri-1" }, 5*time.Second, 10*time.Millisecond)
+		calls := observer.count("nft.TokenURI()")
+		assert.Equal(t, "uri-0", uri(2))
+		assert.Equal(t, calls, observer.count("nft.TokenURI()"))
+	})
+
+	t.Run("OK - BatchMetadataUpdate", func(t *testing.T) {
+		_, err := emitter.UpdateAll(auth, big.NewInt(0), big.NewInt(10))
+		assert.Nil(t, err)
+		backend.Commit()
+
+		assert.Eventually(t, func() bool { return uri(2) == "uri-1" }, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, "uri-2", uri(1))
+	})
+
+	watcher.Close()
+	<-watcher.Done()
+	assert.Nil(t, watcher.Err())
+}
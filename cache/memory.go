@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type memoryEntry struct {
+	key       Key
+	value     string
+	expiresAt time.Time
+}
+
+// MemoryStore is an in-memory Store evicting the least recently used values once full.
+type MemoryStore struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[Key]*list.Element
+	order      *list.List
+}
+
+// NewMemoryStore creates a new MemoryStore holding at most maxEntries values, zero means unbounded.
+func NewMemoryStore(maxEntries int) *MemoryStore {
+	return &MemoryStore{
+		maxEntries: maxEntries,
+		entries:    map[Key]*list.Element{},
+		order:      list.New(),
+	}
+}
+
+// Get returns the value stored under key, if any and not expired.
+func (m *MemoryStore) Get(key Key) (string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	element, ok := m.entries[key]
+	if !ok {
+		return "", false
+	}
+	entry := element.Value.(*memoryEntry)
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		m.remove(element)
+		return "", false
+	}
+	m.order.MoveToFront(element)
+	return entry.value, true
+}
+
+// Set stores value under key, evicting the least recently used value when the store is full.
+func (m *MemoryStore) Set(key Key, value string, expiresAt time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if element, ok := m.entries[key]; ok {
+		element.Value = &memoryEntry{key, value, expiresAt}
+		m.order.MoveToFront(element)
+		return
+	}
+	m.entries[key] = m.order.PushFront(&memoryEntry{key, value, expiresAt})
+	if m.maxEntries > 0 && m.order.Len() > m.maxEntries {
+		m.remove(m.order.Back())
+	}
+}
+
+// Delete removes the value stored under key.
+func (m *MemoryStore) Delete(key Key) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if element, ok := m.entries[key]; ok {
+		m.remove(element)
+	}
+}
+
+// DeleteMatching removes every value whose key matches.
+func (m *MemoryStore) DeleteMatching(match func(Key) bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key, element := range m.entries {
+		if match(key) {
+			m.remove(element)
+		}
+	}
+}
+
+// Len returns the number of stored values, expired ones included.
+func (m *MemoryStore) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.order.Len()
+}
+
+func (m *MemoryStore) remove(element *list.Element) {
+	m.order.Remove(element)
+	delete(m.entries, element.Value.(*memoryEntry).key)
+}
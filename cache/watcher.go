@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// MetadataUpdateTopic is the topic of the ERC-4906 MetadataUpdate(uint256) event.
+	MetadataUpdateTopic = crypto.Keccak256Hash([]byte("MetadataUpdate(uint256)"))
+	// BatchMetadataUpdateTopic is the topic of the ERC-4906 BatchMetadataUpdate(uint256,uint256) event.
+	BatchMetadataUpdateTopic = crypto.Keccak256Hash([]byte("BatchMetadataUpdate(uint256,uint256)"))
+)
+
+// Watcher invalidates cached token metadata when ERC-4906 metadata update events are emitted.
+type Watcher struct {
+	cache   *Cache
+	chainID uint64
+	sub     ethereum.Subscription
+	done    chan struct{}
+	once    sync.Once
+	mu      sync.Mutex
+	err     error
+}
+
+// Watch subscribes to the ERC-4906 events of the given contracts and invalidates the matching cache entries until the watcher is closed or ctx is done.
+func (c *Cache) Watch(ctx context.Context, client ethereum.LogFilterer, chainID uint64, addresses ...common.Address) (*Watcher, error) {
+	logs := make(chan types.Log)
+	query := ethereum.FilterQuery{
+		Addresses: addresses,
+		Topics:    [][]common.Hash{{MetadataUpdateTopic, BatchMetadataUpdateTopic}},
+	}
+	sub, err := client.SubscribeFilterLogs(ctx, query, logs)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watcher{cache: c, chainID: chainID, sub: sub, done: make(chan struct{})}
+	go w.loop(ctx, logs)
+	return w, nil
+}
+
+// Watch subscribes to the ERC-4906 events of the contract and invalidates its cache entries.
+func (c *ContractCache) Watch(ctx context.Context, client ethereum.LogFilterer) (*Watcher, error) {
+	return c.cache.Watch(ctx, client, c.chainID, c.address)
+}
+
+func (w *Watcher) loop(ctx context.Context, logs <-chan types.Log) {
+	for {
+		select {
+		case log := <-logs:
+			w.invalidate(log)
+		case err := <-w.sub.Err():
+			w.stop(err)
+			return
+		case <-ctx.Done():
+			w.stop(ctx.Err())
+			return
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// invalidate drops the cache entries a log refers to. Malformed events invalidate the whole contract.
+func (w *Watcher) invalidate(log types.Log) {
+	if len(log.Topics) == 0 {
+		return
+	}
+	switch log.Topics[0] {
+	case MetadataUpdateTopic:
+		if len(log.Data) < 32 {
+			w.cache.InvalidateContract(w.chainID, log.Address)
+			return
+		}
+		w.cache.InvalidateToken(w.chainID, log.Address, new(big.Int).SetBytes(log.Data[:32]))
+	case BatchMetadataUpdateTopic:
+		if len(log.Data) < 64 {
+			w.cache.InvalidateContract(w.chainID, log.Address)
+			return
+		}
+		from := new(big.Int).SetBytes(log.Data[:32])
+		to := new(big.Int).SetBytes(log.Data[32:64])
+		w.cache.InvalidateTokens(w.chainID, log.Address, from, to)
+	}
+}
+
+// Done returns a channel closed once the watcher stopped.
+func (w *Watcher) Done() <-chan struct{} {
+	return w.done
+}
+
+// Err returns the error that stopped the watcher, nil when it was closed or is still running.
+func (w *Watcher) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+// stop records the error that stopped the watcher, unless it was already closed, and closes it.
+func (w *Watcher) stop(err error) {
+	w.mu.Lock()
+	select {
+	case <-w.done:
+	default:
+		w.err = err
+	}
+	w.mu.Unlock()
+	w.Close()
+}
+
+// Close stops the watcher and unsubscribes from the events.
+func (w *Watcher) Close() {
+	w.once.Do(func() {
+		w.sub.Unsubscribe()
+		close(w.done)
+	})
+}
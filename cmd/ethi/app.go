@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/urfave/cli/v2"
+)
+
+// dialer connects to a node, tests replace it to run the commands against a simulated chain.
+type dialer func(ctx context.Context, url string) (simulated.Client, error)
+
+func dialRPC(ctx context.Context, url string) (simulated.Client, error) {
+	return ethclient.DialContext(ctx, url)
+}
+
+// chains maps the chain names accepted by --chain to their id.
+var chains = map[string]uint64{
+	"mainnet":   1,
+	"sepolia":   11155111,
+	"holesky":   17000,
+	"optimism":  10,
+	"polygon":   137,
+	"base":      8453,
+	"arbitrum":  42161,
+	"anvil":     31337,
+	"simulated": 1337,
+}
+
+func newApp(dial dialer, stdout io.Writer) *cli.App {
+	return &cli.App{
+		Name:      "ethi",
+		Usage:     "interact with ERC20, ERC721 and ERC1155 contracts",
+		Writer:    stdout,
+		ErrWriter: stdout,
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "rpc", Usage: "RPC URL of the node", Value: "http://localhost:8545", EnvVars: []string{"ETH_RPC_URL"}},
+			&cli.StringFlag{Name: "key", Usage: "key source: hex key, env:NAME, file:PATH or keystore:PATH", Value: "env:PRIVATE_KEY", EnvVars: []string{"ETHI_KEY"}},
+			&cli.StringFlag{Name: "chain", Usage: "expected chain, by id or name such as mainnet or sepolia", EnvVars: []string{"ETH_CHAIN"}},
+			&cli.StringFlag{Name: "output", Aliases: []string{"o"}, Usage: "output format: table or json", Value: "table", EnvVars: []string{"ETHI_OUTPUT"}},
+			&cli.BoolFlag{Name: "wait", Usage: "wait for sent transactions to be mined"},
+		},
+		Before: func(cCtx *cli.Context) error {
+			if output := cCtx.String("output"); output != "table" && output != "json" {
+				return fmt.Errorf("unknown output format %q", output)
+			}
+			return nil
+		},
+		Commands: []*cli.Command{
+			probeCommand(dial),
+			erc20Command(dial),
+			nftCommand(dial),
+			disperseCommand(dial),
+			sweepCommand(dial),
+		},
+	}
+}
+
+// session holds the connection and the account used by a command.
+type session struct {
+	ctx    context.Context
+	client simulated.Client
+	base   *base.BaseInteractions
+	// signer tells whether the account key was configured, commands sending transactions require it.
+	signer bool
+	out    *printer
+	wait   bool
+	// explorer links the sent transactions, nil on chains without a preset.
+	explorer *base.Explorer
+}
+
+// newSession connects to the node, checks the chain and loads the key. Without a key, read-only commands run with a throwaway account.
+func newSession(cCtx *cli.Context, dial dialer) (*session, error) {
+	ctx := cCtx.Context
+	client, err := dial(ctx, cCtx.String("rpc"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", cCtx.String("rpc"), err)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+	if chain := cCtx.String("chain"); chain != "" {
+		if err := checkChain(chainID, chain); err != nil {
+			return nil, err
+		}
+	}
+
+	signer := true
+	key, err := utils.LoadPrivateKey(cCtx.String("key"))
+	if errors.Is(err, utils.ErrNoKey) {
+		signer = false
+		key, err = crypto.GenerateKey()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &session{
+		ctx:      ctx,
+		client:   client,
+		base:     base.NewBaseInteractions(client, key, nil),
+		signer:   signer,
+		out:      &printer{w: cCtx.App.Writer, json: cCtx.String("output") == "json"},
+		wait:     cCtx.Bool("wait"),
+		explorer: base.ExplorerForChain(chainID),
+	}, nil
+}
+
+func checkChain(chainID *big.Int, chain string) error {
+	expected, ok := chains[strings.ToLower(chain)]
+	if !ok {
+		id, err := strconv.ParseUint(chain, 10, 64)
+		if err != nil {
+			return fmt.Errorf("unknown chain %q", chain)
+		}
+		expected = id
+	}
+	if chainID.Cmp(new(big.Int).SetUint64(expected)) != 0 {
+		return fmt.Errorf("connected to chain %s, expected %d", chainID, expected)
+	}
+	return nil
+}
+
+// requireSigner fails when no key is configured, for the commands sending transactions.
+func (s *session) requireSigner() error {
+	if !s.signer {
+		return errors.New("a private key is required to send transactions, see --key")
+	}
+	return nil
+}
+
+// owner returns the address given as argument, the session account when it is missing.
+func (s *session) owner(arg string) (common.Address, error) {
+	if arg != "" {
+		return parseAddress(arg)
+	}
+	if !s.signer {
+		return common.Address{}, errors.New("an address or a private key is required, see --key")
+	}
+	return s.base.Address, nil
+}
+
+// sent prints a sent transaction, along with its receipt when waiting for it.
+func (s *session) sent(tx *types.Transaction, err error) error {
+	if err != nil {
+		return err
+	}
+	result := txResult{Hash: tx.Hash(), Link: s.explorer.TxURL(tx.Hash())}
+	if s.wait {
+		receipt, err := bind.WaitMined(s.ctx, s.client, tx)
+		if err != nil {
+			return fmt.Errorf("failed to wait for %s: %w", tx.Hash().Hex(), err)
+		}
+		result.Status, result.Block, result.GasUsed = &receipt.Status, receipt.BlockNumber, receipt.GasUsed
+	}
+	return s.out.print(result, result.rows())
+}
+
+// txResult is the output of the commands sending a transaction.
+type txResult struct {
+	Hash    common.Hash `json:"hash"`
+	Link    string      `json:"link,omitempty"`
+	Status  *uint64     `json:"status,omitempty"`
+	Block   *big.Int    `json:"block,omitempty"`
+	GasUsed uint64      `json:"gasUsed,omitempty"`
+}
+
+func (r txResult) rows() [][]string {
+	rows := [][]string{{"Hash", r.Hash.Hex()}}
+	if r.Link != "" {
+		rows = append(rows, []string{"Link", r.Link})
+	}
+	if r.Status != nil {
+		rows = append(rows, []string{"Status", strconv.FormatUint(*r.Status, 10)}, []string{"Block", r.Block.String()}, []string{"Gas used", strconv.FormatUint(r.GasUsed, 10)})
+	}
+	return rows
+}
+
+func parseAddress(arg string) (common.Address, error) {
+	if !common.IsHexAddress(arg) {
+		return common.Address{}, fmt.Errorf("invalid address %q", arg)
+	}
+	return common.HexToAddress(arg), nil
+}
+
+func parseInt(arg string) (*big.Int, error) {
+	value, ok := new(big.Int).SetString(arg, 0)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("invalid number %q", arg)
+	}
+	return value, nil
+}
+
+// args checks the number of arguments of a command, optional ones being returned empty.
+func args(cCtx *cli.Context, required, optional int) ([]string, error) {
+	n := cCtx.NArg()
+	if n < required || n > required+optional {
+		return nil, fmt.Errorf("usage: %s %s", cCtx.Command.FullName(), cCtx.Command.ArgsUsage)
+	}
+	values := make([]string, required+optional)
+	copy(values, cCtx.Args().Slice())
+	return values, nil
+}
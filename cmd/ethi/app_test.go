@@ -0,0 +1,227 @@
+package main
+
+// Tests of the ethi commands against a simulated chain.
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/probe"
+	"github.com/OCharless/eth-interfaces/testkit"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+)
+
+// run executes ethi against the kit chain with the key of its first account.
+func run(kit *testkit.Kit, args ...string) (string, error) {
+	out := &bytes.Buffer{}
+	dial := func(context.Context, string) (simulated.Client, error) {
+		return kit.Client(), nil
+	}
+	key := hex.EncodeToString(crypto.FromECDSA(kit.Accounts[0].Key))
+	err := newApp(dial, out).Run(append([]string{"ethi", "--key", key}, args...))
+	return out.String(), err
+}
+
+// Test_ERC20 verifies the erc20 commands.
+func Test_ERC20(t *testing.T) {
+	kit := testkit.New(t).WithAccounts(2).WithERC20Balance(big.NewInt(1e18)).Build()
+	token, other := kit.ERC20.Hex(), kit.Accounts[1]
+
+	t.Run("OK - Balance", func(t *testing.T) {
+		out, err := run(kit, "-o", "json", "erc20", "balance", token, other.Address.Hex())
+		assert.Nil(t, err)
+		result := tokenAmount{}
+		assert.Nil(t, json.Unmarshal([]byte(out), &result))
+		assert.Equal(t, other.Address, result.Owner)
+		assert.Equal(t, "1000000000000000000", result.Balance.String())
+		assert.Equal(t, "1", result.Amount)
+	})
+
+	t.Run("OK - Transfer", func(t *testing.T) {
+		out, err := run(kit, "erc20", "transfer", token, other.Address.Hex(), "0.5")
+		assert.Nil(t, err)
+		assert.Contains(t, out, "Hash")
+		kit.Commit()
+		balance, err := other.ERC20.GetBalance()
+		assert.Nil(t, err)
+		assert.Equal(t, "1500000000000000000", balance.String())
+	})
+
+	t.Run("OK - Approve and allowance", func(t *testing.T) {
+		_, err := run(kit, "erc20", "approve", "--raw", token, other.Address.Hex(), "42")
+		assert.Nil(t, err)
+		kit.Commit()
+		out, err := run(kit, "-o", "json", "erc20", "allowance", token, other.Address.Hex())
+		assert.Nil(t, err)
+		result := tokenAmount{}
+		assert.Nil(t, json.Unmarshal([]byte(out), &result))
+		assert.Equal(t, "42", result.Balance.String())
+		assert.Equal(t, &other.Address, result.Spender)
+	})
+
+	t.Run("OK - Burn", func(t *testing.T) {
+		before, err := kit.Accounts[0].ERC20.GetBalance()
+		assert.Nil(t, err)
+		_, err = run(kit, "erc20", "burn", token, "2")
+		assert.Nil(t, err)
+		kit.Commit()
+		after, err := kit.Accounts[0].ERC20.GetBalance()
+		assert.Nil(t, err)
+		assert.Equal(t, "2000000000000000000", new(big.Int).Sub(before, after).String())
+	})
+
+	t.Run("KO - Invalid arguments", func(t *testing.T) {
+		testCases := []struct {
+			Name string
+			Args []string
+		}{
+			{"Missing amount", []string{"erc20", "transfer", token, other.Address.Hex()}},
+			{"Invalid address", []string{"erc20", "balance", "0x1234"}},
+			{"Invalid amount", []string{"erc20", "transfer", token, other.Address.Hex(), "one"}},
+			{"Wrong chain", []string{"--chain", "mainnet", "erc20", "balance", token}},
+			{"Unknown output", []string{"-o", "yaml", "erc20", "balance", token}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.Name, func(t *testing.T) {
+				_, err := run(kit, tc.Args...)
+				assert.NotNil(t, err)
+			})
+		}
+	})
+}
+
+// Test_NFT verifies the nft commands.
+func Test_NFT(t *testing.T) {
+	kit := testkit.New(t).WithAccounts(2).WithERC721Tokens(2).Build()
+	collection, other := kit.ERC721.Hex(), kit.Accounts[1]
+
+	t.Run("OK - Owner", func(t *testing.T) {
+		out, err := run(kit, "-o", "json", "nft", "owner", collection, "1")
+		assert.Nil(t, err)
+		result := tokenOwner{}
+		assert.Nil(t, json.Unmarshal([]byte(out), &result))
+		assert.Equal(t, other.Address, result.Owner)
+	})
+
+	t.Run("OK - Tokens", func(t *testing.T) {
+		out, err := run(kit, "-o", "json", "nft", "tokens", collection, other.Address.Hex())
+		assert.Nil(t, err)
+		result := ownedTokens{}
+		assert.Nil(t, json.Unmarshal([]byte(out), &result))
+		assert.Equal(t, "[0 1]", fmt.Sprint(result.TokenIDs))
+	})
+
+	t.Run("OK - Meta", func(t *testing.T) {
+		out, err := run(kit, "nft", "meta", collection, "3")
+		assert.Nil(t, err)
+		assert.Contains(t, out, "MyNFT")
+		assert.Contains(t, out, "MNFT")
+	})
+
+	t.Run("OK - Royalty", func(t *testing.T) {
+		out, err := run(kit, "-o", "json", "nft", "royalty", "--price", "1000", collection, "3")
+		assert.Nil(t, err)
+		result := tokenRoyalty{}
+		assert.Nil(t, json.Unmarshal([]byte(out), &result))
+		assert.Equal(t, "1000", result.SalePrice.String())
+	})
+
+	t.Run("OK - Transfer", func(t *testing.T) {
+		_, err := run(kit, "nft", "transfer", collection, other.Address.Hex(), "5")
+		assert.Nil(t, err)
+		kit.Commit()
+		owner, err := other.ERC721.OwnerOf(big.NewInt(5))
+		assert.Nil(t, err)
+		assert.Equal(t, other.Address, owner)
+	})
+}
+
+// Test_ETH verifies the commands sending ether.
+func Test_ETH(t *testing.T) {
+	kit := testkit.New(t).WithAccounts(2).WithBalance(new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))).WithDisperse().Build()
+	ctx := context.Background()
+
+	t.Run("OK - Disperse", func(t *testing.T) {
+		// Disperse waits for its transaction to be mined.
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				case <-time.After(10 * time.Millisecond):
+					kit.Commit()
+				}
+			}
+		}()
+		testCases := []struct {
+			Name       string
+			Value      string
+			Recipients []common.Address
+			Expected   string
+		}{
+			{"Small value", "0.1", []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2")}, "50000000000000000"},
+			{"Value above a uint64 of wei", "20", []common.Address{common.HexToAddress("0x4"), common.HexToAddress("0x5")}, "10000000000000000000"},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.Name, func(t *testing.T) {
+				_, err := run(kit, "disperse", "--contract", kit.Disperse.Hex(), "--value", tc.Value, tc.Recipients[0].Hex(), tc.Recipients[1].Hex())
+				assert.Nil(t, err)
+				for _, recipient := range tc.Recipients {
+					balance, err := kit.Client().BalanceAt(ctx, recipient, nil)
+					assert.Nil(t, err)
+					assert.Equal(t, tc.Expected, balance.String())
+				}
+			})
+		}
+	})
+
+	t.Run("OK - Sweep", func(t *testing.T) {
+		to := common.HexToAddress("0x3")
+		_, err := run(kit, "sweep", to.Hex())
+		assert.Nil(t, err)
+		kit.Commit()
+		balance, err := kit.Client().BalanceAt(ctx, to, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, balance.Sign())
+		left, err := kit.Client().BalanceAt(ctx, kit.Accounts[0].Address, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, -1, left.Cmp(big.NewInt(1e15)))
+	})
+}
+
+// Test_Probe verifies the probe command.
+func Test_Probe(t *testing.T) {
+	kit := testkit.New(t).WithERC20().Build()
+
+	t.Run("OK - Burnable ERC20", func(t *testing.T) {
+		out, err := run(kit, "-o", "json", "probe", kit.ERC20.Hex())
+		assert.Nil(t, err)
+		result := probe.Capabilities{}
+		assert.Nil(t, json.Unmarshal([]byte(out), &result))
+		assert.Equal(t, kit.ERC20, result.Address)
+		assert.Equal(t, []string{"ERC20", "Burnable"}, result.Names())
+	})
+
+	t.Run("OK - Table", func(t *testing.T) {
+		out, err := run(kit, "probe", kit.ERC20.Hex())
+		assert.Nil(t, err)
+		assert.Contains(t, out, "ERC20Permit")
+		assert.Contains(t, out, "true")
+	})
+
+	t.Run("KO - No contract", func(t *testing.T) {
+		_, err := run(kit, "probe", common.HexToAddress("0x1").Hex())
+		assert.ErrorContains(t, err, "no contract deployed at")
+	})
+}
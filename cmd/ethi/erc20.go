@@ -0,0 +1,236 @@
+package main
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/erc20/burnable"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+)
+
+var rawFlag = &cli.BoolFlag{Name: "raw", Usage: "read amounts as integers of the smallest unit instead of decimal token amounts"}
+
+// tokenAmount is the output of the commands reading an ERC20 amount.
+type tokenAmount struct {
+	Token    common.Address  `json:"token"`
+	Symbol   string          `json:"symbol"`
+	Decimals uint8           `json:"decimals"`
+	Owner    common.Address  `json:"owner"`
+	Spender  *common.Address `json:"spender,omitempty"`
+	Balance  *big.Int        `json:"balance"`
+	Amount   string          `json:"amount"`
+}
+
+func (a tokenAmount) rows() [][]string {
+	rows := [][]string{
+		{"Token", a.Token.Hex()},
+		{"Symbol", a.Symbol},
+		{"Decimals", strconv.Itoa(int(a.Decimals))},
+		{"Owner", a.Owner.Hex()},
+	}
+	if a.Spender != nil {
+		rows = append(rows, []string{"Spender", a.Spender.Hex()})
+	}
+	return append(rows, []string{"Balance", a.Balance.String()}, []string{"Amount", a.Amount})
+}
+
+func erc20Command(dial dialer) *cli.Command {
+	// token opens a session and the interactions with the token given as first argument.
+	token := func(cCtx *cli.Context, arg string) (*session, *erc20.ERC20Interactions, error) {
+		address, err := parseAddress(arg)
+		if err != nil {
+			return nil, nil, err
+		}
+		s, err := newSession(cCtx, dial)
+		if err != nil {
+			return nil, nil, err
+		}
+		interactions, err := erc20.NewIERC20Interactions(s.base, address, []erc20.BaseERC20Signature{})
+		return s, interactions, err
+	}
+	// amount parses an amount in token units, or in the smallest unit with --raw.
+	amount := func(cCtx *cli.Context, interactions *erc20.ERC20Interactions, arg string) (*big.Int, error) {
+		if cCtx.Bool("raw") {
+			return parseInt(arg)
+		}
+		decimals, err := interactions.Decimals()
+		if err != nil {
+			return nil, err
+		}
+		return utils.ParseUnits(arg, decimals)
+	}
+	// describe builds the output of an amount read from the token.
+	describe := func(interactions *erc20.ERC20Interactions, owner common.Address, balance *big.Int) (tokenAmount, error) {
+		symbol, err := interactions.Symbol()
+		if err != nil {
+			return tokenAmount{}, err
+		}
+		decimals, err := interactions.Decimals()
+		if err != nil {
+			return tokenAmount{}, err
+		}
+		return tokenAmount{
+			Token:    interactions.GetAddress(),
+			Symbol:   symbol,
+			Decimals: decimals,
+			Owner:    owner,
+			Balance:  balance,
+			Amount:   utils.FormatUnits(balance, decimals),
+		}, nil
+	}
+
+	return &cli.Command{
+		Name:  "erc20",
+		Usage: "read and transfer ERC20 tokens",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "balance",
+				Usage:     "print the balance of an address, the account by default",
+				ArgsUsage: "<token> [owner]",
+				Action: func(cCtx *cli.Context) error {
+					values, err := args(cCtx, 1, 1)
+					if err != nil {
+						return err
+					}
+					s, interactions, err := token(cCtx, values[0])
+					if err != nil {
+						return err
+					}
+					owner, err := s.owner(values[1])
+					if err != nil {
+						return err
+					}
+					balance, err := interactions.BalanceOf(owner)
+					if err != nil {
+						return err
+					}
+					result, err := describe(interactions, owner, balance)
+					if err != nil {
+						return err
+					}
+					return s.out.print(result, result.rows())
+				},
+			},
+			{
+				Name:      "allowance",
+				Usage:     "print the amount a spender may transfer from an owner, the account by default",
+				ArgsUsage: "<token> <spender> [owner]",
+				Action: func(cCtx *cli.Context) error {
+					values, err := args(cCtx, 2, 1)
+					if err != nil {
+						return err
+					}
+					s, interactions, err := token(cCtx, values[0])
+					if err != nil {
+						return err
+					}
+					spender, err := parseAddress(values[1])
+					if err != nil {
+						return err
+					}
+					owner, err := s.owner(values[2])
+					if err != nil {
+						return err
+					}
+					allowance, err := interactions.Allowance(owner, spender)
+					if err != nil {
+						return err
+					}
+					result, err := describe(interactions, owner, allowance)
+					if err != nil {
+						return err
+					}
+					result.Spender = &spender
+					return s.out.print(result, result.rows())
+				},
+			},
+			{
+				Name:      "transfer",
+				Usage:     "transfer tokens from the account",
+				ArgsUsage: "<token> <to> <amount>",
+				Flags:     []cli.Flag{rawFlag},
+				Action: func(cCtx *cli.Context) error {
+					values, err := args(cCtx, 3, 0)
+					if err != nil {
+						return err
+					}
+					s, interactions, err := token(cCtx, values[0])
+					if err != nil {
+						return err
+					}
+					if err := s.requireSigner(); err != nil {
+						return err
+					}
+					to, err := parseAddress(values[1])
+					if err != nil {
+						return err
+					}
+					value, err := amount(cCtx, interactions, values[2])
+					if err != nil {
+						return err
+					}
+					return s.sent(interactions.TransferTo(to, value))
+				},
+			},
+			{
+				Name:      "approve",
+				Usage:     "allow a spender to transfer tokens from the account",
+				ArgsUsage: "<token> <spender> <amount>",
+				Flags:     []cli.Flag{rawFlag},
+				Action: func(cCtx *cli.Context) error {
+					values, err := args(cCtx, 3, 0)
+					if err != nil {
+						return err
+					}
+					s, interactions, err := token(cCtx, values[0])
+					if err != nil {
+						return err
+					}
+					if err := s.requireSigner(); err != nil {
+						return err
+					}
+					spender, err := parseAddress(values[1])
+					if err != nil {
+						return err
+					}
+					value, err := amount(cCtx, interactions, values[2])
+					if err != nil {
+						return err
+					}
+					return s.sent(interactions.Approve(spender, value))
+				},
+			},
+			{
+				Name:      "burn",
+				Usage:     "burn tokens of the account",
+				ArgsUsage: "<token> <amount>",
+				Flags:     []cli.Flag{rawFlag},
+				Action: func(cCtx *cli.Context) error {
+					values, err := args(cCtx, 2, 0)
+					if err != nil {
+						return err
+					}
+					s, interactions, err := token(cCtx, values[0])
+					if err != nil {
+						return err
+					}
+					if err := s.requireSigner(); err != nil {
+						return err
+					}
+					value, err := amount(cCtx, interactions, values[1])
+					if err != nil {
+						return err
+					}
+					burnableInteractions, err := burnable.NewIERC20Burnable(interactions, []burnable.ERC20BurnableSignatures{burnable.Burn})
+					if err != nil {
+						return err
+					}
+					return s.sent(burnableInteractions.Burn(value))
+				},
+			},
+		},
+	}
+}
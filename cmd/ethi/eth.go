@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+)
+
+// disperseResult is the output of disperse, which waits for its transaction to be mined.
+type disperseResult struct {
+	Hash       string           `json:"hash"`
+	Link       string           `json:"link,omitempty"`
+	Recipients []common.Address `json:"recipients"`
+	Value      *big.Int         `json:"value"`
+}
+
+func disperseCommand(dial dialer) *cli.Command {
+	return &cli.Command{
+		Name:      "disperse",
+		Usage:     "split an amount of ether evenly between addresses through a Disperse contract",
+		ArgsUsage: "<address>...",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "contract", Usage: "address of the Disperse contract", Required: true, EnvVars: []string{"ETHI_DISPERSE"}},
+			&cli.StringFlag{Name: "value", Usage: "total amount of ether to send", Required: true},
+		},
+		Action: func(cCtx *cli.Context) error {
+			if cCtx.NArg() == 0 {
+				return fmt.Errorf("usage: %s %s", cCtx.Command.FullName(), cCtx.Command.ArgsUsage)
+			}
+			contract, err := parseAddress(cCtx.String("contract"))
+			if err != nil {
+				return err
+			}
+			recipients := make([]common.Address, cCtx.NArg())
+			for i, arg := range cCtx.Args().Slice() {
+				if recipients[i], err = parseAddress(arg); err != nil {
+					return err
+				}
+			}
+			value, err := utils.ParseUnits(cCtx.String("value"), 18)
+			if err != nil {
+				return err
+			}
+
+			s, err := newSession(cCtx, dial)
+			if err != nil {
+				return err
+			}
+			if err := s.requireSigner(); err != nil {
+				return err
+			}
+			if err := s.base.SetDisperse(contract.Hex()); err != nil {
+				return err
+			}
+			hash, err := s.base.DisperseEther(recipients, value)
+			if err != nil {
+				return err
+			}
+			result := disperseResult{hash, s.explorer.TxURL(common.HexToHash(hash)), recipients, value}
+			rows := [][]string{{"Hash", hash}}
+			if result.Link != "" {
+				rows = append(rows, []string{"Link", result.Link})
+			}
+			return s.out.print(result, append(rows,
+				[]string{"Recipients", fmt.Sprint(len(recipients))},
+				[]string{"Value", value.String()},
+			))
+		},
+	}
+}
+
+func sweepCommand(dial dialer) *cli.Command {
+	return &cli.Command{
+		Name:      "sweep",
+		Usage:     "send the whole ether balance of the account, minus the fees",
+		ArgsUsage: "<to>",
+		Action: func(cCtx *cli.Context) error {
+			values, err := args(cCtx, 1, 0)
+			if err != nil {
+				return err
+			}
+			to, err := parseAddress(values[0])
+			if err != nil {
+				return err
+			}
+			s, err := newSession(cCtx, dial)
+			if err != nil {
+				return err
+			}
+			if err := s.requireSigner(); err != nil {
+				return err
+			}
+			return s.sent(s.base.SendAllFunds(to))
+		},
+	}
+}
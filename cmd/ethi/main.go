@@ -0,0 +1,23 @@
+// Command ethi interacts with ERC20 and ERC721 contracts from the command line.
+//
+// The global flags can be set in the environment or in a .env file of the working directory.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	if err := godotenv.Load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		fmt.Fprintln(os.Stderr, "failed to load .env:", err)
+		os.Exit(1)
+	}
+	if err := newApp(dialRPC, os.Stdout).Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/nft/enumerable"
+	"github.com/OCharless/eth-interfaces/nft/royalties"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+)
+
+// tokenOwner is the output of nft owner.
+type tokenOwner struct {
+	Collection common.Address `json:"collection"`
+	TokenID    *big.Int       `json:"tokenId"`
+	Owner      common.Address `json:"owner"`
+}
+
+// ownedTokens is the output of nft tokens.
+type ownedTokens struct {
+	Collection common.Address `json:"collection"`
+	Owner      common.Address `json:"owner"`
+	TokenIDs   []*big.Int     `json:"tokenIds"`
+}
+
+// tokenMeta is the output of nft meta.
+type tokenMeta struct {
+	Collection common.Address `json:"collection"`
+	TokenID    *big.Int       `json:"tokenId"`
+	Name       string         `json:"name"`
+	Symbol     string         `json:"symbol"`
+	URI        string         `json:"uri"`
+}
+
+// tokenRoyalty is the output of nft royalty.
+type tokenRoyalty struct {
+	Collection  common.Address `json:"collection"`
+	TokenID     *big.Int       `json:"tokenId"`
+	SalePrice   *big.Int       `json:"salePrice"`
+	Receiver    common.Address `json:"receiver"`
+	Amount      *big.Int       `json:"amount"`
+	BasisPoints *big.Int       `json:"basisPoints"`
+}
+
+func nftCommand(dial dialer) *cli.Command {
+	// collection opens a session and the interactions with the collection given as first argument.
+	collection := func(cCtx *cli.Context, arg string) (*session, *nft.ERC721Interactions, error) {
+		address, err := parseAddress(arg)
+		if err != nil {
+			return nil, nil, err
+		}
+		s, err := newSession(cCtx, dial)
+		if err != nil {
+			return nil, nil, err
+		}
+		interactions, err := nft.NewERC721Interactions(s.base, address, []nft.BaseNFTSignature{})
+		return s, interactions, err
+	}
+
+	return &cli.Command{
+		Name:  "nft",
+		Usage: "read and transfer ERC721 tokens",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "owner",
+				Usage:     "print the owner of a token",
+				ArgsUsage: "<collection> <id>",
+				Action: func(cCtx *cli.Context) error {
+					values, err := args(cCtx, 2, 0)
+					if err != nil {
+						return err
+					}
+					s, interactions, err := collection(cCtx, values[0])
+					if err != nil {
+						return err
+					}
+					id, err := parseInt(values[1])
+					if err != nil {
+						return err
+					}
+					owner, err := interactions.OwnerOf(id)
+					if err != nil {
+						return err
+					}
+					result := tokenOwner{interactions.GetAddress(), id, owner}
+					return s.out.print(result, [][]string{
+						{"Collection", result.Collection.Hex()},
+						{"Token", id.String()},
+						{"Owner", owner.Hex()},
+					})
+				},
+			},
+			{
+				Name:      "tokens",
+				Usage:     "list the tokens of an address, the account by default, on an enumerable collection",
+				ArgsUsage: "<collection> [owner]",
+				Action: func(cCtx *cli.Context) error {
+					values, err := args(cCtx, 1, 1)
+					if err != nil {
+						return err
+					}
+					s, interactions, err := collection(cCtx, values[0])
+					if err != nil {
+						return err
+					}
+					owner, err := s.owner(values[1])
+					if err != nil {
+						return err
+					}
+					enumerableInteractions, err := enumerable.NewERC721EnumerableInteractions(interactions, []enumerable.IERC721EnumerableSignature{enumerable.TokenOfOwnerByIndex})
+					if err != nil {
+						return err
+					}
+					ids, err := enumerableInteractions.GetAddressOwnedTokens(owner)
+					if err != nil {
+						return err
+					}
+					result := ownedTokens{interactions.GetAddress(), owner, ids}
+					rows := [][]string{{"Token"}}
+					for _, id := range ids {
+						rows = append(rows, []string{id.String()})
+					}
+					return s.out.print(result, rows)
+				},
+			},
+			{
+				Name:      "meta",
+				Usage:     "print the name, symbol and URI of a token",
+				ArgsUsage: "<collection> <id>",
+				Action: func(cCtx *cli.Context) error {
+					values, err := args(cCtx, 2, 0)
+					if err != nil {
+						return err
+					}
+					s, interactions, err := collection(cCtx, values[0])
+					if err != nil {
+						return err
+					}
+					id, err := parseInt(values[1])
+					if err != nil {
+						return err
+					}
+					meta, err := interactions.TokenMetaInfos(id)
+					if err != nil {
+						return err
+					}
+					result := tokenMeta{interactions.GetAddress(), id, meta.Name, meta.Symbol, meta.URI}
+					return s.out.print(result, [][]string{
+						{"Collection", result.Collection.Hex()},
+						{"Token", id.String()},
+						{"Name", meta.Name},
+						{"Symbol", meta.Symbol},
+						{"URI", meta.URI},
+					})
+				},
+			},
+			{
+				Name:      "royalty",
+				Usage:     "print the ERC2981 royalty of a token for a sale price",
+				ArgsUsage: "<collection> <id>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "price", Usage: "sale price, in the smallest unit of the payment token", Value: "10000"},
+				},
+				Action: func(cCtx *cli.Context) error {
+					values, err := args(cCtx, 2, 0)
+					if err != nil {
+						return err
+					}
+					s, interactions, err := collection(cCtx, values[0])
+					if err != nil {
+						return err
+					}
+					id, err := parseInt(values[1])
+					if err != nil {
+						return err
+					}
+					price, err := parseInt(cCtx.String("price"))
+					if err != nil {
+						return err
+					}
+					royaltiesInteractions, err := royalties.NewERC721RoyaltiesInteractions(interactions, []royalties.IERC721RoyaltiesSignature{royalties.RoyaltyInfo})
+					if err != nil {
+						return err
+					}
+					infos, err := royaltiesInteractions.RoyaltiesInfos(id, price)
+					if err != nil {
+						return err
+					}
+					result := tokenRoyalty{interactions.GetAddress(), id, price, infos.Receiver, infos.RoyaltyAmount, infos.BasisPoints}
+					return s.out.print(result, [][]string{
+						{"Collection", result.Collection.Hex()},
+						{"Token", id.String()},
+						{"Sale price", price.String()},
+						{"Receiver", infos.Receiver.Hex()},
+						{"Amount", infos.RoyaltyAmount.String()},
+						{"Basis points", infos.BasisPoints.String()},
+					})
+				},
+			},
+			{
+				Name:      "transfer",
+				Usage:     "transfer a token of the account",
+				ArgsUsage: "<collection> <to> <id>",
+				Action: func(cCtx *cli.Context) error {
+					values, err := args(cCtx, 3, 0)
+					if err != nil {
+						return err
+					}
+					s, interactions, err := collection(cCtx, values[0])
+					if err != nil {
+						return err
+					}
+					if err := s.requireSigner(); err != nil {
+						return err
+					}
+					to, err := parseAddress(values[1])
+					if err != nil {
+						return err
+					}
+					id, err := parseInt(values[2])
+					if err != nil {
+						return err
+					}
+					return s.sent(interactions.TransferTo(to, id))
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/OCharless/eth-interfaces/probe"
+	"github.com/urfave/cli/v2"
+)
+
+func probeCommand(dial dialer) *cli.Command {
+	return &cli.Command{
+		Name:      "probe",
+		Usage:     "report the standards and extensions a contract implements",
+		ArgsUsage: "<address>",
+		Action: func(cCtx *cli.Context) error {
+			values, err := args(cCtx, 1, 0)
+			if err != nil {
+				return err
+			}
+			address, err := parseAddress(values[0])
+			if err != nil {
+				return err
+			}
+			s, err := newSession(cCtx, dial)
+			if err != nil {
+				return err
+			}
+			capabilities, err := probe.Probe(s.base, address)
+			if err != nil {
+				return err
+			}
+			rows := [][]string{{"Address", address.Hex()}}
+			for _, capability := range []struct {
+				name      string
+				supported bool
+			}{
+				{"ERC165", capabilities.ERC165},
+				{"ERC20", capabilities.ERC20},
+				{"ERC721", capabilities.ERC721},
+				{"ERC721Enumerable", capabilities.Enumerable},
+				{"ERC721Metadata", capabilities.Metadata},
+				{"ERC2981", capabilities.Royalties},
+				{"ERC1155", capabilities.ERC1155},
+				{"ERC20Permit", capabilities.Permit},
+				{"Burnable", capabilities.Burnable},
+			} {
+				rows = append(rows, []string{capability.name, strconv.FormatBool(capability.supported)})
+			}
+			return s.out.print(capabilities, rows)
+		},
+	}
+}
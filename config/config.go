@@ -0,0 +1,224 @@
+package config
+
+// Package config loads the networks, accounts and contracts used by an application from YAML, JSON or the environment,
+// and builds the matching interactions by name.
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultName is the name of the network and account built from the environment by FromEnv.
+const DefaultName = "default"
+
+// FeeMode selects how the fees of the transactions sent through typed interactions are priced.
+type FeeMode string
+
+const (
+	// FeeLegacy prices transactions with the suggested gas price, it is used when no mode is set.
+	FeeLegacy FeeMode = "legacy"
+	// FeeEIP1559 sends dynamic fee transactions, priced from the base fee and the suggested tip.
+	FeeEIP1559 FeeMode = "eip1559"
+)
+
+// ContractType is the standard of a named contract, selecting the interactions built for it.
+type ContractType string
+
+const (
+	ERC20   ContractType = "erc20"
+	ERC721  ContractType = "erc721"
+	ERC1155 ContractType = "erc1155"
+	// Auto probes the contract to find its standard.
+	Auto ContractType = "auto"
+)
+
+// Config describes the networks, accounts and contracts of an application.
+type Config struct {
+	// DefaultNetwork is used when no network is named, it may be left empty when a single network is configured.
+	DefaultNetwork string              `json:"defaultNetwork" yaml:"defaultNetwork"`
+	Networks       map[string]Network  `json:"networks" yaml:"networks"`
+	Accounts       map[string]Account  `json:"accounts" yaml:"accounts"`
+	Contracts      map[string]Contract `json:"contracts" yaml:"contracts"`
+}
+
+// Network describes a chain and how to reach it.
+type Network struct {
+	// RPC holds the URLs of the endpoints, several ones are combined with a base.MultiClient.
+	RPC     URLs   `json:"rpc" yaml:"rpc"`
+	ChainID uint64 `json:"chainId" yaml:"chainId"`
+	// Explorer is the base URL of an Etherscan-like explorer, the preset of the chain ID is used when empty.
+	Explorer string  `json:"explorer" yaml:"explorer"`
+	FeeMode  FeeMode `json:"feeMode" yaml:"feeMode"`
+}
+
+// Account is a named account, Key being a key source accepted by utils.LoadPrivateKey.
+type Account struct {
+	Key string `json:"key" yaml:"key"`
+}
+
+// Contract is a named contract. Network restricts it to a network, it can be used on any of them when empty.
+type Contract struct {
+	Address common.Address `json:"address" yaml:"address"`
+	Type    ContractType   `json:"type" yaml:"type"`
+	Network string         `json:"network" yaml:"network"`
+}
+
+// URLs is a list of URLs, written as a single string or as a list.
+type URLs []string
+
+func (u *URLs) UnmarshalJSON(data []byte) error {
+	var url string
+	if err := json.Unmarshal(data, &url); err == nil {
+		*u = URLs{url}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(u))
+}
+
+func (u *URLs) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*u = URLs{node.Value}
+		return nil
+	}
+	return node.Decode((*[]string)(u))
+}
+
+// Load reads a config file, in YAML or JSON depending on its extension. See Parse.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		return Parse(data, false)
+	case ".json":
+		return Parse(data, true)
+	default:
+		return nil, fmt.Errorf("unknown config format %q", ext)
+	}
+}
+
+// Parse decodes and validates a config. References to environment variables, such as ${RPC_URL}, are expanded first,
+// and the network named by ETH_NETWORK, when set, replaces the default one.
+func Parse(data []byte, isJSON bool) (*Config, error) {
+	config := &Config{}
+	data = []byte(os.ExpandEnv(string(data)))
+	var err error
+	if isJSON {
+		err = json.Unmarshal(data, config)
+	} else {
+		err = yaml.Unmarshal(data, config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	if network := os.Getenv("ETH_NETWORK"); network != "" {
+		config.DefaultNetwork = network
+	}
+	return config, config.Validate()
+}
+
+// FromEnv builds a config of a single network and account named DefaultName from the environment:
+// ETH_RPC_URL (comma separated URLs), ETH_CHAIN_ID, ETH_EXPLORER, ETH_FEE_MODE and PRIVATE_KEY.
+func FromEnv() (*Config, error) {
+	network := Network{
+		Explorer: os.Getenv("ETH_EXPLORER"),
+		FeeMode:  FeeMode(os.Getenv("ETH_FEE_MODE")),
+	}
+	for _, url := range strings.Split(os.Getenv("ETH_RPC_URL"), ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			network.RPC = append(network.RPC, url)
+		}
+	}
+	if chainID := os.Getenv("ETH_CHAIN_ID"); chainID != "" {
+		id, err := strconv.ParseUint(chainID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ETH_CHAIN_ID %q", chainID)
+		}
+		network.ChainID = id
+	}
+	config := &Config{
+		DefaultNetwork: DefaultName,
+		Networks:       map[string]Network{DefaultName: network},
+		Accounts:       map[string]Account{DefaultName: {Key: "env:PRIVATE_KEY"}},
+	}
+	return config, config.Validate()
+}
+
+// Validate checks that every network can be reached and that the references between entries exist.
+func (c *Config) Validate() error {
+	var errs []error
+	if c.DefaultNetwork != "" {
+		if _, ok := c.Networks[c.DefaultNetwork]; !ok {
+			errs = append(errs, fmt.Errorf("default network %q is not configured", c.DefaultNetwork))
+		}
+	}
+	for _, name := range sortedKeys(c.Networks) {
+		network := c.Networks[name]
+		if len(network.RPC) == 0 {
+			errs = append(errs, fmt.Errorf("network %q has no RPC URL", name))
+		}
+		if network.FeeMode != "" && network.FeeMode != FeeLegacy && network.FeeMode != FeeEIP1559 {
+			errs = append(errs, fmt.Errorf("network %q has an unknown fee mode %q", name, network.FeeMode))
+		}
+	}
+	for _, name := range sortedKeys(c.Accounts) {
+		if c.Accounts[name].Key == "" {
+			errs = append(errs, fmt.Errorf("account %q has no key", name))
+		}
+	}
+	for _, name := range sortedKeys(c.Contracts) {
+		contract := c.Contracts[name]
+		switch contract.Type {
+		case ERC20, ERC721, ERC1155, Auto:
+		default:
+			errs = append(errs, fmt.Errorf("contract %q has an unknown type %q", name, contract.Type))
+		}
+		if contract.Address == (common.Address{}) {
+			errs = append(errs, fmt.Errorf("contract %q has no address", name))
+		}
+		if _, ok := c.Networks[contract.Network]; contract.Network != "" && !ok {
+			errs = append(errs, fmt.Errorf("contract %q refers to the unknown network %q", name, contract.Network))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// network returns the named network, the default one when the name is empty.
+func (c *Config) network(name string) (string, Network, error) {
+	if name == "" {
+		name = c.DefaultNetwork
+	}
+	if name == "" && len(c.Networks) == 1 {
+		for single := range c.Networks {
+			name = single
+		}
+	}
+	if name == "" {
+		return "", Network{}, errors.New("no network named and no default network configured")
+	}
+	network, ok := c.Networks[name]
+	if !ok {
+		return "", Network{}, fmt.Errorf("unknown network %q", name)
+	}
+	return name, network, nil
+}
+
+func sortedKeys[T any](entries map[string]T) []string {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
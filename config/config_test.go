@@ -0,0 +1,213 @@
+package config_test
+
+// Package config_test contains tests for config loading and the interactions built from it.
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/config"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/testkit"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Load verifies the YAML and JSON formats and the expansion of environment variables.
+func Test_Load(t *testing.T) {
+	t.Setenv("SEPOLIA_RPC_URL", "https://sepolia.example.org")
+
+	for _, path := range []string{"testdata/config.yaml", "testdata/config.json"} {
+		t.Run("OK - "+path, func(t *testing.T) {
+			cfg, err := config.Load(path)
+			assert.Nil(t, err)
+			assert.Equal(t, "sepolia", cfg.DefaultNetwork)
+			assert.Equal(t, config.Network{
+				RPC:      config.URLs{"https://sepolia.example.org"},
+				ChainID:  11155111,
+				Explorer: "https://sepolia.etherscan.io",
+				FeeMode:  config.FeeEIP1559,
+			}, cfg.Networks["sepolia"])
+			assert.Equal(t, config.URLs{"http://localhost:8545", "http://localhost:8546"}, cfg.Networks["local"].RPC)
+			assert.Equal(t, "keystore:/secrets/treasury.json", cfg.Accounts["treasury"].Key)
+			assert.Equal(t, config.Contract{
+				Address: common.HexToAddress("0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238"),
+				Type:    config.ERC20,
+				Network: "sepolia",
+			}, cfg.Contracts["usdc"])
+		})
+	}
+
+	t.Run("OK - Network from the environment", func(t *testing.T) {
+		t.Setenv("ETH_NETWORK", "local")
+		cfg, err := config.Load("testdata/config.yaml")
+		assert.Nil(t, err)
+		assert.Equal(t, "local", cfg.DefaultNetwork)
+	})
+
+	t.Run("KO - Unknown format", func(t *testing.T) {
+		_, err := config.Load("testdata/config.toml")
+		assert.NotNil(t, err)
+	})
+}
+
+// Test_Validate verifies the errors reported for invalid configs.
+func Test_Validate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Config        string
+		ExpectedError string
+	}{
+		{
+			Name:          "KO - Unknown default network",
+			Config:        "defaultNetwork: mainnet\nnetworks:\n  local: {rpc: http://localhost:8545}",
+			ExpectedError: `default network "mainnet" is not configured`,
+		},
+		{
+			Name:          "KO - Missing RPC URL",
+			Config:        "networks:\n  local: {chainId: 1}",
+			ExpectedError: `network "local" has no RPC URL`,
+		},
+		{
+			Name:          "KO - Unknown fee mode",
+			Config:        "networks:\n  local: {rpc: http://localhost:8545, feeMode: flashbots}",
+			ExpectedError: `network "local" has an unknown fee mode "flashbots"`,
+		},
+		{
+			Name:          "KO - Missing key",
+			Config:        "accounts:\n  deployer: {}",
+			ExpectedError: `account "deployer" has no key`,
+		},
+		{
+			Name:          "KO - Unknown contract type",
+			Config:        "contracts:\n  token: {address: '0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238', type: erc777}",
+			ExpectedError: `contract "token" has an unknown type "erc777"`,
+		},
+		{
+			Name:          "KO - Unknown contract network",
+			Config:        "contracts:\n  token: {address: '0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238', type: erc20, network: base}",
+			ExpectedError: `contract "token" refers to the unknown network "base"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := config.Parse([]byte(tc.Config), false)
+			assert.ErrorContains(t, err, tc.ExpectedError)
+		})
+	}
+}
+
+// Test_FromEnv verifies the config built from the environment.
+func Test_FromEnv(t *testing.T) {
+	t.Setenv("ETH_RPC_URL", "http://localhost:8545, http://localhost:8546")
+	t.Setenv("ETH_CHAIN_ID", "31337")
+	t.Setenv("ETH_FEE_MODE", "legacy")
+
+	cfg, err := config.FromEnv()
+	assert.Nil(t, err)
+	assert.Equal(t, config.Network{
+		RPC:     config.URLs{"http://localhost:8545", "http://localhost:8546"},
+		ChainID: 31337,
+		FeeMode: config.FeeLegacy,
+	}, cfg.Networks[config.DefaultName])
+	assert.Equal(t, "env:PRIVATE_KEY", cfg.Accounts[config.DefaultName].Key)
+
+	t.Setenv("ETH_CHAIN_ID", "local")
+	_, err = config.FromEnv()
+	assert.NotNil(t, err)
+}
+
+// Test_Session verifies the interactions built by name on a simulated chain.
+func Test_Session(t *testing.T) {
+	kit := testkit.New(t).WithAccounts(2).WithERC20().WithERC721Tokens(1).Build()
+	ctx := context.Background()
+	owner, other := kit.Accounts[0], kit.Accounts[1]
+	t.Setenv("OTHER_KEY", hex.EncodeToString(crypto.FromECDSA(other.Key)))
+
+	cfg := &config.Config{
+		Networks: map[string]config.Network{
+			"simulated": {RPC: config.URLs{"simulated"}, ChainID: testkit.ChainID.Uint64(), FeeMode: config.FeeEIP1559},
+			"mainnet":   {RPC: config.URLs{"https://mainnet.example.org"}, ChainID: 1},
+		},
+		DefaultNetwork: "simulated",
+		Accounts: map[string]config.Account{
+			"owner": {Key: hex.EncodeToString(crypto.FromECDSA(owner.Key))},
+			"other": {Key: "env:OTHER_KEY"},
+		},
+		Contracts: map[string]config.Contract{
+			"token":      {Address: kit.ERC20, Type: config.ERC20, Network: "simulated"},
+			"collection": {Address: kit.ERC721, Type: config.Auto},
+			"usdc":       {Address: common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"), Type: config.ERC20, Network: "mainnet"},
+		},
+	}
+	assert.Nil(t, cfg.Validate())
+
+	session, err := cfg.Connect(ctx, "", kit.Client())
+	assert.Nil(t, err)
+	defer session.Close()
+
+	t.Run("OK - Base", func(t *testing.T) {
+		b, err := session.Base("other")
+		assert.Nil(t, err)
+		assert.Equal(t, other.Address, b.Address)
+		// The simulated chain has no explorer preset.
+		assert.Nil(t, b.Explorer())
+	})
+
+	t.Run("OK - ERC20 with dynamic fees", func(t *testing.T) {
+		token, err := session.ERC20("token", "owner")
+		assert.Nil(t, err)
+		tx, err := token.TransferTo(other.Address, big.NewInt(42))
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(types.DynamicFeeTxType), tx.Type())
+		kit.Commit()
+		balance, err := other.ERC20.GetBalance()
+		assert.Nil(t, err)
+		assert.Equal(t, "42", balance.String())
+	})
+
+	t.Run("OK - Probed asset", func(t *testing.T) {
+		asset, err := session.Asset("collection", "other")
+		assert.Nil(t, err)
+		collection, ok := asset.(*nft.ERC721Interactions)
+		assert.True(t, ok)
+		holder, err := collection.OwnerOf(big.NewInt(0))
+		assert.Nil(t, err)
+		assert.Equal(t, other.Address, holder)
+	})
+
+	t.Run("OK - Typed asset", func(t *testing.T) {
+		asset, err := session.Asset("token", "owner")
+		assert.Nil(t, err)
+		_, ok := asset.(*erc20.ERC20Interactions)
+		assert.True(t, ok)
+	})
+
+	t.Run("KO - Invalid references", func(t *testing.T) {
+		testCases := []struct {
+			Name          string
+			Build         func() error
+			ExpectedError string
+		}{
+			{"Unknown account", func() error { _, err := session.Base("nobody"); return err }, `unknown account "nobody"`},
+			{"Unknown contract", func() error { _, err := session.ERC20("dai", "owner"); return err }, `unknown contract "dai"`},
+			{"Other network", func() error { _, err := session.ERC20("usdc", "owner"); return err }, `deployed on network "mainnet"`},
+			{"Wrong type", func() error { _, err := session.ERC721("token", "owner"); return err }, `has type erc20, not erc721`},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.Name, func(t *testing.T) {
+				assert.ErrorContains(t, tc.Build(), tc.ExpectedError)
+			})
+		}
+	})
+
+	t.Run("KO - Wrong chain", func(t *testing.T) {
+		_, err := cfg.Connect(ctx, "mainnet", kit.Client())
+		assert.ErrorContains(t, err, "expected 1")
+	})
+}
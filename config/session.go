@@ -0,0 +1,242 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/basetokens"
+	"github.com/OCharless/eth-interfaces/customerrors"
+	"github.com/OCharless/eth-interfaces/erc1155"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+)
+
+// Session is a connection to a configured network, building the interactions of the named accounts and contracts.
+type Session struct {
+	config  *Config
+	Name    string
+	Network Network
+	Client  simulated.Client
+	// Explorer links the transactions and contracts of the network, nil when it has no explorer.
+	Explorer *base.Explorer
+	// closer closes the clients dialed by the session, clients given to Connect are left open.
+	closer func()
+}
+
+// Dial connects to the named network, the default one when the name is empty, and checks its chain ID.
+// Networks with several RPC URLs are reached through a base.MultiClient.
+func (c *Config) Dial(ctx context.Context, network string) (*Session, error) {
+	name, config, err := c.network(network)
+	if err != nil {
+		return nil, err
+	}
+	clients := make([]simulated.Client, 0, len(config.RPC))
+	closeAll := func() {
+		for _, client := range clients {
+			client.(*ethclient.Client).Close()
+		}
+	}
+	for _, url := range config.RPC {
+		client, err := ethclient.DialContext(ctx, url)
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("failed to connect to %s: %w", url, err)
+		}
+		clients = append(clients, client)
+	}
+
+	client, closer := clients[0], closeAll
+	if len(clients) > 1 {
+		multiClient, err := base.NewMultiClient(base.MultiClientConfig{}, clients...)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		client, closer = multiClient, multiClient.Close
+	}
+	session, err := c.connect(ctx, name, config, client)
+	if err != nil {
+		closer()
+		return nil, err
+	}
+	session.closer = closer
+	return session, nil
+}
+
+// Connect uses an existing client for the named network, such as a simulated backend or a client wrapped in middlewares.
+func (c *Config) Connect(ctx context.Context, network string, client simulated.Client) (*Session, error) {
+	name, config, err := c.network(network)
+	if err != nil {
+		return nil, err
+	}
+	return c.connect(ctx, name, config, client)
+}
+
+func (c *Config) connect(ctx context.Context, name string, network Network, client simulated.Client) (*Session, error) {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID of network %q: %w", name, err)
+	}
+	if network.ChainID != 0 && chainID.Cmp(new(big.Int).SetUint64(network.ChainID)) != 0 {
+		return nil, fmt.Errorf("network %q has chain ID %s, expected %d", name, chainID, network.ChainID)
+	}
+	explorer := base.ExplorerForChain(chainID)
+	if network.Explorer != "" {
+		explorer = base.NewExplorer(name, network.Explorer)
+	}
+	return &Session{config: c, Name: name, Network: network, Client: client, Explorer: explorer, closer: func() {}}, nil
+}
+
+// Close closes the clients dialed by the session.
+func (s *Session) Close() {
+	s.closer()
+}
+
+// Base returns the base interactions of the named account, the default one when the name is empty.
+func (s *Session) Base(account string) (*base.BaseInteractions, error) {
+	name, config, err := s.account(account)
+	if err != nil {
+		return nil, err
+	}
+	key, err := utils.LoadPrivateKey(config.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the key of account %q: %w", name, err)
+	}
+	return base.NewBaseInteractions(s.Client, key, s.Explorer), nil
+}
+
+// TransactOpts returns the transaction options of the base interactions, priced according to the fee mode of the network.
+func (s *Session) TransactOpts(b *base.BaseInteractions) (*bind.TransactOpts, error) {
+	opts, err := b.BaseTxSetup()
+	if err != nil {
+		return nil, err
+	}
+	if s.Network.FeeMode == FeeEIP1559 {
+		// Without a gas price, the bindings fill the fee cap and tip of a dynamic fee transaction.
+		opts.GasPrice = nil
+	}
+	return opts, nil
+}
+
+// Contract returns the named contract, checking it is available on the network of the session.
+func (s *Session) Contract(name string) (Contract, error) {
+	contract, ok := s.config.Contracts[name]
+	if !ok {
+		return Contract{}, fmt.Errorf("unknown contract %q", name)
+	}
+	if contract.Network != "" && contract.Network != s.Name {
+		return Contract{}, fmt.Errorf("contract %q is deployed on network %q, not %q", name, contract.Network, s.Name)
+	}
+	return contract, nil
+}
+
+// Address returns the address of the named contract.
+func (s *Session) Address(contract string) (common.Address, error) {
+	config, err := s.Contract(contract)
+	return config.Address, err
+}
+
+// ERC20 returns the ERC20 interactions of the named contract for the named account.
+func (s *Session) ERC20(contract, account string) (*erc20.ERC20Interactions, error) {
+	config, b, opts, err := s.interactions(contract, account, ERC20)
+	if err != nil {
+		return nil, err
+	}
+	return erc20.NewIERC20Interactions(b, config.Address, []erc20.BaseERC20Signature{}, opts)
+}
+
+// ERC721 returns the ERC721 interactions of the named contract for the named account.
+func (s *Session) ERC721(contract, account string) (*nft.ERC721Interactions, error) {
+	config, b, opts, err := s.interactions(contract, account, ERC721)
+	if err != nil {
+		return nil, err
+	}
+	return nft.NewERC721Interactions(b, config.Address, []nft.BaseNFTSignature{}, opts)
+}
+
+// ERC1155 returns the ERC1155 interactions of the named contract for the named account.
+func (s *Session) ERC1155(contract, account string) (*erc1155.ERC1155Interactions, error) {
+	config, b, opts, err := s.interactions(contract, account, ERC1155)
+	if err != nil {
+		return nil, err
+	}
+	return erc1155.NewERC1155Interactions(b, config.Address, []erc1155.BaseERC1155Signature{}, opts)
+}
+
+// Asset returns the interactions matching the type of the named contract, probing it when its type is Auto.
+func (s *Session) Asset(contract, account string) (basetokens.Asset, error) {
+	config, err := s.Contract(contract)
+	if err != nil {
+		return nil, err
+	}
+	switch config.Type {
+	case ERC20:
+		return asset(s.ERC20(contract, account))
+	case ERC721:
+		return asset(s.ERC721(contract, account))
+	case ERC1155:
+		return asset(s.ERC1155(contract, account))
+	}
+	b, err := s.Base(account)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := s.TransactOpts(b)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("BaseTxSetup", err)
+	}
+	return basetokens.NewAsset(b, config.Address, opts)
+}
+
+// interactions resolves what the typed interactions of a contract are built from, the contract being either of the expected type or Auto.
+func (s *Session) interactions(contract, account string, expected ContractType) (Contract, *base.BaseInteractions, *bind.TransactOpts, error) {
+	config, err := s.Contract(contract)
+	if err != nil {
+		return Contract{}, nil, nil, err
+	}
+	if config.Type != expected && config.Type != Auto {
+		return Contract{}, nil, nil, fmt.Errorf("contract %q has type %s, not %s", contract, config.Type, expected)
+	}
+	b, err := s.Base(account)
+	if err != nil {
+		return Contract{}, nil, nil, err
+	}
+	opts, err := s.TransactOpts(b)
+	if err != nil {
+		return Contract{}, nil, nil, customerrors.WrapinterfacingError("BaseTxSetup", err)
+	}
+	return config, b, opts, nil
+}
+
+// account returns the named account. An empty name selects the account named DefaultName, or the only configured account.
+func (s *Session) account(name string) (string, Account, error) {
+	if name == "" {
+		if _, ok := s.config.Accounts[DefaultName]; ok || len(s.config.Accounts) != 1 {
+			name = DefaultName
+		} else {
+			for single := range s.config.Accounts {
+				name = single
+			}
+		}
+	}
+	account, ok := s.config.Accounts[name]
+	if !ok {
+		return "", Account{}, fmt.Errorf("unknown account %q", name)
+	}
+	return name, account, nil
+}
+
+// asset converts the result of an interactions constructor, keeping a nil Asset on error instead of a typed nil pointer.
+func asset[T basetokens.Asset](interactions T, err error) (basetokens.Asset, error) {
+	if err != nil {
+		return nil, err
+	}
+	return interactions, nil
+}
@@ -0,0 +1,389 @@
+package access
+
+// Package access provides functions to inspect and manage Ownable and AccessControl contracts.
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/contractextension"
+	"github.com/OCharless/eth-interfaces/customerrors"
+	"github.com/OCharless/eth-interfaces/inferences/AccessControlOwnable"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrUnauthorized is returned by CheckAuthorized when an account may not call a restricted function.
+var ErrUnauthorized = errors.New("unauthorized account")
+
+// ErrNoAccessControl is returned by NewAccessInteractions for contracts implementing neither Ownable nor AccessControl.
+// Extensions restricting their functions otherwise leave them to revert on their own.
+var ErrNoAccessControl = errors.New("implements neither Ownable nor AccessControl")
+
+// DefaultAdminRole is the role administering every other role unless configured otherwise.
+var DefaultAdminRole = [32]byte{}
+
+// RoleID returns the identifier of a role declared as keccak256("NAME"), such as MINTER_ROLE.
+func RoleID(name string) [32]byte {
+	return crypto.Keccak256Hash([]byte(name))
+}
+
+// AccessInteractions wraps interactions with a contract implementing Ownable, AccessControl or both.
+type AccessInteractions struct {
+	*base.BaseInteractions
+	session       *AccessControlOwnable.AccessControlOwnableSession
+	address       common.Address
+	ownable       bool
+	accessControl bool
+	enumerable    bool
+	callError     func(string, error) *base.CallError
+}
+
+// NewAccessInteractions creates a new instance of AccessInteractions for the contract at the given address.
+// The given signatures must all be implemented. Ownable is detected from owner(), AccessControl and its enumerable
+// extension either through ERC-165 or from their selectors. ErrNoAccessControl is returned when none is found,
+// failures to read the contract are returned as they are.
+func NewAccessInteractions(
+	baseInteractions *base.BaseInteractions,
+	address common.Address,
+	signatures []AccessSignature,
+	transactOps ...*bind.TransactOpts,
+) (*AccessInteractions, error) {
+	var converted []utils.Signature
+	for _, sig := range signatures {
+		converted = append(converted, sig)
+	}
+
+	err := baseInteractions.CheckSignatures(address, converted)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("access", err)
+	}
+
+	// implements only tells missing selectors apart from failures to get the bytecode, which are returned.
+	implements := func(signatures ...utils.Signature) (bool, error) {
+		err := baseInteractions.CheckSignatures(address, signatures)
+		var notSupported *customerrors.InterfacingError
+		if errors.As(err, &notSupported) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	supports := func(interfaceID [4]byte, signatures ...utils.Signature) (bool, error) {
+		supported, err := baseInteractions.SupportsInterfaceWithGasCap(address, interfaceID)
+		if err == nil && supported {
+			return true, nil
+		}
+		return implements(signatures...)
+	}
+
+	ownable, err := implements(Owner)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("access", err)
+	}
+	accessControl, err := supports(utils.IACCESS_CONTROL_INTERFACE_ID, HasRole, GetRoleAdmin)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("access", err)
+	}
+	enumerable := false
+	if accessControl {
+		enumerable, err = supports(utils.IACCESS_CONTROL_ENUMERABLE_INTERFACE_ID, GetRoleMember, GetRoleMemberCount)
+		if err != nil {
+			return nil, customerrors.WrapinterfacingError("access", err)
+		}
+	}
+	if !ownable && !accessControl {
+		return nil, fmt.Errorf("%s %w", address.Hex(), ErrNoAccessControl)
+	}
+
+	var txOpts *bind.TransactOpts
+	if len(transactOps) == 0 {
+		txOpts, err = baseInteractions.BaseTxSetup()
+		if err != nil {
+			return nil, customerrors.WrapinterfacingError("BaseTxSetup", err)
+		}
+	} else {
+		txOpts = transactOps[0]
+	}
+
+	contract, err := AccessControlOwnable.NewAccessControlOwnable(address, baseInteractions.Client)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("access", err)
+	}
+	session := AccessControlOwnable.AccessControlOwnableSession{
+		Contract:     contract,
+		CallOpts:     bind.CallOpts{Pending: true, From: baseInteractions.Address},
+		TransactOpts: *txOpts,
+	}
+
+	callError := func(field string, err error) *base.CallError {
+		return baseInteractions.WrapCallError(AccessControlOwnable.AccessControlOwnableABI, field, err)
+	}
+
+	return &AccessInteractions{baseInteractions, &session, address, ownable, accessControl, enumerable, callError}, nil
+}
+
+// GetAddress returns the contract address.
+func (a *AccessInteractions) GetAddress() common.Address {
+	return a.address
+}
+
+// IsOwnable reports whether the contract exposes owner().
+func (a *AccessInteractions) IsOwnable() bool {
+	return a.ownable
+}
+
+// IsAccessControl reports whether the contract implements AccessControl.
+func (a *AccessInteractions) IsAccessControl() bool {
+	return a.accessControl
+}
+
+// IsEnumerable reports whether the contract implements AccessControlEnumerable.
+func (a *AccessInteractions) IsEnumerable() bool {
+	return a.enumerable
+}
+
+// Owner returns the current owner of the contract.
+func (a *AccessInteractions) Owner() (common.Address, error) {
+	if !a.ownable {
+		return common.Address{}, a.callError("access.Owner()", fmt.Errorf("contract is not Ownable"))
+	}
+	owner, err := base.Observe(a.BaseInteractions, base.ReadOperation, "access.Owner()", func() (common.Address, error) {
+		return a.session.Owner()
+	})
+	if err != nil {
+		return common.Address{}, a.callError("access.Owner()", err)
+	}
+	return owner, nil
+}
+
+// HasRole reports whether the account has been granted the role.
+func (a *AccessInteractions) HasRole(role [32]byte, account common.Address) (bool, error) {
+	if !a.accessControl {
+		return false, a.callError("access.HasRole()", fmt.Errorf("contract does not implement AccessControl"))
+	}
+	hasRole, err := base.Observe(a.BaseInteractions, base.ReadOperation, "access.HasRole()", func() (bool, error) {
+		return a.session.HasRole(role, account)
+	})
+	if err != nil {
+		return false, a.callError("access.HasRole()", err)
+	}
+	return hasRole, nil
+}
+
+// CheckAuthorized returns ErrUnauthorized when the account may not call functions restricted to the role:
+// it must hold the role on AccessControl contracts, and be the owner on contracts that are only Ownable.
+func (a *AccessInteractions) CheckAuthorized(role [32]byte, account common.Address) error {
+	if a.accessControl {
+		hasRole, err := a.HasRole(role, account)
+		if err != nil {
+			return err
+		}
+		if !hasRole {
+			return fmt.Errorf("%w: %s misses role %s", ErrUnauthorized, account.Hex(), common.Hash(role).Hex())
+		}
+		return nil
+	}
+	owner, err := a.Owner()
+	if err != nil {
+		return err
+	}
+	if owner != account {
+		return fmt.Errorf("%w: %s is not the owner %s", ErrUnauthorized, account.Hex(), owner.Hex())
+	}
+	return nil
+}
+
+// GetRoleAdmin returns the role allowed to grant and revoke the given role.
+func (a *AccessInteractions) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	if !a.accessControl {
+		return [32]byte{}, a.callError("access.GetRoleAdmin()", fmt.Errorf("contract does not implement AccessControl"))
+	}
+	admin, err := base.Observe(a.BaseInteractions, base.ReadOperation, "access.GetRoleAdmin()", func() ([32]byte, error) {
+		return a.session.GetRoleAdmin(role)
+	})
+	if err != nil {
+		return [32]byte{}, a.callError("access.GetRoleAdmin()", err)
+	}
+	return admin, nil
+}
+
+// GetRoleMember returns the holder of the role at the given index, only available on AccessControlEnumerable contracts.
+func (a *AccessInteractions) GetRoleMember(role [32]byte, index *big.Int) (common.Address, error) {
+	if !a.enumerable {
+		return common.Address{}, a.callError("access.GetRoleMember()", fmt.Errorf("contract does not implement AccessControlEnumerable"))
+	}
+	member, err := base.Observe(a.BaseInteractions, base.ReadOperation, "access.GetRoleMember()", func() (common.Address, error) {
+		return a.session.GetRoleMember(role, index)
+	})
+	if err != nil {
+		return common.Address{}, a.callError("access.GetRoleMember()", err)
+	}
+	return member, nil
+}
+
+// RoleMembers returns the current holders of a role. Enumerable contracts are queried directly,
+// otherwise the holders are rebuilt from the RoleGranted and RoleRevoked logs since the given block.
+func (a *AccessInteractions) RoleMembers(role [32]byte, fromBlock uint64) ([]common.Address, error) {
+	if !a.enumerable {
+		holders, err := a.RoleHolders(fromBlock, nil)
+		if err != nil {
+			return nil, err
+		}
+		return holders[role], nil
+	}
+
+	count, err := base.Observe(a.BaseInteractions, base.ReadOperation, "access.GetRoleMemberCount()", func() (*big.Int, error) {
+		return a.session.GetRoleMemberCount(role)
+	})
+	if err != nil {
+		return nil, a.callError("access.GetRoleMemberCount()", err)
+	}
+	members := []common.Address{}
+	for index := new(big.Int); index.Cmp(count) < 0; index = new(big.Int).Add(index, common.Big1) {
+		member, err := a.GetRoleMember(role, index)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// RoleHolders rebuilds the holders of every role by replaying RoleGranted and RoleRevoked events in chain order.
+// A nil end block means the latest block. Holders are sorted by address.
+func (a *AccessInteractions) RoleHolders(start uint64, end *uint64) (map[[32]byte][]common.Address, error) {
+	if !a.accessControl {
+		return nil, a.callError("access.RoleHolders()", fmt.Errorf("contract does not implement AccessControl"))
+	}
+
+	type roleChange struct {
+		blockNumber uint64
+		logIndex    uint
+		role        [32]byte
+		account     common.Address
+		granted     bool
+	}
+	changes := []roleChange{}
+
+	opts := &bind.FilterOpts{Start: start, End: end, Context: a.Ctx}
+	granted, err := base.Observe(a.BaseInteractions, base.ReadOperation, "access.FilterRoleGranted()", func() (*AccessControlOwnable.AccessControlOwnableRoleGrantedIterator, error) {
+		return a.session.Contract.FilterRoleGranted(opts, nil, nil, nil)
+	})
+	if err != nil {
+		return nil, a.callError("access.FilterRoleGranted()", err)
+	}
+	defer granted.Close()
+	for granted.Next() {
+		changes = append(changes, roleChange{
+			blockNumber: granted.Event.Raw.BlockNumber,
+			logIndex:    granted.Event.Raw.Index,
+			role:        granted.Event.Role,
+			account:     granted.Event.Account,
+			granted:     true,
+		})
+	}
+	if err := granted.Error(); err != nil {
+		return nil, a.callError("access.FilterRoleGranted()", err)
+	}
+
+	revoked, err := base.Observe(a.BaseInteractions, base.ReadOperation, "access.FilterRoleRevoked()", func() (*AccessControlOwnable.AccessControlOwnableRoleRevokedIterator, error) {
+		return a.session.Contract.FilterRoleRevoked(opts, nil, nil, nil)
+	})
+	if err != nil {
+		return nil, a.callError("access.FilterRoleRevoked()", err)
+	}
+	defer revoked.Close()
+	for revoked.Next() {
+		changes = append(changes, roleChange{
+			blockNumber: revoked.Event.Raw.BlockNumber,
+			logIndex:    revoked.Event.Raw.Index,
+			role:        revoked.Event.Role,
+			account:     revoked.Event.Account,
+		})
+	}
+	if err := revoked.Error(); err != nil {
+		return nil, a.callError("access.FilterRoleRevoked()", err)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].blockNumber != changes[j].blockNumber {
+			return changes[i].blockNumber < changes[j].blockNumber
+		}
+		return changes[i].logIndex < changes[j].logIndex
+	})
+
+	members := map[[32]byte]map[common.Address]bool{}
+	for _, change := range changes {
+		if members[change.role] == nil {
+			members[change.role] = map[common.Address]bool{}
+		}
+		if change.granted {
+			members[change.role][change.account] = true
+		} else {
+			delete(members[change.role], change.account)
+		}
+	}
+
+	holders := map[[32]byte][]common.Address{}
+	for role, accounts := range members {
+		if len(accounts) == 0 {
+			continue
+		}
+		for account := range accounts {
+			holders[role] = append(holders[role], account)
+		}
+		sort.Slice(holders[role], func(i, j int) bool {
+			return bytes.Compare(holders[role][i].Bytes(), holders[role][j].Bytes()) < 0
+		})
+	}
+	return holders, nil
+}
+
+// TransferOwnership transfers the ownership of the contract. With dryRun, the call is only simulated and no transaction is returned.
+func (a *AccessInteractions) TransferOwnership(newOwner common.Address, dryRun bool) (*types.Transaction, error) {
+	if !a.ownable {
+		return nil, a.callError("access.TransferOwnership()", fmt.Errorf("contract is not Ownable"))
+	}
+	if dryRun {
+		err := contractextension.SimulateCall(a.Ctx, AccessControlOwnable.AccessControlOwnableABI, "transferOwnership", a, newOwner)
+		if err != nil {
+			return nil, a.callError("access.TransferOwnership()", err)
+		}
+		return nil, nil
+	}
+	tx, err := base.Observe(a.BaseInteractions, base.SendOperation, "access.TransferOwnership()", func() (*types.Transaction, error) {
+		return a.session.TransferOwnership(newOwner)
+	})
+	if err != nil {
+		return nil, a.callError("access.TransferOwnership()", err)
+	}
+	return tx, nil
+}
+
+// RenounceOwnership leaves the contract without owner. With dryRun, the call is only simulated and no transaction is returned.
+func (a *AccessInteractions) RenounceOwnership(dryRun bool) (*types.Transaction, error) {
+	if !a.ownable {
+		return nil, a.callError("access.RenounceOwnership()", fmt.Errorf("contract is not Ownable"))
+	}
+	if dryRun {
+		err := contractextension.SimulateCall(a.Ctx, AccessControlOwnable.AccessControlOwnableABI, "renounceOwnership", a)
+		if err != nil {
+			return nil, a.callError("access.RenounceOwnership()", err)
+		}
+		return nil, nil
+	}
+	tx, err := base.Observe(a.BaseInteractions, base.SendOperation, "access.RenounceOwnership()", func() (*types.Transaction, error) {
+		return a.session.RenounceOwnership()
+	})
+	if err != nil {
+		return nil, a.callError("access.RenounceOwnership()", err)
+	}
+	return tx, nil
+}
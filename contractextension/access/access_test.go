@@ -0,0 +1,192 @@
+package access_test
+
+// Package access_test contains tests for Ownable and AccessControl interactions.
+
+import (
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/contractextension/access"
+	"github.com/OCharless/eth-interfaces/inferences/AccessControlOwnable"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Instantiation verifies the detection of Ownable and AccessControl, and that other contracts are rejected.
+func Test_Instantiation(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		AccessControlOwnable.AccessControlOwnableABI,
+		AccessControlOwnable.AccessControlOwnableBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	erc20Addr, _, _, err := utils.DeployContract(auth, backend.Client(), ERC20Burnable.ERC20BurnableABI, ERC20Burnable.ERC20BurnableBin)
+	assert.Nil(t, err)
+	backend.Commit()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+
+	testCases := []struct {
+		Name          string
+		ContractAddr  common.Address
+		Signatures    []access.AccessSignature
+		ExpectError   bool
+		ExpectedError string
+	}{
+		{
+			Name:         "OK - Ownable and AccessControlEnumerable contract",
+			ContractAddr: *contractAddr,
+			Signatures:   []access.AccessSignature{access.Owner, access.HasRole, access.GetRoleMember},
+		},
+		{
+			Name:          "KO - Token without access control",
+			ContractAddr:  erc20Addr,
+			ExpectError:   true,
+			ExpectedError: "implements neither Ownable nor AccessControl",
+		},
+		{
+			Name:          "KO - Required signature not implemented",
+			ContractAddr:  erc20Addr,
+			Signatures:    []access.AccessSignature{access.Owner},
+			ExpectError:   true,
+			ExpectedError: "not supported functions: owner()",
+		},
+	}
+
+	t.Run("KO - No access control is told apart from RPC failures", func(t *testing.T) {
+		_, err := access.NewAccessInteractions(baseInteractions, erc20Addr, nil)
+		assert.ErrorIs(t, err, access.ErrNoAccessControl)
+
+		// Nothing listens on port 1, every call fails to connect.
+		client, err := ethclient.Dial("http://127.0.0.1:1")
+		assert.Nil(t, err)
+		defer client.Close()
+		_, err = access.NewAccessInteractions(base.NewBaseInteractions(client, privKey, nil), *contractAddr, nil)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, access.ErrNoAccessControl)
+	})
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			session, err := access.NewAccessInteractions(baseInteractions, tt.ContractAddr, tt.Signatures)
+			if tt.ExpectError {
+				if err == nil {
+					t.Error("expected error but there's none")
+					return
+				}
+				assert.Contains(t, err.Error(), tt.ExpectedError)
+			} else {
+				assert.Nil(t, err)
+				assert.True(t, session.IsOwnable())
+				assert.True(t, session.IsAccessControl())
+				assert.True(t, session.IsEnumerable())
+			}
+		})
+	}
+}
+
+// Test_RoleHolders verifies that replaying role events matches the holders reported by the contract.
+func Test_RoleHolders(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		AccessControlOwnable.AccessControlOwnableABI,
+		AccessControlOwnable.AccessControlOwnableBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	contract, err := AccessControlOwnable.NewAccessControlOwnable(*contractAddr, backend.Client())
+	assert.Nil(t, err)
+
+	minterRole := access.RoleID("MINTER_ROLE")
+	alice := common.HexToAddress("0x000000000000000000000000000000000000a11c")
+	bob := common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	_, err = contract.GrantRole(auth, minterRole, alice)
+	assert.Nil(t, err)
+	backend.Commit()
+	_, err = contract.GrantRole(auth, minterRole, bob)
+	assert.Nil(t, err)
+	backend.Commit()
+	_, err = contract.RevokeRole(auth, minterRole, alice)
+	assert.Nil(t, err)
+	backend.Commit()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	session, err := access.NewAccessInteractions(baseInteractions, *contractAddr, []access.AccessSignature{})
+	assert.Nil(t, err)
+
+	t.Run("OK - Holders replayed from logs", func(t *testing.T) {
+		holders, err := session.RoleHolders(0, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []common.Address{auth.From}, holders[access.DefaultAdminRole])
+		assert.Equal(t, []common.Address{bob}, holders[minterRole])
+	})
+
+	t.Run("OK - Holders enumerated by the contract", func(t *testing.T) {
+		members, err := session.RoleMembers(minterRole, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, []common.Address{bob}, members)
+	})
+
+	t.Run("OK - Role checks", func(t *testing.T) {
+		hasRole, err := session.HasRole(minterRole, alice)
+		assert.Nil(t, err)
+		assert.False(t, hasRole)
+		admin, err := session.GetRoleAdmin(minterRole)
+		assert.Nil(t, err)
+		assert.Equal(t, access.DefaultAdminRole, admin)
+		assert.Nil(t, session.CheckAuthorized(minterRole, bob))
+		assert.ErrorIs(t, session.CheckAuthorized(minterRole, alice), access.ErrUnauthorized)
+	})
+}
+
+// Test_OwnershipTransfer verifies dry-run and effective ownership transfers and the decoding of Ownable errors.
+func Test_OwnershipTransfer(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		AccessControlOwnable.AccessControlOwnableABI,
+		AccessControlOwnable.AccessControlOwnableBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	session, err := access.NewAccessInteractions(baseInteractions, *contractAddr, []access.AccessSignature{access.Owner, access.TransferOwnership}, auth)
+	assert.Nil(t, err)
+
+	newOwner := common.HexToAddress("0x000000000000000000000000000000000000beef")
+
+	t.Run("KO - Dry run to the zero address", func(t *testing.T) {
+		tx, err := session.TransferOwnership(common.Address{}, true)
+		assert.Nil(t, tx)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "call error on access.TransferOwnership(): OwnableInvalidOwner")
+	})
+
+	t.Run("OK - Dry run leaves the owner unchanged", func(t *testing.T) {
+		tx, err := session.TransferOwnership(newOwner, true)
+		assert.Nil(t, err)
+		assert.Nil(t, tx)
+		owner, err := session.Owner()
+		assert.Nil(t, err)
+		assert.Equal(t, auth.From, owner)
+	})
+
+	t.Run("OK - Ownership transferred", func(t *testing.T) {
+		tx, err := session.TransferOwnership(newOwner, false)
+		assert.Nil(t, err)
+		assert.NotNil(t, tx)
+		backend.Commit()
+		owner, err := session.Owner()
+		assert.Nil(t, err)
+		assert.Equal(t, newOwner, owner)
+	})
+
+	t.Run("KO - Former owner can no longer renounce", func(t *testing.T) {
+		_, err := session.RenounceOwnership(true)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "OwnableUnauthorizedAccount")
+	})
+}
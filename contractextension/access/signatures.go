@@ -0,0 +1,29 @@
+package access
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type AccessSignature string
+
+const (
+	Owner              AccessSignature = "owner()"
+	TransferOwnership  AccessSignature = "transferOwnership(address)"
+	RenounceOwnership  AccessSignature = "renounceOwnership()"
+	HasRole            AccessSignature = "hasRole(bytes32,address)"
+	GetRoleAdmin       AccessSignature = "getRoleAdmin(bytes32)"
+	GrantRole          AccessSignature = "grantRole(bytes32,address)"
+	RevokeRole         AccessSignature = "revokeRole(bytes32,address)"
+	RenounceRole       AccessSignature = "renounceRole(bytes32,address)"
+	GetRoleMember      AccessSignature = "getRoleMember(bytes32,uint256)"
+	GetRoleMemberCount AccessSignature = "getRoleMemberCount(bytes32)"
+)
+
+func (s AccessSignature) GetHex() string {
+	hash := crypto.NewKeccakState()
+	hash.Write([]byte(s))
+	selector := hash.Sum(nil)[:4]
+	return hex.EncodeToString(selector)
+}
@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: MIT
+// Test contract combining Ownable and AccessControlEnumerable, the deployer being the owner and the default admin.
+// setRoleAdmin lets the default admin change the admin role of any role.
+
+pragma solidity ^0.8.20;
+
+import {Ownable} from "@openzeppelin/contracts/access/Ownable.sol";
+import {AccessControlEnumerable} from "@openzeppelin/contracts/access/extensions/AccessControlEnumerable.sol";
+
+contract AccessControlOwnable is Ownable, AccessControlEnumerable {
+    constructor() Ownable(msg.sender) {
+        _grantRole(DEFAULT_ADMIN_ROLE, msg.sender);
+    }
+
+    function setRoleAdmin(bytes32 role, bytes32 adminRole) external onlyRole(DEFAULT_ADMIN_ROLE) {
+        _setRoleAdmin(role, adminRole);
+    }
+}
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: MIT
+// Test contract implementing ERC1155 and ERC1155MetadataURI with the "ipfs://meta/{id}" URI and an open mint.
+
+pragma solidity ^0.8.20;
+
+import {ERC1155} from "@openzeppelin/contracts/token/ERC1155/ERC1155.sol";
+
+contract ERC1155Complete is ERC1155 {
+    constructor() ERC1155("ipfs://meta/{id}") {}
+
+    function mint(address to, uint256 id, uint256 value) external {
+        _mint(to, id, value, "");
+    }
+}
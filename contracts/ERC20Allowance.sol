@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: MIT
+// Test contract implementing ERC20 with increaseAllowance/decreaseAllowance and the USDT approval rule:
+// approve reverts when both the current and the new allowance are not zero.
+// The deployer receives the whole supply of 1,000,000 tokens of 18 decimals.
+
+pragma solidity ^0.8.20;
+
+import {ERC20} from "@openzeppelin/contracts/token/ERC20/ERC20.sol";
+
+contract ERC20Allowance is ERC20 {
+    error ERC20ApproveFromNonZero(address spender, uint256 currentAllowance);
+    error ERC20FailedDecreaseAllowance(address spender, uint256 currentAllowance, uint256 requestedDecrease);
+
+    constructor() ERC20("Allowance", "ALW") {
+        _mint(msg.sender, 1_000_000 * 10 ** decimals());
+    }
+
+    function approve(address spender, uint256 value) public override returns (bool) {
+        uint256 currentAllowance = allowance(msg.sender, spender);
+        if (value != 0 && currentAllowance != 0) {
+            revert ERC20ApproveFromNonZero(spender, currentAllowance);
+        }
+        return super.approve(spender, value);
+    }
+
+    function increaseAllowance(address spender, uint256 addedValue) external returns (bool) {
+        _approve(msg.sender, spender, allowance(msg.sender, spender) + addedValue);
+        return true;
+    }
+
+    function decreaseAllowance(address spender, uint256 requestedDecrease) external returns (bool) {
+        uint256 currentAllowance = allowance(msg.sender, spender);
+        if (currentAllowance < requestedDecrease) {
+            revert ERC20FailedDecreaseAllowance(spender, currentAllowance, requestedDecrease);
+        }
+        _approve(msg.sender, spender, currentAllowance - requestedDecrease);
+        return true;
+    }
+}
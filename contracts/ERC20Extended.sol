@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: MIT
+// Test contract implementing ERC20 with the OpenZeppelin extensions AccessControl, Pausable, Capped and Votes:
+// - mint requires MINTER_ROLE and reverts above the cap given to the constructor
+// - pause and unpause require PAUSER_ROLE, transfers and mints revert while paused
+// - votes are checkpointed per block number, delegateBySig verifies EIP-712 signatures of the domain ("Extended Votes", "2"),
+//   which differs from the token name and the default version and is only known from eip712Domain()
+// The deployer is granted DEFAULT_ADMIN_ROLE, MINTER_ROLE and PAUSER_ROLE, no token is minted on deployment.
+
+pragma solidity ^0.8.20;
+
+import {AccessControl} from "@openzeppelin/contracts/access/AccessControl.sol";
+import {ERC20} from "@openzeppelin/contracts/token/ERC20/ERC20.sol";
+import {ERC20Capped} from "@openzeppelin/contracts/token/ERC20/extensions/ERC20Capped.sol";
+import {ERC20Pausable} from "@openzeppelin/contracts/token/ERC20/extensions/ERC20Pausable.sol";
+import {ERC20Votes} from "@openzeppelin/contracts/token/ERC20/extensions/ERC20Votes.sol";
+import {EIP712} from "@openzeppelin/contracts/utils/cryptography/EIP712.sol";
+
+contract ERC20Extended is ERC20, AccessControl, ERC20Pausable, ERC20Capped, ERC20Votes {
+    bytes32 public constant MINTER_ROLE = keccak256("MINTER_ROLE");
+    bytes32 public constant PAUSER_ROLE = keccak256("PAUSER_ROLE");
+
+    constructor(uint256 cap_) ERC20("Extended", "EXT") ERC20Capped(cap_) EIP712("Extended Votes", "2") {
+        _grantRole(DEFAULT_ADMIN_ROLE, msg.sender);
+        _grantRole(MINTER_ROLE, msg.sender);
+        _grantRole(PAUSER_ROLE, msg.sender);
+    }
+
+    function mint(address to, uint256 value) external onlyRole(MINTER_ROLE) {
+        _mint(to, value);
+    }
+
+    function pause() external onlyRole(PAUSER_ROLE) {
+        _pause();
+    }
+
+    function unpause() external onlyRole(PAUSER_ROLE) {
+        _unpause();
+    }
+
+    function DOMAIN_SEPARATOR() external view returns (bytes32) {
+        return _domainSeparatorV4();
+    }
+
+    function _update(address from, address to, uint256 value)
+        internal
+        override(ERC20, ERC20Pausable, ERC20Capped, ERC20Votes)
+    {
+        super._update(from, to, value);
+    }
+}
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: MIT
+// Test contract implementing an ERC4626 vault over the ERC20 given to the constructor, with one virtual share and one
+// virtual asset. Assets sent to the vault without deposit raise the value of every share, which tests use as yield.
+
+pragma solidity ^0.8.20;
+
+import {ERC20} from "@openzeppelin/contracts/token/ERC20/ERC20.sol";
+import {IERC20} from "@openzeppelin/contracts/token/ERC20/IERC20.sol";
+import {ERC4626} from "@openzeppelin/contracts/token/ERC20/extensions/ERC4626.sol";
+
+contract ERC4626Vault is ERC4626 {
+    constructor(IERC20 asset_) ERC20("Vault", "VLT") ERC4626(asset_) {}
+}
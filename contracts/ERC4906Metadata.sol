@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+// Test contract serving ERC721 metadata and emitting ERC-4906 metadata update events.
+// tokenURI(id) returns "uri-N" for any id, minted or not, where N is (global version + token version) mod 10:
+// updateToken bumps the version of a token and updateAll the global one.
+
+pragma solidity ^0.8.20;
+
+import {ERC721} from "@openzeppelin/contracts/token/ERC721/ERC721.sol";
+import {IERC4906} from "@openzeppelin/contracts/interfaces/IERC4906.sol";
+import {IERC165} from "@openzeppelin/contracts/utils/introspection/IERC165.sol";
+import {Strings} from "@openzeppelin/contracts/utils/Strings.sol";
+
+contract ERC4906Metadata is ERC721, IERC4906 {
+    uint256 private _version;
+    mapping(uint256 tokenId => uint256) private _tokenVersions;
+
+    constructor() ERC721("Metadata", "META") {}
+
+    function tokenURI(uint256 tokenId) public view override returns (string memory) {
+        return string.concat("uri-", Strings.toString((_version + _tokenVersions[tokenId]) % 10));
+    }
+
+    function updateToken(uint256 tokenId) external {
+        _tokenVersions[tokenId]++;
+        emit MetadataUpdate(tokenId);
+    }
+
+    function updateAll(uint256 fromTokenId, uint256 toTokenId) external {
+        _version++;
+        emit BatchMetadataUpdate(fromTokenId, toTokenId);
+    }
+
+    function supportsInterface(bytes4 interfaceId) public view override(ERC721, IERC165) returns (bool) {
+        return interfaceId == bytes4(0x49064906) || super.supportsInterface(interfaceId);
+    }
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity ^0.8.9;
+
+import "contracts/ERC721A.sol";
+
+/**
+ * @title ERC721ABurnable
+ *
+ * @dev ERC721A collection without the queryable extension whose token IDs start at 1. The constructor mints tokens
+ * 1 to 5 to the deployer and anyone may burn a token they own or are approved for.
+ */
+contract ERC721ABurnable is ERC721A {
+    constructor() ERC721A("Burnable", "BRN") {
+        _mint(msg.sender, 5);
+    }
+
+    /**
+     * @dev Burns `tokenId`. See {ERC721A-_burn}.
+     */
+    function burn(uint256 tokenId) public virtual {
+        _burn(tokenId, true);
+    }
+
+    function _startTokenId() internal view virtual override returns (uint256) {
+        return 1;
+    }
+}
@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: MIT
+// ERC721A Contracts v4.2.3
+// Creator: Chiru Labs
+
+pragma solidity ^0.8.9;
+
+import "contracts/ERC721A.sol";
+import "contracts/IERC721AQueryable.sol";
+
+/**
+ * @title ERC721AQueryable
+ *
+ * @dev ERC721A with the ERC721AQueryable extension. The constructor mints tokens 0 to 9 to the deployer
+ * through {_mintERC2309}, emitting a single ERC-2309 ConsecutiveTransfer event, so that only the first
+ * token of the batch stores its ownership.
+ */
+contract ERC721AQueryable is ERC721A, IERC721AQueryable {
+    constructor() ERC721A("Queryable", "QRY") {
+        _mintERC2309(msg.sender, 10);
+    }
+
+    /**
+     * @dev Returns the `TokenOwnership` struct at `tokenId` without reverting.
+     *
+     * If the `tokenId` is out of bounds:
+     *
+     * - `addr = address(0)`
+     * - `startTimestamp = 0`
+     * - `burned = false`
+     * - `extraData = 0`
+     *
+     * If the `tokenId` is burned:
+     *
+     * - `addr = <Address of owner before token was burned>`
+     * - `startTimestamp = <Timestamp when token was burned>`
+     * - `burned = true`
+     * - `extraData = <Extra data when token was burned>`
+     *
+     * Otherwise:
+     *
+     * - `addr = <Address of owner>`
+     * - `startTimestamp = <Timestamp of start of ownership>`
+     * - `burned = false`
+     * - `extraData = <Extra data at start of ownership>`
+     */
+    function explicitOwnershipOf(
+        uint256 tokenId
+    ) public view virtual override returns (TokenOwnership memory) {
+        TokenOwnership memory ownership;
+        if (tokenId < _startTokenId() || tokenId >= _nextTokenId()) {
+            return ownership;
+        }
+        ownership = _ownershipAt(tokenId);
+        if (ownership.burned) {
+            return ownership;
+        }
+        return _ownershipOf(tokenId);
+    }
+
+    /**
+     * @dev Returns an array of `TokenOwnership` structs at `tokenIds` in order.
+     * See {ERC721AQueryable-explicitOwnershipOf}
+     */
+    function explicitOwnershipsOf(
+        uint256[] memory tokenIds
+    ) external view virtual override returns (TokenOwnership[] memory) {
+        unchecked {
+            uint256 tokenIdsLength = tokenIds.length;
+            TokenOwnership[] memory ownerships = new TokenOwnership[](
+                tokenIdsLength
+            );
+            for (uint256 i; i != tokenIdsLength; ++i) {
+                ownerships[i] = explicitOwnershipOf(tokenIds[i]);
+            }
+            return ownerships;
+        }
+    }
+
+    /**
+     * @dev Returns an array of token IDs owned by `owner`,
+     * in the range [`start`, `stop`)
+     * (i.e. `start <= tokenId < stop`).
+     *
+     * This function allows for tokens to be queried if the collection
+     * grows too big for a single call of {ERC721AQueryable-tokensOfOwner}.
+     *
+     * Requirements:
+     *
+     * - `start < stop`
+     */
+    function tokensOfOwnerIn(
+        address owner,
+        uint256 start,
+        uint256 stop
+    ) external view virtual override returns (uint256[] memory) {
+        unchecked {
+            if (start >= stop) revert InvalidQueryRange();
+            uint256 tokenIdsIdx;
+            uint256 stopLimit = _nextTokenId();
+            // Set `start = max(start, _startTokenId())`.
+            if (start < _startTokenId()) {
+                start = _startTokenId();
+            }
+            // Set `stop = min(stop, stopLimit)`.
+            if (stop > stopLimit) {
+                stop = stopLimit;
+            }
+            uint256 tokenIdsMaxLength = balanceOf(owner);
+            // Set `tokenIdsMaxLength = min(balanceOf(owner), stop - start)`,
+            // to cater for cases where `balanceOf(owner)` is too big.
+            if (start < stop) {
+                uint256 rangeLength = stop - start;
+                if (rangeLength < tokenIdsMaxLength) {
+                    tokenIdsMaxLength = rangeLength;
+                }
+            } else {
+                tokenIdsMaxLength = 0;
+            }
+            uint256[] memory tokenIds = new uint256[](tokenIdsMaxLength);
+            if (tokenIdsMaxLength == 0) {
+                return tokenIds;
+            }
+            // We need to call `explicitOwnershipOf(start)`,
+            // because the slot at `start` may not be initialized.
+            TokenOwnership memory ownership = explicitOwnershipOf(start);
+            address currOwnershipAddr;
+            // If the starting slot exists (i.e. not burned), initialize `currOwnershipAddr`.
+            // `ownership.address` will not be zero, as `start` is clamped to the valid token ID range.
+            if (!ownership.burned) {
+                currOwnershipAddr = ownership.addr;
+            }
+            for (
+                uint256 i = start;
+                i != stop && tokenIdsIdx != tokenIdsMaxLength;
+                ++i
+            ) {
+                ownership = _ownershipAt(i);
+                if (ownership.burned) {
+                    continue;
+                }
+                if (ownership.addr != address(0)) {
+                    currOwnershipAddr = ownership.addr;
+                }
+                if (currOwnershipAddr == owner) {
+                    tokenIds[tokenIdsIdx++] = i;
+                }
+            }
+            // Downsize the array to fit.
+            assembly {
+                mstore(tokenIds, tokenIdsIdx)
+            }
+            return tokenIds;
+        }
+    }
+
+    /**
+     * @dev Returns an array of token IDs owned by `owner`.
+     *
+     * This function scans the ownership mapping and is O(`totalSupply`) in complexity.
+     * It is meant to be called off-chain.
+     *
+     * See {ERC721AQueryable-tokensOfOwnerIn} for splitting the scan into
+     * multiple smaller scans if the collection is large enough to cause
+     * an out-of-gas error (10K collections should be fine).
+     */
+    function tokensOfOwner(
+        address owner
+    ) external view virtual override returns (uint256[] memory) {
+        unchecked {
+            uint256 tokenIdsIdx;
+            address currOwnershipAddr;
+            uint256 tokenIdsLength = balanceOf(owner);
+            uint256[] memory tokenIds = new uint256[](tokenIdsLength);
+            TokenOwnership memory ownership;
+            for (
+                uint256 i = _startTokenId();
+                tokenIdsIdx != tokenIdsLength;
+                ++i
+            ) {
+                ownership = _ownershipAt(i);
+                if (ownership.burned) {
+                    continue;
+                }
+                if (ownership.addr != address(0)) {
+                    currOwnershipAddr = ownership.addr;
+                }
+                if (currOwnershipAddr == owner) {
+                    tokenIds[tokenIdsIdx++] = i;
+                }
+            }
+            return tokenIds;
+        }
+    }
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: MIT
+// Test contract implementing an enumerable ERC721 with ERC-2981 royalties: a default royalty shared by the collection
+// and per-token royalties overriding it. Tokens are minted with explicit ids, so the ids of the collection need not be
+// contiguous. Minting and setting royalties are open to anyone.
+
+pragma solidity ^0.8.20;
+
+import {ERC721} from "@openzeppelin/contracts/token/ERC721/ERC721.sol";
+import {ERC721Enumerable} from "@openzeppelin/contracts/token/ERC721/extensions/ERC721Enumerable.sol";
+import {ERC2981} from "@openzeppelin/contracts/token/common/ERC2981.sol";
+
+contract ERC721Royalties is ERC721Enumerable, ERC2981 {
+    constructor() ERC721("Royalties", "ROY") {}
+
+    function mint(address to, uint256 tokenId) external {
+        _mint(to, tokenId);
+    }
+
+    function setDefaultRoyalty(address receiver, uint96 feeNumerator) external {
+        _setDefaultRoyalty(receiver, feeNumerator);
+    }
+
+    function setTokenRoyalty(uint256 tokenId, address receiver, uint96 feeNumerator) external {
+        _setTokenRoyalty(tokenId, receiver, feeNumerator);
+    }
+
+    function supportsInterface(bytes4 interfaceId) public view override(ERC721Enumerable, ERC2981) returns (bool) {
+        return super.supportsInterface(interfaceId);
+    }
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: MIT
+// Test contract implementing ERC20 with the quirks of non-standard tokens, selected by the flags constructor argument:
+// - 1: transfer, transferFrom and approve return no data and approve requires a zero allowance first, like USDT
+// - 2: name and symbol are returned as bytes32, like MKR
+// - 4: 1% of every transfer is burnt, like fee-on-transfer tokens
+// - 8: balances grow by 1% of the deployment supply per block, like rebasing tokens
+// - 16: transfer and transferFrom return false instead of reverting when the balance is too low
+// The deployer receives the whole supply of 1,000,000 tokens of 18 decimals.
+// Balances are kept in shares, converted to amounts by an index growing with the blocks when flag 8 is set.
+// The quirky return values are written in assembly, the ABI being the one of a standard ERC20.
+
+pragma solidity ^0.8.20;
+
+import {IERC20Metadata} from "@openzeppelin/contracts/token/ERC20/extensions/IERC20Metadata.sol";
+import {IERC20Errors} from "@openzeppelin/contracts/interfaces/draft-IERC6093.sol";
+
+contract QuirkyERC20 is IERC20Metadata, IERC20Errors {
+    uint256 private constant NO_RETURN_DATA = 1;
+    uint256 private constant BYTES32_TEXT = 2;
+    uint256 private constant TRANSFER_FEE = 4;
+    uint256 private constant REBASING = 8;
+    uint256 private constant FALSE_ON_FAILURE = 16;
+
+    error ERC20ApproveFromNonZero(address spender, uint256 currentAllowance);
+
+    uint256 private immutable _flags;
+    uint256 private immutable _deploymentBlock;
+    uint256 private _totalShares;
+    mapping(address account => uint256) private _shares;
+    mapping(address account => mapping(address spender => uint256)) private _allowances;
+
+    constructor(uint256 flags) {
+        _flags = flags;
+        _deploymentBlock = block.number;
+        uint256 supply = 1_000_000 * 10 ** 18;
+        _totalShares = supply;
+        _shares[msg.sender] = supply;
+        emit Transfer(address(0), msg.sender, supply);
+    }
+
+    function name() external view returns (string memory) {
+        _returnText("Quirky");
+    }
+
+    function symbol() external view returns (string memory) {
+        _returnText("QUIRK");
+    }
+
+    function decimals() external pure returns (uint8) {
+        return 18;
+    }
+
+    function totalSupply() external view returns (uint256) {
+        return _toAmount(_totalShares);
+    }
+
+    function balanceOf(address account) external view returns (uint256) {
+        return _toAmount(_shares[account]);
+    }
+
+    function allowance(address owner, address spender) external view returns (uint256) {
+        return _allowances[owner][spender];
+    }
+
+    function transfer(address to, uint256 value) external returns (bool) {
+        if (_hasFlag(FALSE_ON_FAILURE) && _shares[msg.sender] < _toShares(value)) {
+            return false;
+        }
+        _move(msg.sender, to, value);
+        _returnSuccess();
+    }
+
+    function transferFrom(address from, address to, uint256 value) external returns (bool) {
+        uint256 allowed = _allowances[from][msg.sender];
+        if (allowed < value) {
+            revert ERC20InsufficientAllowance(msg.sender, allowed, value);
+        }
+        if (_hasFlag(FALSE_ON_FAILURE) && _shares[from] < _toShares(value)) {
+            return false;
+        }
+        if (allowed != type(uint256).max) {
+            _allowances[from][msg.sender] = allowed - value;
+        }
+        _move(from, to, value);
+        _returnSuccess();
+    }
+
+    function approve(address spender, uint256 value) external returns (bool) {
+        uint256 currentAllowance = _allowances[msg.sender][spender];
+        if (_hasFlag(NO_RETURN_DATA) && value != 0 && currentAllowance != 0) {
+            revert ERC20ApproveFromNonZero(spender, currentAllowance);
+        }
+        _allowances[msg.sender][spender] = value;
+        emit Approval(msg.sender, spender, value);
+        _returnSuccess();
+    }
+
+    function _move(address from, address to, uint256 value) private {
+        if (to == address(0)) {
+            revert ERC20InvalidReceiver(address(0));
+        }
+        uint256 shares = _toShares(value);
+        uint256 fromShares = _shares[from];
+        if (fromShares < shares) {
+            revert ERC20InsufficientBalance(from, _toAmount(fromShares), value);
+        }
+        _shares[from] = fromShares - shares;
+        uint256 fee = _hasFlag(TRANSFER_FEE) ? value / 100 : 0;
+        uint256 feeShares = _toShares(fee);
+        _totalShares -= feeShares;
+        _shares[to] += shares - feeShares;
+        emit Transfer(from, to, value - fee);
+        if (fee != 0) {
+            emit Transfer(from, address(0), fee);
+        }
+    }
+
+    function _hasFlag(uint256 flag) private view returns (bool) {
+        return _flags & flag != 0;
+    }
+
+    // _index is the amount of one share, scaled by 1e18.
+    function _index() private view returns (uint256) {
+        if (!_hasFlag(REBASING)) {
+            return 1e18;
+        }
+        return 1e18 + (block.number - _deploymentBlock) * 1e16;
+    }
+
+    function _toAmount(uint256 shares) private view returns (uint256) {
+        return (shares * _index()) / 1e18;
+    }
+
+    function _toShares(uint256 amount) private view returns (uint256) {
+        return (amount * 1e18) / _index();
+    }
+
+    // _returnSuccess returns true, or no data when NO_RETURN_DATA is set.
+    function _returnSuccess() private view {
+        bool noData = _hasFlag(NO_RETURN_DATA);
+        assembly {
+            if noData {
+                return(0, 0)
+            }
+            mstore(0, 1)
+            return(0, 0x20)
+        }
+    }
+
+    // _returnText returns a string of up to 32 bytes, or a bytes32 when BYTES32_TEXT is set.
+    function _returnText(bytes32 text) private view {
+        uint256 length;
+        while (length < 32 && text[length] != 0) {
+            length++;
+        }
+        bool asBytes32 = _hasFlag(BYTES32_TEXT);
+        assembly {
+            if asBytes32 {
+                mstore(0, text)
+                return(0, 0x20)
+            }
+            mstore(0, 0x20)
+            mstore(0x20, length)
+            mstore(0x40, text)
+            return(0, 0x60)
+        }
+    }
+}
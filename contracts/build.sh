@@ -0,0 +1,15 @@
+#!/bin/sh
+# Builds the given test contracts with forge and regenerates their build/<Name>.abi, build/<Name>.bin and
+# inferences/<Name> binding. The libraries are installed once with:
+#   forge install --no-git OpenZeppelin/openzeppelin-contracts@v5.1.0
+set -e
+
+cd "$(dirname "$0")/.."
+forge build
+for name in "$@"; do
+    artifact="out/$name.sol/$name.json"
+    jq '.abi' "$artifact" > "build/$name.abi"
+    jq -r '.bytecode.object' "$artifact" | sed 's/^0x//' > "build/$name.bin"
+    mkdir -p "inferences/$name"
+    abigen --abi "build/$name.abi" --bin "build/$name.bin" --pkg "$name" --type "$name" --out "inferences/$name/$name.go"
+done
@@ -0,0 +1,55 @@
+package deploy
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Artifact is the ABI and creation bytecode of a contract, as found in the build/*.abi and build/*.bin pairs or in the generated bindings.
+type Artifact struct {
+	Name string
+	ABI  string
+	Bin  string
+}
+
+// LoadArtifact reads the <name>.abi and <name>.bin files of a build directory.
+func LoadArtifact(dir, name string) (Artifact, error) {
+	abiJSON, err := os.ReadFile(filepath.Join(dir, name+".abi"))
+	if err != nil {
+		return Artifact{}, fmt.Errorf("failed to read ABI of %s: %w", name, err)
+	}
+	bin, err := os.ReadFile(filepath.Join(dir, name+".bin"))
+	if err != nil {
+		return Artifact{}, fmt.Errorf("failed to read bytecode of %s: %w", name, err)
+	}
+	return Artifact{Name: name, ABI: string(abiJSON), Bin: strings.TrimSpace(string(bin))}, nil
+}
+
+// LibraryPlaceholder returns the placeholder solc leaves in the bytecode for a library, given by its fully qualified name such as "contracts/Math.sol:Math".
+func LibraryPlaceholder(library string) string {
+	return "__$" + common.Bytes2Hex(crypto.Keccak256([]byte(library)))[:34] + "$__"
+}
+
+// legacyLibraryPlaceholder returns the placeholder of solc versions prior to 0.5, the library name padded with underscores to 40 characters.
+func legacyLibraryPlaceholder(library string) string {
+	if len(library) > 36 {
+		library = library[:36]
+	}
+	return "__" + library + strings.Repeat("_", 38-len(library))
+}
+
+// Link replaces the library placeholders of a bytecode with the address of the libraries, keyed by their fully qualified name.
+// Both the current hashed placeholders and the legacy name based ones are replaced.
+func Link(bin string, libraries map[string]common.Address) string {
+	for library, address := range libraries {
+		hexAddress := strings.ToLower(address.Hex()[2:])
+		bin = strings.ReplaceAll(bin, LibraryPlaceholder(library), hexAddress)
+		bin = strings.ReplaceAll(bin, legacyLibraryPlaceholder(library), hexAddress)
+	}
+	return bin
+}
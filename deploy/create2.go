@@ -0,0 +1,30 @@
+package deploy
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Create2FactoryAddress is the address of the deterministic deployment proxy, available on most public chains.
+var Create2FactoryAddress = common.HexToAddress("0x4e59b44847b379578588920cA78FbF26c0B4956C")
+
+// Create2FactoryBin is the creation code of the deterministic deployment proxy, to deploy it on chains where it is missing.
+// Its calldata is the 32 bytes salt followed by the creation code, it returns the deployed address.
+const Create2FactoryBin = "0x604580600e600039806000f350fe7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035828234f58015156039578182fd5b8082525050506014600cf3"
+
+// Create2Address returns the address a factory deploys a creation code at with the given salt.
+func Create2Address(factory common.Address, salt common.Hash, initCode []byte) common.Address {
+	return crypto.CreateAddress2(factory, salt, crypto.Keccak256(initCode))
+}
+
+// DeployFactory deploys the deterministic deployment proxy with a regular transaction and returns its address.
+// The factory of the deployer is left unchanged.
+func (d *Deployer) DeployFactory(ctx context.Context) (common.Address, error) {
+	deployment, err := d.Deploy(ctx, Spec{Artifact: Artifact{Name: "Create2Factory", ABI: "[]", Bin: Create2FactoryBin}})
+	if err != nil {
+		return common.Address{}, err
+	}
+	return deployment.Address, nil
+}
@@ -0,0 +1,268 @@
+package deploy
+
+// Package deploy deploys contracts from their ABI and bytecode, links their libraries and verifies the deployed contract before returning it.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// ErrDeploymentReverted is returned when the deployment transaction is mined with a failed status.
+	ErrDeploymentReverted = errors.New("deployment reverted")
+	// ErrNoCode is returned when no code is found at the deployed address.
+	ErrNoCode = errors.New("no code at deployed address")
+	// ErrAlreadyDeployed is returned when code already exists at the address of a CREATE2 deployment.
+	ErrAlreadyDeployed = errors.New("contract already deployed")
+	// ErrCheckFailed is returned when a post-deployment check does not return the expected values.
+	ErrCheckFailed = errors.New("post-deployment check failed")
+)
+
+// Check is a read-only call made on the deployed contract, which must return the Expected values.
+type Check struct {
+	Method   string
+	Args     []any
+	Expected []any
+}
+
+// Spec declares a contract deployment.
+type Spec struct {
+	Artifact
+	// Args are the constructor arguments.
+	Args []any
+	// Libraries are the addresses of the linked libraries, keyed by their fully qualified name.
+	Libraries map[string]common.Address
+	// Salt deploys the contract through the CREATE2 factory when set.
+	Salt *common.Hash
+	// Checks are run once the contract is deployed.
+	Checks []Check
+}
+
+// Deployment is a deployed and verified contract.
+type Deployment struct {
+	Address  common.Address
+	Tx       *types.Transaction
+	Receipt  *types.Receipt
+	Contract *bind.BoundContract
+	// Link and AddressLink are the explorer links of the deployment transaction and of the contract, empty when no explorer is set.
+	Link        string
+	AddressLink string
+}
+
+// Config configures the CREATE2 factory and how deployments are awaited.
+type Config struct {
+	// Factory is the CREATE2 factory, called with the salt followed by the creation code.
+	Factory common.Address
+	// PollInterval is the delay between two receipt lookups.
+	PollInterval time.Duration
+	// Timeout bounds the wait for the deployment receipt, zero waits until the context is done.
+	Timeout time.Duration
+}
+
+// DefaultConfig uses the deterministic deployment proxy and waits up to 5 minutes for the receipts.
+var DefaultConfig = Config{
+	Factory:      Create2FactoryAddress,
+	PollInterval: time.Second,
+	Timeout:      5 * time.Minute,
+}
+
+// Deployer deploys contracts with the account of the base interactions.
+type Deployer struct {
+	*base.BaseInteractions
+	config Config
+}
+
+// NewDeployer creates a new Deployer.
+func NewDeployer(baseInteractions *base.BaseInteractions, config Config) *Deployer {
+	if config.Factory == (common.Address{}) {
+		config.Factory = DefaultConfig.Factory
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = DefaultConfig.PollInterval
+	}
+	return &Deployer{baseInteractions, config}
+}
+
+// InitCode returns the linked creation code of a spec followed by its encoded constructor arguments.
+func (d *Deployer) InitCode(spec Spec) ([]byte, error) {
+	_, bytecode, constructorArgs, err := prepare(spec)
+	if err != nil {
+		return nil, err
+	}
+	return append(bytecode, constructorArgs...), nil
+}
+
+// Predict returns the address the spec would be deployed at by the next transaction of the deployer.
+func (d *Deployer) Predict(spec Spec) (common.Address, error) {
+	if spec.Salt != nil {
+		initCode, err := d.InitCode(spec)
+		if err != nil {
+			return common.Address{}, err
+		}
+		return Create2Address(d.config.Factory, *spec.Salt, initCode), nil
+	}
+	nonce, err := base.Observe(d.BaseInteractions, base.ReadOperation, "deploy.PendingNonceAt()", func() (uint64, error) {
+		return d.Client.PendingNonceAt(d.Ctx, d.Address)
+	})
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get user nonce: %w", err)
+	}
+	return crypto.CreateAddress(d.Address, nonce), nil
+}
+
+// Deploy sends the deployment of a spec, waits for its receipt, verifies code exists at the deployed address and runs the checks of the spec.
+func (d *Deployer) Deploy(ctx context.Context, spec Spec) (*Deployment, error) {
+	contractABI, bytecode, constructorArgs, err := prepare(spec)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := d.BaseTxSetup()
+	if err != nil {
+		return nil, err
+	}
+	opts.Context = ctx
+
+	deployment := &Deployment{}
+	if spec.Salt == nil {
+		deployment.Tx, err = base.Observe(d.BaseInteractions, base.SendOperation, "deploy.Deploy()", func() (*types.Transaction, error) {
+			var tx *types.Transaction
+			deployment.Address, tx, deployment.Contract, err = bind.DeployContract(opts, contractABI, bytecode, d.Client, spec.Args...)
+			return tx, err
+		})
+	} else {
+		initCode := append(bytecode, constructorArgs...)
+		deployment.Address = Create2Address(d.config.Factory, *spec.Salt, initCode)
+		var code []byte
+		if code, err = d.codeAt(ctx, deployment.Address, nil); err != nil {
+			return nil, fmt.Errorf("failed to get code at %s: %w", deployment.Address.Hex(), err)
+		}
+		if len(code) > 0 {
+			return nil, fmt.Errorf("%w at %s", ErrAlreadyDeployed, deployment.Address.Hex())
+		}
+		factory := bind.NewBoundContract(d.config.Factory, abi.ABI{}, d.Client, d.Client, d.Client)
+		deployment.Tx, err = base.Observe(d.BaseInteractions, base.SendOperation, "deploy.Deploy()", func() (*types.Transaction, error) {
+			return factory.RawTransact(opts, append(spec.Salt.Bytes(), initCode...))
+		})
+		deployment.Contract = bind.NewBoundContract(deployment.Address, contractABI, d.Client, d.Client, d.Client)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy %s: %w", spec.Name, err)
+	}
+	deployment.Link = d.Explorer().TxURL(deployment.Tx.Hash())
+	deployment.AddressLink = d.Explorer().AddressURL(deployment.Address)
+
+	if deployment.Receipt, err = d.waitReceipt(ctx, deployment.Tx); err != nil {
+		return deployment, err
+	}
+	if deployment.Receipt.Status != types.ReceiptStatusSuccessful {
+		return deployment, fmt.Errorf("%w: %s in tx %s", ErrDeploymentReverted, spec.Name, deployment.Tx.Hash().Hex())
+	}
+	code, err := d.codeAt(ctx, deployment.Address, deployment.Receipt.BlockNumber)
+	if err != nil {
+		return deployment, fmt.Errorf("failed to get code at %s: %w", deployment.Address.Hex(), err)
+	}
+	if len(code) == 0 {
+		return deployment, fmt.Errorf("%w: %s at %s", ErrNoCode, spec.Name, deployment.Address.Hex())
+	}
+
+	for _, check := range spec.Checks {
+		if err := d.runCheck(ctx, deployment.Contract, check); err != nil {
+			return deployment, err
+		}
+	}
+	return deployment, nil
+}
+
+// waitReceipt polls the receipt of a transaction until it is mined, the timeout expires or ctx is done.
+func (d *Deployer) waitReceipt(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	if d.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.config.Timeout)
+		defer cancel()
+	}
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		// Like bind.WaitMined, receipt lookup errors are retried since nodes report them while indexing.
+		receipt, err := base.Observe(d.BaseInteractions, base.ReadOperation, "deploy.TransactionReceipt()", func() (*types.Receipt, error) {
+			return d.Client.TransactionReceipt(ctx, tx.Hash())
+		})
+		if err == nil && receipt != nil {
+			return receipt, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to wait for receipt of %s: %w", tx.Hash().Hex(), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// codeAt reads the code of an account through Observe, nil meaning the latest block.
+func (d *Deployer) codeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return base.Observe(d.BaseInteractions, base.ReadOperation, "deploy.CodeAt()", func() ([]byte, error) {
+		return d.Client.CodeAt(ctx, account, blockNumber)
+	})
+}
+
+// runCheck calls a method of the deployed contract and compares its outputs with the expected values.
+func (d *Deployer) runCheck(ctx context.Context, contract *bind.BoundContract, check Check) error {
+	opts := d.BaseCallSetup()
+	opts.Context = ctx
+	outputs := []any{}
+	_, err := base.Observe(d.BaseInteractions, base.ReadOperation, "deploy.Check()", func() (any, error) {
+		return nil, contract.Call(opts, &outputs, check.Method, check.Args...)
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrCheckFailed, check.Method, err)
+	}
+	if len(outputs) != len(check.Expected) {
+		return fmt.Errorf("%w: %s returned %d values, expected %d", ErrCheckFailed, check.Method, len(outputs), len(check.Expected))
+	}
+	for i := range outputs {
+		if !equal(outputs[i], check.Expected[i]) {
+			return fmt.Errorf("%w: %s returned %v, expected %v", ErrCheckFailed, check.Method, outputs[i], check.Expected[i])
+		}
+	}
+	return nil
+}
+
+// prepare parses the ABI of a spec, links and decodes its bytecode and encodes its constructor arguments.
+func prepare(spec Spec) (abi.ABI, []byte, []byte, error) {
+	contractABI, err := abi.JSON(strings.NewReader(spec.ABI))
+	if err != nil {
+		return abi.ABI{}, nil, nil, fmt.Errorf("failed to parse ABI of %s: %w", spec.Name, err)
+	}
+	bytecode, err := utils.ParseBytecode(Link(spec.Bin, spec.Libraries))
+	if err != nil {
+		return abi.ABI{}, nil, nil, fmt.Errorf("failed to decode bytecode of %s: %w", spec.Name, err)
+	}
+	constructorArgs, err := contractABI.Pack("", spec.Args...)
+	if err != nil {
+		return abi.ABI{}, nil, nil, fmt.Errorf("failed to encode constructor arguments of %s: %w", spec.Name, err)
+	}
+	return contractABI, bytecode, constructorArgs, nil
+}
+
+// equal compares a returned value with an expected one, big integers by value.
+func equal(actual, expected any) bool {
+	if a, ok := actual.(*big.Int); ok {
+		if e, ok := expected.(*big.Int); ok {
+			return a.Cmp(e) == 0
+		}
+	}
+	return reflect.DeepEqual(actual, expected)
+}
@@ -0,0 +1,221 @@
+package deploy_test
+
+// Package deploy_test contains tests for the declarative deployments, library linking and CREATE2 support.
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/deploy"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+)
+
+var erc20Artifact = deploy.Artifact{Name: "ERC20Burnable", ABI: ERC20Burnable.ERC20BurnableABI, Bin: ERC20Burnable.ERC20BurnableBin}
+
+// setupDeployer returns a deployer on a backend mining a block every few milliseconds.
+func setupDeployer(t *testing.T) *deploy.Deployer {
+	backend, _, _, privKey, err := utils.SetupBlockchain(t, ERC20Burnable.ERC20BurnableABI, ERC20Burnable.ERC20BurnableBin)
+	assert.Nil(t, err)
+	done := make(chan struct{})
+	t.Cleanup(func() {
+		close(done)
+		backend.Close()
+	})
+	go mine(backend, done)
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	return deploy.NewDeployer(baseInteractions, deploy.Config{PollInterval: 5 * time.Millisecond, Timeout: 5 * time.Second})
+}
+
+func mine(backend *simulated.Backend, done chan struct{}) {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			backend.Commit()
+		}
+	}
+}
+
+// Test_Deploy verifies the deployments and their post-deployment checks.
+func Test_Deploy(t *testing.T) {
+	deployer := setupDeployer(t)
+	erc721Artifact, err := deploy.LoadArtifact("../build", "ERC721Complete")
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		Name        string
+		Spec        deploy.Spec
+		ExpectedErr error
+		ExpectError bool
+	}{
+		{
+			Name: "OK - Bindings artifact",
+			Spec: deploy.Spec{
+				Artifact: erc20Artifact,
+				Checks: []deploy.Check{
+					{Method: "name", Expected: []any{"TESTToken"}},
+					{Method: "balanceOf", Args: []any{deployer.Address}, Expected: []any{new(big.Int).Mul(big.NewInt(100_000_000), big.NewInt(1e18))}},
+				},
+			},
+		},
+		{
+			Name: "OK - Build artifact with constructor arguments",
+			Spec: deploy.Spec{
+				Artifact: erc721Artifact,
+				Args:     []any{"MyNFT", "MNFT"},
+				Checks:   []deploy.Check{{Method: "symbol", Expected: []any{"MNFT"}}},
+			},
+		},
+		{
+			Name: "KO - Failed check",
+			Spec: deploy.Spec{
+				Artifact: erc20Artifact,
+				Checks:   []deploy.Check{{Method: "symbol", Expected: []any{"USDC"}}},
+			},
+			ExpectedErr: deploy.ErrCheckFailed,
+		},
+		{
+			Name: "KO - Unknown check method",
+			Spec: deploy.Spec{
+				Artifact: erc20Artifact,
+				Checks:   []deploy.Check{{Method: "decimal", Expected: []any{uint8(18)}}},
+			},
+			ExpectedErr: deploy.ErrCheckFailed,
+		},
+		{
+			Name:        "KO - No code deployed",
+			Spec:        deploy.Spec{Artifact: deploy.Artifact{Name: "Stop", ABI: "[]", Bin: "0x00"}},
+			ExpectedErr: deploy.ErrNoCode,
+		},
+		{
+			Name:        "KO - Empty bytecode",
+			Spec:        deploy.Spec{Artifact: deploy.Artifact{Name: "Empty", ABI: "[]", Bin: "0x"}},
+			ExpectError: true,
+		},
+		{
+			Name:        "KO - Invalid bytecode",
+			Spec:        deploy.Spec{Artifact: deploy.Artifact{Name: "Invalid", ABI: "[]", Bin: "0x6x"}},
+			ExpectError: true,
+		},
+		{
+			Name:        "KO - Missing constructor arguments",
+			Spec:        deploy.Spec{Artifact: erc721Artifact},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			predicted, err := deployer.Predict(tc.Spec)
+			assert.Nil(t, err)
+			deployment, err := deployer.Deploy(context.Background(), tc.Spec)
+			if tc.ExpectedErr != nil {
+				assert.ErrorIs(t, err, tc.ExpectedErr)
+				return
+			}
+			if tc.ExpectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, predicted, deployment.Address)
+			assert.Equal(t, deployment.Address, deployment.Receipt.ContractAddress)
+		})
+	}
+}
+
+// Test_Create2 verifies the deployments through the CREATE2 factory land at the predicted address.
+func Test_Create2(t *testing.T) {
+	deployer := setupDeployer(t)
+	factory, err := deployer.DeployFactory(context.Background())
+	assert.Nil(t, err)
+	deployer = deploy.NewDeployer(deployer.BaseInteractions, deploy.Config{Factory: factory, PollInterval: 5 * time.Millisecond, Timeout: 5 * time.Second})
+
+	salt := common.HexToHash("0x01")
+	spec := deploy.Spec{
+		Artifact: erc20Artifact,
+		Salt:     &salt,
+		Checks:   []deploy.Check{{Method: "symbol", Expected: []any{"TT"}}},
+	}
+	predicted, err := deployer.Predict(spec)
+	assert.Nil(t, err)
+	initCode, err := deployer.InitCode(spec)
+	assert.Nil(t, err)
+	assert.Equal(t, deploy.Create2Address(factory, salt, initCode), predicted)
+
+	t.Run("OK - Predicted address", func(t *testing.T) {
+		deployer.SetExplorer(base.NewExplorer("Test", "https://explorer.test"))
+		defer deployer.SetExplorer(nil)
+		deployment, err := deployer.Deploy(context.Background(), spec)
+		assert.Nil(t, err)
+		assert.Equal(t, predicted, deployment.Address)
+		assert.Equal(t, "https://explorer.test/tx/"+deployment.Tx.Hash().Hex(), deployment.Link)
+		assert.Equal(t, "https://explorer.test/address/"+predicted.Hex(), deployment.AddressLink)
+	})
+
+	t.Run("KO - Already deployed", func(t *testing.T) {
+		_, err := deployer.Deploy(context.Background(), spec)
+		assert.ErrorIs(t, err, deploy.ErrAlreadyDeployed)
+	})
+
+	t.Run("OK - Other salt", func(t *testing.T) {
+		other := common.HexToHash("0x02")
+		spec.Salt = &other
+		deployment, err := deployer.Deploy(context.Background(), spec)
+		assert.Nil(t, err)
+		assert.NotEqual(t, predicted, deployment.Address)
+	})
+}
+
+// Test_Link verifies the replacement of the library placeholders.
+func Test_Link(t *testing.T) {
+	library := common.HexToAddress("0x00000000000000000000000000000000000000AB")
+	linked := "6073" + "00000000000000000000000000000000000000ab" + "00"
+
+	testCases := []struct {
+		Name        string
+		Bin         string
+		Libraries   map[string]common.Address
+		ExpectError bool
+	}{
+		{
+			Name:      "OK - Hashed placeholder",
+			Bin:       "6073" + deploy.LibraryPlaceholder("contracts/Math.sol:Math") + "00",
+			Libraries: map[string]common.Address{"contracts/Math.sol:Math": library},
+		},
+		{
+			Name:      "OK - Legacy placeholder",
+			Bin:       "6073" + "__Math__________________________________" + "00",
+			Libraries: map[string]common.Address{"Math": library},
+		},
+		{
+			Name:        "KO - Missing library",
+			Bin:         "6073" + deploy.LibraryPlaceholder("contracts/Math.sol:Math") + "00",
+			Libraries:   map[string]common.Address{"contracts/Strings.sol:Strings": library},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			bytecode, err := utils.ParseBytecode(deploy.Link(tc.Bin, tc.Libraries))
+			if tc.ExpectError {
+				assert.ErrorContains(t, err, "offset 2")
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, linked, common.Bytes2Hex(bytecode))
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package erc1155
+
+// Package erc1155 provides base functionality for interacting with multi-tokens using the IERC1155 standard.
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/cache"
+	"github.com/OCharless/eth-interfaces/customerrors"
+	"github.com/OCharless/eth-interfaces/inferences/ERC1155Complete"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ERC1155Interactions wraps multi-token interactions using an underlying base interaction and an ERC1155 session.
+type ERC1155Interactions struct {
+	*base.BaseInteractions
+	erc1155Session *ERC1155Complete.ERC1155CompleteSession
+	address        common.Address
+	callError      func(string, error) *base.CallError
+	metaCache      *cache.ContractCache
+}
+
+// NewERC1155Interactions creates a new instance of ERC1155Interactions from a base interaction interface and a multi-token contract address.
+// The contract must advertise the IERC1155 interface through ERC-165, as the standard requires.
+func NewERC1155Interactions(
+	baseInteractions *base.BaseInteractions,
+	address common.Address,
+	signatures []BaseERC1155Signature,
+	transactOps ...*bind.TransactOpts,
+) (*ERC1155Interactions, error) {
+
+	var converted []utils.Signature
+	for _, sig := range signatures {
+		converted = append(converted, sig)
+	}
+
+	err := baseInteractions.CheckSignatures(address, converted)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("CheckSignatures", err)
+	}
+
+	supported, err := baseInteractions.SupportsInterface(address, utils.IERC1155_INTERFACE_ID)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("SupportsInterface", err)
+	}
+	if !supported {
+		return nil, fmt.Errorf("contract %s does not support the IERC1155 interface", address.Hex())
+	}
+
+	var txOpts *bind.TransactOpts
+	if len(transactOps) == 0 {
+		txOpts, err = baseInteractions.BaseTxSetup()
+		if err != nil {
+			return nil, customerrors.WrapinterfacingError("BaseTxSetup", err)
+		}
+	} else {
+		txOpts = transactOps[0]
+	}
+
+	erc1155Complete, err := ERC1155Complete.NewERC1155Complete(address, baseInteractions.Client)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("NewERC1155Interactions", err)
+	}
+	erc1155Session := ERC1155Complete.ERC1155CompleteSession{
+		Contract:     erc1155Complete,
+		CallOpts:     bind.CallOpts{Pending: true, From: baseInteractions.Address},
+		TransactOpts: *txOpts,
+	}
+
+	callError := func(field string, err error) *base.CallError {
+		return baseInteractions.WrapCallError(ERC1155Complete.ERC1155CompleteABI, field, err)
+	}
+
+	return &ERC1155Interactions{baseInteractions,
+		&erc1155Session,
+		address,
+		callError,
+		nil,
+	}, nil
+}
+
+// SetCache sets the cache used for the token URIs, nil disables caching.
+func (d *ERC1155Interactions) SetCache(c *cache.Cache) error {
+	if c == nil {
+		d.metaCache = nil
+		return nil
+	}
+	chainID, err := base.Observe(d.BaseInteractions, base.ReadOperation, "erc1155.ChainID()", func() (*big.Int, error) {
+		return d.Client.ChainID(d.Ctx)
+	})
+	if err != nil {
+		return customerrors.WrapinterfacingError("ChainID", err)
+	}
+	d.metaCache = c.Contract(chainID.Uint64(), d.address)
+	return nil
+}
+
+// GetAddress returns the multi-token contract address.
+func (d *ERC1155Interactions) GetAddress() common.Address {
+	return d.address
+}
+
+// GetSession returns the current session used for multi-token interactions.
+func (d *ERC1155Interactions) GetSession() ERC1155Complete.ERC1155CompleteSession {
+	return *d.erc1155Session
+}
+
+// GetBalance retrieves the balance of a token id for the associated address.
+func (d *ERC1155Interactions) GetBalance(id *big.Int) (*big.Int, error) {
+	return d.BalanceOf(d.Address, id)
+}
+
+// BalanceOf retrieves the balance of a token id for a given owner.
+func (d *ERC1155Interactions) BalanceOf(owner common.Address, id *big.Int) (*big.Int, error) {
+	balance, err := base.Observe(d.BaseInteractions, base.ReadOperation, "erc1155.BalanceOf()", func() (*big.Int, error) {
+		return d.erc1155Session.BalanceOf(owner, id)
+	})
+	if err != nil {
+		return nil, d.callError("erc1155.BalanceOf()", err)
+	}
+	return balance, nil
+}
+
+// BalanceOfBatch retrieves the balances of several owner and token id pairs in a single call.
+func (d *ERC1155Interactions) BalanceOfBatch(owners []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	balances, err := base.Observe(d.BaseInteractions, base.ReadOperation, "erc1155.BalanceOfBatch()", func() ([]*big.Int, error) {
+		return d.erc1155Session.BalanceOfBatch(owners, ids)
+	})
+	if err != nil {
+		return nil, d.callError("erc1155.BalanceOfBatch()", err)
+	}
+	return balances, nil
+}
+
+// URI returns the raw metadata URI of a token id, which may contain the {id} placeholder, from the metadata cache when one is set.
+func (d *ERC1155Interactions) URI(id *big.Int) (string, error) {
+	return d.metaCache.Fetch("uri", id, func() (string, error) {
+		uri, err := base.Observe(d.BaseInteractions, base.ReadOperation, "erc1155.Uri()", func() (string, error) {
+			return d.erc1155Session.Uri(id)
+		})
+		if err != nil {
+			return "", d.callError("erc1155.Uri()", err)
+		}
+		return uri, nil
+	})
+}
+
+// TokenURI returns the metadata URI of a token id with the {id} placeholder substituted as the standard requires.
+func (d *ERC1155Interactions) TokenURI(id *big.Int) (string, error) {
+	uri, err := d.URI(id)
+	if err != nil {
+		return "", err
+	}
+	return strings.ReplaceAll(uri, "{id}", fmt.Sprintf("%064x", id)), nil
+}
+
+// IsApprovedForAll tells whether an operator may transfer every token of an owner.
+func (d *ERC1155Interactions) IsApprovedForAll(owner, operator common.Address) (bool, error) {
+	approved, err := base.Observe(d.BaseInteractions, base.ReadOperation, "erc1155.IsApprovedForAll()", func() (bool, error) {
+		return d.erc1155Session.IsApprovedForAll(owner, operator)
+	})
+	if err != nil {
+		return false, d.callError("erc1155.IsApprovedForAll()", err)
+	}
+	return approved, nil
+}
+
+// SetApprovalForAll grants or revokes an operator the right to transfer every token of the signer.
+func (d *ERC1155Interactions) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	tx, err := base.Observe(d.BaseInteractions, base.SendOperation, "erc1155.SetApprovalForAll()", func() (*types.Transaction, error) {
+		return d.erc1155Session.SetApprovalForAll(operator, approved)
+	})
+	if err != nil {
+		return nil, d.callError("erc1155.SetApprovalForAll()", err)
+	}
+	return tx, nil
+}
+
+// TransferTo transfers an amount of a token id from the signer to another address.
+func (d *ERC1155Interactions) TransferTo(to common.Address, id, amount *big.Int) (*types.Transaction, error) {
+	tx, err := base.Observe(d.BaseInteractions, base.SendOperation, "erc1155.SafeTransferFrom()", func() (*types.Transaction, error) {
+		return d.erc1155Session.SafeTransferFrom(d.Address, to, id, amount, []byte{})
+	})
+	if err != nil {
+		return nil, d.callError("erc1155.SafeTransferFrom()", err)
+	}
+	return tx, nil
+}
+
+// BatchTransferTo transfers amounts of several token ids from the signer to another address in a single transaction.
+func (d *ERC1155Interactions) BatchTransferTo(to common.Address, ids, amounts []*big.Int) (*types.Transaction, error) {
+	tx, err := base.Observe(d.BaseInteractions, base.SendOperation, "erc1155.SafeBatchTransferFrom()", func() (*types.Transaction, error) {
+		return d.erc1155Session.SafeBatchTransferFrom(d.Address, to, ids, amounts, []byte{})
+	})
+	if err != nil {
+		return nil, d.callError("erc1155.SafeBatchTransferFrom()", err)
+	}
+	return tx, nil
+}
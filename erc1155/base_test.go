@@ -0,0 +1,158 @@
+package erc1155_test
+
+// Package erc1155_test contains tests for multi-token interactions defined in base.go.
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/erc1155"
+	"github.com/OCharless/eth-interfaces/inferences/ERC1155Complete"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Instantiation verifies that the multi-token interactions are only created for ERC1155 contracts.
+func Test_Instantiation(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC1155Complete.ERC1155CompleteABI,
+		ERC1155Complete.ERC1155CompleteBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	erc20Addr, _, _, err := utils.DeployContract(auth, backend.Client(), ERC20Burnable.ERC20BurnableABI, ERC20Burnable.ERC20BurnableBin)
+	assert.Nil(t, err)
+	backend.Commit()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+
+	testCases := []struct {
+		Name         string
+		ContractAddr common.Address
+		Signatures   []erc1155.BaseERC1155Signature
+		ExpectError  bool
+	}{
+		{
+			Name:         "OK - ERC1155",
+			ContractAddr: *contractAddr,
+			Signatures:   []erc1155.BaseERC1155Signature{erc1155.BalanceOf, erc1155.BalanceOfBatch, erc1155.URI},
+		},
+		{
+			Name:         "KO - ERC20",
+			ContractAddr: erc20Addr,
+			ExpectError:  true,
+		},
+		{
+			Name:         "KO - Missing signature",
+			ContractAddr: *contractAddr,
+			Signatures:   []erc1155.BaseERC1155Signature{"royaltyInfo(uint256,uint256)"},
+			ExpectError:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := erc1155.NewERC1155Interactions(baseInteractions, tc.ContractAddr, tc.Signatures, auth)
+			if tc.ExpectError {
+				assert.Error(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+// Test_Transfers verifies balances, approvals, URIs and single and batch transfers.
+func Test_Transfers(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC1155Complete.ERC1155CompleteABI,
+		ERC1155Complete.ERC1155CompleteBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	contract, err := ERC1155Complete.NewERC1155Complete(*contractAddr, backend.Client())
+	assert.Nil(t, err)
+	for id := int64(1); id <= 3; id++ {
+		_, err = contract.Mint(auth, auth.From, big.NewInt(id), big.NewInt(id*10))
+		assert.Nil(t, err)
+	}
+	backend.Commit()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	multiToken, err := erc1155.NewERC1155Interactions(baseInteractions, *contractAddr, []erc1155.BaseERC1155Signature{}, auth)
+	assert.Nil(t, err)
+	recipient := common.HexToAddress("0x000000000000000000000000000000000000beef")
+
+	t.Run("OK - Balances", func(t *testing.T) {
+		balance, err := multiToken.GetBalance(big.NewInt(2))
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(20), balance)
+
+		balances, err := multiToken.BalanceOfBatch(
+			[]common.Address{auth.From, auth.From, recipient},
+			[]*big.Int{big.NewInt(1), big.NewInt(3), big.NewInt(1)},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, "[10 30 0]", fmt.Sprint(balances))
+	})
+
+	t.Run("OK - URI", func(t *testing.T) {
+		uri, err := multiToken.URI(big.NewInt(1))
+		assert.Nil(t, err)
+		assert.Equal(t, "ipfs://meta/{id}", uri)
+
+		uri, err = multiToken.TokenURI(big.NewInt(0x4cce))
+		assert.Nil(t, err)
+		assert.Equal(t, "ipfs://meta/0000000000000000000000000000000000000000000000000000000000004cce", uri)
+	})
+
+	t.Run("OK - Approval", func(t *testing.T) {
+		_, err := multiToken.SetApprovalForAll(recipient, true)
+		assert.Nil(t, err)
+		backend.Commit()
+
+		approved, err := multiToken.IsApprovedForAll(auth.From, recipient)
+		assert.Nil(t, err)
+		assert.True(t, approved)
+	})
+
+	t.Run("OK - Transfers", func(t *testing.T) {
+		tx, err := multiToken.TransferTo(recipient, big.NewInt(1), big.NewInt(4))
+		assert.Nil(t, err)
+		backend.Commit()
+		receipt, err := backend.Client().TransactionReceipt(context.Background(), tx.Hash())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), receipt.Status)
+
+		tx, err = multiToken.BatchTransferTo(recipient, []*big.Int{big.NewInt(2), big.NewInt(3)}, []*big.Int{big.NewInt(5), big.NewInt(6)})
+		assert.Nil(t, err)
+		backend.Commit()
+		receipt, err = backend.Client().TransactionReceipt(context.Background(), tx.Hash())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), receipt.Status)
+
+		batch, err := contract.ParseTransferBatch(*receipt.Logs[0])
+		assert.Nil(t, err)
+		assert.Equal(t, "[2 3]", fmt.Sprint(batch.Ids))
+		assert.Equal(t, "[5 6]", fmt.Sprint(batch.Values))
+
+		balances, err := multiToken.BalanceOfBatch(
+			[]common.Address{recipient, recipient, recipient, auth.From},
+			[]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(3)},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, "[4 5 6 24]", fmt.Sprint(balances))
+	})
+
+	t.Run("KO - Insufficient balance", func(t *testing.T) {
+		_, err := multiToken.TransferTo(recipient, big.NewInt(1), big.NewInt(100))
+		assert.Error(t, err)
+	})
+}
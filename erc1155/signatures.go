@@ -0,0 +1,26 @@
+package erc1155
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type BaseERC1155Signature string
+
+const (
+	BalanceOf             BaseERC1155Signature = "balanceOf(address,uint256)"
+	BalanceOfBatch        BaseERC1155Signature = "balanceOfBatch(address[],uint256[])"
+	SetApprovalForAll     BaseERC1155Signature = "setApprovalForAll(address,bool)"
+	IsApprovedForAll      BaseERC1155Signature = "isApprovedForAll(address,address)"
+	SafeTransferFrom      BaseERC1155Signature = "safeTransferFrom(address,address,uint256,uint256,bytes)"
+	SafeBatchTransferFrom BaseERC1155Signature = "safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)"
+	URI                   BaseERC1155Signature = "uri(uint256)"
+)
+
+func (s BaseERC1155Signature) GetHex() string {
+	hash := crypto.NewKeccakState()
+	hash.Write([]byte(s))
+	selector := hash.Sum(nil)[:4]
+	return hex.EncodeToString(selector)
+}
@@ -0,0 +1,192 @@
+package erc20
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// allowanceABI holds the allowance functions removed from OpenZeppelin 5, which the bundled bindings lack.
+const allowanceABI = `[
+	{"inputs":[{"name":"spender","type":"address"},{"name":"addedValue","type":"uint256"}],"name":"increaseAllowance","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},
+	{"inputs":[{"name":"spender","type":"address"},{"name":"subtractedValue","type":"uint256"}],"name":"decreaseAllowance","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}
+]`
+
+// Approval is a spender approved by the account, along with its current allowance.
+type Approval struct {
+	Spender   common.Address
+	Allowance *big.Int
+	// Block and TxHash locate the last Approval event of the spender, Link is the explorer link of its transaction.
+	Block  uint64
+	TxHash common.Hash
+	Link   string
+}
+
+// approve sends an approve transaction, using the given nonce instead of the one of the session when set.
+// The approval is simulated first, like the transfers of TransferTo.
+func (d *ERC20Interactions) approve(spender common.Address, amount *big.Int, nonce *big.Int) (*types.Transaction, error) {
+	if err := d.checkSuccess("erc20.Approve()", "approve", spender, amount); err != nil {
+		return nil, err
+	}
+	opts := d.ierc20Session.TransactOpts
+	if nonce != nil {
+		opts.Nonce = nonce
+	}
+	tx, err := base.Observe(d.BaseInteractions, base.SendOperation, "erc20.Approve()", func() (*types.Transaction, error) {
+		return d.ierc20Session.Contract.Approve(&opts, spender, amount)
+	})
+	if err != nil {
+		return nil, d.callError("erc20.Approve()", err)
+	}
+	return tx, nil
+}
+
+// SafeApprove sets the allowance of the spender to amount, going through zero when both are not zero, as tokens such as USDT require.
+// The zero approval is awaited before the new allowance is sent. No transaction is sent when the allowance is already amount.
+func (d *ERC20Interactions) SafeApprove(spender common.Address, amount *big.Int) ([]*types.Transaction, error) {
+	current, err := d.Allowance(d.Address, spender)
+	if err != nil {
+		return nil, err
+	}
+	if current.Cmp(amount) == 0 {
+		return nil, nil
+	}
+
+	var txs []*types.Transaction
+	var nonce *big.Int
+	if current.Sign() != 0 && amount.Sign() != 0 {
+		tx, err := d.approve(spender, common.Big0, nil)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+		receipt, err := d.WaitMined(tx)
+		if err != nil {
+			return txs, fmt.Errorf("failed to wait for tx %s: %w", tx.Hash().Hex(), err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return txs, fmt.Errorf("approval reset reverted in tx %s", tx.Hash().Hex())
+		}
+		nonce = new(big.Int).SetUint64(tx.Nonce() + 1)
+	}
+	tx, err := d.approve(spender, amount, nonce)
+	if err != nil {
+		return txs, err
+	}
+	return append(txs, tx), nil
+}
+
+// EnsureAllowance approves amount through SafeApprove when the allowance of the spender is lower, and sends nothing otherwise.
+func (d *ERC20Interactions) EnsureAllowance(spender common.Address, amount *big.Int) ([]*types.Transaction, error) {
+	current, err := d.Allowance(d.Address, spender)
+	if err != nil {
+		return nil, err
+	}
+	if current.Cmp(amount) >= 0 {
+		return nil, nil
+	}
+	return d.SafeApprove(spender, amount)
+}
+
+// IncreaseAllowance raises the allowance of the spender by amount, for tokens implementing increaseAllowance.
+func (d *ERC20Interactions) IncreaseAllowance(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return d.changeAllowance(IncreaseAllowance, "erc20.IncreaseAllowance()", spender, amount)
+}
+
+// DecreaseAllowance lowers the allowance of the spender by amount, for tokens implementing decreaseAllowance.
+func (d *ERC20Interactions) DecreaseAllowance(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return d.changeAllowance(DecreaseAllowance, "erc20.DecreaseAllowance()", spender, amount)
+}
+
+func (d *ERC20Interactions) changeAllowance(signature BaseERC20Signature, method string, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	if err := d.CheckSignatures(d.nftAddress, []utils.Signature{signature}); err != nil {
+		return nil, err
+	}
+	parsed, err := abi.JSON(strings.NewReader(allowanceABI))
+	if err != nil {
+		return nil, err
+	}
+	opts := d.ierc20Session.TransactOpts
+	contract := bind.NewBoundContract(d.nftAddress, parsed, d.Client, d.Client, d.Client)
+	name := strings.TrimSuffix(string(signature), "(address,uint256)")
+	tx, err := base.Observe(d.BaseInteractions, base.SendOperation, method, func() (*types.Transaction, error) {
+		return contract.Transact(&opts, name, spender, amount)
+	})
+	if err != nil {
+		return nil, d.callError(method, err)
+	}
+	return tx, nil
+}
+
+// Approvals scans the Approval events of the account from the given block and returns the spenders whose allowance is not zero,
+// ordered by their last approval.
+func (d *ERC20Interactions) Approvals(fromBlock uint64) ([]Approval, error) {
+	iterator, err := base.Observe(d.BaseInteractions, base.ReadOperation, "erc20.FilterApproval()", func() (*ERC20Burnable.ERC20BurnableApprovalIterator, error) {
+		return d.ierc20Session.Contract.FilterApproval(&bind.FilterOpts{Start: fromBlock, Context: d.Ctx}, []common.Address{d.Address}, nil)
+	})
+	if err != nil {
+		return nil, d.callError("erc20.FilterApproval()", err)
+	}
+	defer iterator.Close()
+
+	last := map[common.Address]types.Log{}
+	for iterator.Next() {
+		last[iterator.Event.Spender] = iterator.Event.Raw
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, d.callError("erc20.FilterApproval()", err)
+	}
+
+	approvals := []Approval{}
+	for spender, log := range last {
+		allowance, err := d.Allowance(d.Address, spender)
+		if err != nil {
+			return nil, err
+		}
+		if allowance.Sign() == 0 {
+			continue
+		}
+		approvals = append(approvals, Approval{
+			Spender:   spender,
+			Allowance: allowance,
+			Block:     log.BlockNumber,
+			TxHash:    log.TxHash,
+			Link:      d.Explorer().TxURL(log.TxHash),
+		})
+	}
+	sort.Slice(approvals, func(i, j int) bool {
+		if approvals[i].Block != approvals[j].Block {
+			return approvals[i].Block < approvals[j].Block
+		}
+		return approvals[i].Spender.Cmp(approvals[j].Spender) < 0
+	})
+	return approvals, nil
+}
+
+// RevokeAll sets to zero the allowance of every spender returned by Approvals, sending one transaction per spender.
+func (d *ERC20Interactions) RevokeAll(fromBlock uint64) ([]*types.Transaction, error) {
+	approvals, err := d.Approvals(fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	var txs []*types.Transaction
+	var nonce *big.Int
+	for _, approval := range approvals {
+		tx, err := d.approve(approval.Spender, common.Big0, nonce)
+		if err != nil {
+			return txs, err
+		}
+		txs = append(txs, tx)
+		nonce = new(big.Int).SetUint64(tx.Nonce() + 1)
+	}
+	return txs, nil
+}
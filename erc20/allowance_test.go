@@ -0,0 +1,144 @@
+package erc20_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Allowance"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Allowance verifies the allowance helpers against a token enforcing the USDT approval rule.
+func Test_Allowance(t *testing.T) {
+	backend, auth, contractAddress, privKey, err := utils.SetupBlockchain(t,
+		ERC20Allowance.ERC20AllowanceABI,
+		ERC20Allowance.ERC20AllowanceBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	// SafeApprove waits for the zero approval to be mined.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+				backend.Commit()
+			}
+		}
+	}()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, base.NewExplorer("Test", "https://explorer.test"))
+	token, err := erc20.NewIERC20Interactions(baseInteractions, *contractAddress, []erc20.BaseERC20Signature{erc20.Allowance, erc20.Approve}, auth)
+	assert.Nil(t, err)
+
+	spender, other, revoked := common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")
+	mined := func(txs ...*types.Transaction) {
+		t.Helper()
+		for _, tx := range txs {
+			receipt, err := bind.WaitMined(context.Background(), backend.Client(), tx)
+			assert.Nil(t, err)
+			assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+		}
+	}
+	allowance := func(spender common.Address) string {
+		t.Helper()
+		value, err := token.Allowance(baseInteractions.Address, spender)
+		assert.Nil(t, err)
+		return value.String()
+	}
+
+	t.Run("OK - Ensure allowance", func(t *testing.T) {
+		txs, err := token.EnsureAllowance(spender, big.NewInt(100))
+		assert.Nil(t, err)
+		assert.Len(t, txs, 1)
+		mined(txs...)
+		assert.Equal(t, "100", allowance(spender))
+
+		txs, err = token.EnsureAllowance(spender, big.NewInt(50))
+		assert.Nil(t, err)
+		assert.Empty(t, txs)
+	})
+
+	t.Run("KO - Approve over a non-zero allowance", func(t *testing.T) {
+		_, err := token.Approve(spender, big.NewInt(200))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("OK - Safe approve", func(t *testing.T) {
+		txs, err := token.SafeApprove(spender, big.NewInt(200))
+		assert.Nil(t, err)
+		assert.Len(t, txs, 2)
+		mined(txs...)
+		assert.Equal(t, "200", allowance(spender))
+
+		txs, err = token.SafeApprove(spender, big.NewInt(200))
+		assert.Nil(t, err)
+		assert.Empty(t, txs)
+	})
+
+	t.Run("OK - Increase and decrease", func(t *testing.T) {
+		tx, err := token.IncreaseAllowance(spender, big.NewInt(50))
+		assert.Nil(t, err)
+		mined(tx)
+		assert.Equal(t, "250", allowance(spender))
+
+		tx, err = token.DecreaseAllowance(spender, big.NewInt(100))
+		assert.Nil(t, err)
+		mined(tx)
+		assert.Equal(t, "150", allowance(spender))
+	})
+
+	t.Run("KO - Increase without increaseAllowance", func(t *testing.T) {
+		burnableAddress, tx, _, err := ERC20Burnable.DeployERC20Burnable(auth, backend.Client())
+		assert.Nil(t, err)
+		mined(tx)
+		burnable, err := erc20.NewIERC20Interactions(baseInteractions, burnableAddress, []erc20.BaseERC20Signature{}, auth)
+		assert.Nil(t, err)
+		_, err = burnable.IncreaseAllowance(spender, big.NewInt(50))
+		assert.ErrorContains(t, err, "increaseAllowance")
+	})
+
+	t.Run("OK - Approvals and revoke all", func(t *testing.T) {
+		txs, err := token.EnsureAllowance(other, big.NewInt(30))
+		assert.Nil(t, err)
+		mined(txs...)
+		txs, err = token.EnsureAllowance(revoked, big.NewInt(10))
+		assert.Nil(t, err)
+		mined(txs...)
+		txs, err = token.SafeApprove(revoked, common.Big0)
+		assert.Nil(t, err)
+		mined(txs...)
+
+		approvals, err := token.Approvals(0)
+		assert.Nil(t, err)
+		assert.Len(t, approvals, 2)
+		report := []string{}
+		for _, approval := range approvals {
+			report = append(report, fmt.Sprintf("%s %s", approval.Spender.Hex(), approval.Allowance))
+			assert.Equal(t, "https://explorer.test/tx/"+approval.TxHash.Hex(), approval.Link)
+		}
+		assert.Equal(t, []string{spender.Hex() + " 150", other.Hex() + " 30"}, report)
+
+		txs, err = token.RevokeAll(0)
+		assert.Nil(t, err)
+		assert.Len(t, txs, 2)
+		mined(txs...)
+		approvals, err = token.Approvals(0)
+		assert.Nil(t, err)
+		assert.Empty(t, approvals)
+	})
+}
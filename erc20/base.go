@@ -4,8 +4,10 @@ package erc20
 
 import (
 	"math/big"
+	"strconv"
 
 	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/cache"
 	"github.com/OCharless/eth-interfaces/contractextension"
 	"github.com/OCharless/eth-interfaces/customerrors"
 	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
@@ -25,6 +27,8 @@ type ERC20Interactions struct {
 	ierc20Session *ERC20Burnable.ERC20BurnableSession
 	nftAddress    common.Address
 	callError     func(string, error) *base.CallError
+	metaCache     *cache.ContractCache
+	holderIndex   HolderIndex
 }
 
 // NewIERC20Interactions creates a new instance of IERC20AInteractions from a base interaction interface and an NFT contract address.
@@ -73,6 +77,8 @@ func NewIERC20Interactions(
 		&ierc20Session,
 		address,
 		callError,
+		nil,
+		nil,
 	}
 
 	if err := contractextension.SimulateCall(baseInteractions.Ctx, ERC20Burnable.ERC20BurnableABI, "name", ierc20Asession); err != nil {
@@ -82,6 +88,22 @@ func NewIERC20Interactions(
 	return ierc20Asession, nil
 }
 
+// SetCache sets the cache used for the name, symbol and decimals of the token, nil disables caching.
+func (d *ERC20Interactions) SetCache(c *cache.Cache) error {
+	if c == nil {
+		d.metaCache = nil
+		return nil
+	}
+	chainID, err := base.Observe(d.BaseInteractions, base.ReadOperation, "erc20.ChainID()", func() (*big.Int, error) {
+		return d.Client.ChainID(d.Ctx)
+	})
+	if err != nil {
+		return customerrors.WrapinterfacingError("ChainID", err)
+	}
+	d.metaCache = c.Contract(chainID.Uint64(), d.nftAddress)
+	return nil
+}
+
 // GetNFTAddress returns the NFT contract address.
 func (d *ERC20Interactions) GetAddress() common.Address {
 	return d.nftAddress
@@ -94,16 +116,24 @@ func (d *ERC20Interactions) GetSession() ERC20Burnable.ERC20BurnableSession {
 
 // GetBalance retrieves the balance of NFTs for the associated address.
 func (d *ERC20Interactions) GetBalance() (*big.Int, error) {
-	balance, err := d.ierc20Session.BalanceOf(d.Address)
+	balance, err := base.Observe(d.BaseInteractions, base.ReadOperation, "erc20.BalanceOf()", func() (*big.Int, error) {
+		return d.ierc20Session.BalanceOf(d.Address)
+	})
 	if err != nil {
 		return nil, d.callError("erc20.BalanceOf()", err)
 	}
 	return balance, nil
 }
 
-// TransferTo transfers a specific token to another address after verifying ownership.
+// TransferTo transfers an amount of tokens to another address. The transfer is simulated first, which costs an eth_call,
+// so that a revert or a false return value, which the transaction would not revert on, is returned without sending it.
 func (d *ERC20Interactions) TransferTo(to common.Address, amount *big.Int) (*types.Transaction, error) {
-	tx, err := d.ierc20Session.Transfer(to, amount)
+	if err := d.checkSuccess("erc20.Transfer()", "transfer", to, amount); err != nil {
+		return nil, err
+	}
+	tx, err := base.Observe(d.BaseInteractions, base.SendOperation, "erc20.Transfer()", func() (*types.Transaction, error) {
+		return d.ierc20Session.Transfer(to, amount)
+	})
 	if err != nil {
 		return nil, d.callError("erc20.Transfer()", err)
 	}
@@ -112,7 +142,9 @@ func (d *ERC20Interactions) TransferTo(to common.Address, amount *big.Int) (*typ
 
 // TotalSupply returns the total number of NFTs minted.
 func (d *ERC20Interactions) TotalSupply() (*big.Int, error) {
-	supply, err := d.ierc20Session.TotalSupply()
+	supply, err := base.Observe(d.BaseInteractions, base.ReadOperation, "erc20.TotalSupply()", func() (*big.Int, error) {
+		return d.ierc20Session.TotalSupply()
+	})
 	if err != nil {
 		return nil, d.callError("erc20.TotalSupply()", err)
 	}
@@ -121,20 +153,19 @@ func (d *ERC20Interactions) TotalSupply() (*big.Int, error) {
 
 // BalanceOf retrieves the NFT balance for a given owner.
 func (d *ERC20Interactions) BalanceOf(owner common.Address) (*big.Int, error) {
-	balance, err := d.ierc20Session.BalanceOf(owner)
+	balance, err := base.Observe(d.BaseInteractions, base.ReadOperation, "erc20.BalanceOf()", func() (*big.Int, error) {
+		return d.ierc20Session.BalanceOf(owner)
+	})
 	if err != nil {
 		return nil, d.callError("erc20.BalanceOf()", err)
 	}
 	return balance, nil
 }
 
-// Approve approves an address to transfer a specific token.
-func (d *ERC20Interactions) Approve(to common.Address, tokenID *big.Int) (*types.Transaction, error) {
-	tx, err := d.ierc20Session.Approve(to, tokenID)
-	if err != nil {
-		return nil, d.callError("erc20.Approve()", err)
-	}
-	return tx, nil
+// Approve sets the amount of tokens of the account the spender may transfer, replacing the current allowance.
+// See SafeApprove for tokens refusing to change an allowance that is not zero.
+func (d *ERC20Interactions) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return d.approve(spender, amount, nil)
 }
 
 // TokenMetaInfos retrieves metadata about the specified token such as name, symbol, and URI.
@@ -150,26 +181,46 @@ func (d *ERC20Interactions) TokenMetaInfos() (*models.TokenMeta, error) {
 	return &models.TokenMeta{Name: name, Symbol: symbol}, nil
 }
 
-// Name returns the name of the NFT.
+// Name returns the name of the token, from the metadata cache when one is set. bytes32 names are accepted.
 func (d *ERC20Interactions) Name() (string, error) {
-	name, err := d.ierc20Session.Name()
-	if err != nil {
-		return "", d.callError("erc20.Name()", err)
-	}
-	return name, nil
+	return d.metaCache.Fetch("name", nil, func() (string, error) {
+		return d.readText("erc20.Name()", "name")
+	})
 }
 
-// Symbol returns the symbol of the NFT.
+// Symbol returns the symbol of the token, from the metadata cache when one is set. bytes32 symbols are accepted.
 func (d *ERC20Interactions) Symbol() (string, error) {
-	symbol, err := d.ierc20Session.Symbol()
+	return d.metaCache.Fetch("symbol", nil, func() (string, error) {
+		return d.readText("erc20.Symbol()", "symbol")
+	})
+}
+
+// Decimals returns the number of decimals of the token, from the metadata cache when one is set.
+func (d *ERC20Interactions) Decimals() (uint8, error) {
+	value, err := d.metaCache.Fetch("decimals", nil, func() (string, error) {
+		decimals, err := base.Observe(d.BaseInteractions, base.ReadOperation, "erc20.Decimals()", func() (uint8, error) {
+			return d.ierc20Session.Decimals()
+		})
+		if err != nil {
+			return "", d.callError("erc20.Decimals()", err)
+		}
+		return strconv.FormatUint(uint64(decimals), 10), nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	decimals, err := strconv.ParseUint(value, 10, 8)
 	if err != nil {
-		return "", d.callError("erc20.Symbol()", err)
+		return 0, customerrors.WrapinterfacingError("Decimals", err)
 	}
-	return symbol, nil
+	return uint8(decimals), nil
 }
 
+// Allowance returns the amount of tokens of owner the spender may transfer.
 func (d *ERC20Interactions) Allowance(owner, spender common.Address) (*big.Int, error) {
-	allowance, err := d.ierc20Session.Allowance(owner, spender)
+	allowance, err := base.Observe(d.BaseInteractions, base.ReadOperation, "erc20.Allowance()", func() (*big.Int, error) {
+		return d.ierc20Session.Allowance(owner, spender)
+	})
 	if err != nil {
 		return nil, d.callError("erc20.Allowance()", err)
 	}
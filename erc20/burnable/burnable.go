@@ -52,7 +52,9 @@ func NewIERC20Burnable(baseIERC20 *erc20.ERC20Interactions, signatures []ERC20Bu
 
 // Burn destroys the specified token from the owner's balance.
 func (e *IERC20BurnableInteractions) Burn(qty *big.Int) (*types.Transaction, error) {
-	tx, err := e.erc20Burnable.Burn(qty)
+	tx, err := base.Observe(e.BaseInteractions, base.SendOperation, "erc20.Burn()", func() (*types.Transaction, error) {
+		return e.erc20Burnable.Burn(qty)
+	})
 	if err != nil {
 		return nil, e.callError("erc20.Burn()", err)
 	}
@@ -61,7 +63,9 @@ func (e *IERC20BurnableInteractions) Burn(qty *big.Int) (*types.Transaction, err
 
 // BurnFrom is a wrapper for Burn that calls the token's burnFrom function instead.
 func (e *IERC20BurnableInteractions) BurnFrom(from common.Address, qty *big.Int) (*types.Transaction, error) {
-	tx, err := e.erc20Burnable.BurnFrom(from, qty)
+	tx, err := base.Observe(e.BaseInteractions, base.SendOperation, "nft.BurnFrom()", func() (*types.Transaction, error) {
+		return e.erc20Burnable.BurnFrom(from, qty)
+	})
 	if err != nil {
 		return nil, e.callError("nft.BurnFrom()", err)
 	}
@@ -0,0 +1,94 @@
+package capped
+
+// Package capped provides functions to interact with ERC20 tokens whose supply is capped.
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/customerrors"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Extended"
+	"github.com/OCharless/eth-interfaces/utils"
+)
+
+// ErrCapExceeded is returned by CheckMint when minting would raise the supply above the cap.
+var ErrCapExceeded = errors.New("cap exceeded")
+
+// IERC20CappedInteractions wraps interactions with a capped ERC20 contract, extending basic ERC20 interactions.
+type IERC20CappedInteractions struct {
+	*erc20.ERC20Interactions
+	erc20Capped *ERC20Extended.ERC20ExtendedSession
+	callError   func(string, error) *base.CallError
+}
+
+// NewIERC20Capped creates a new capped interaction instance using the provided base ERC20 interactions.
+func NewIERC20Capped(baseIERC20 *erc20.ERC20Interactions, signatures []ERC20CappedSignatures) (*IERC20CappedInteractions, error) {
+	erc20Capped, err := ERC20Extended.NewERC20Extended(baseIERC20.GetAddress(), baseIERC20.Client)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("ierc20Capped", err)
+	}
+	session := ERC20Extended.ERC20ExtendedSession{
+		Contract:     erc20Capped,
+		CallOpts:     baseIERC20.GetSession().CallOpts,
+		TransactOpts: baseIERC20.GetSession().TransactOpts,
+	}
+
+	var converted []utils.Signature
+	for _, sig := range signatures {
+		converted = append(converted, sig)
+	}
+
+	err = baseIERC20.CheckSignatures(baseIERC20.GetAddress(), converted)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("ierc20Capped", err)
+	}
+
+	callError := func(field string, err error) *base.CallError {
+		return baseIERC20.WrapCallError(ERC20Extended.ERC20ExtendedABI, field, err)
+	}
+
+	return &IERC20CappedInteractions{baseIERC20, &session, callError}, nil
+}
+
+// Cap returns the maximum supply of the token.
+func (e *IERC20CappedInteractions) Cap() (*big.Int, error) {
+	maxSupply, err := base.Observe(e.BaseInteractions, base.ReadOperation, "erc20.Cap()", func() (*big.Int, error) {
+		return e.erc20Capped.Cap()
+	})
+	if err != nil {
+		return nil, e.callError("erc20.Cap()", err)
+	}
+	return maxSupply, nil
+}
+
+// Headroom returns the amount that can still be minted before the cap is reached.
+func (e *IERC20CappedInteractions) Headroom() (*big.Int, error) {
+	maxSupply, err := e.Cap()
+	if err != nil {
+		return nil, err
+	}
+	supply, err := e.TotalSupply()
+	if err != nil {
+		return nil, err
+	}
+	headroom := new(big.Int).Sub(maxSupply, supply)
+	if headroom.Sign() < 0 {
+		headroom.SetInt64(0)
+	}
+	return headroom, nil
+}
+
+// CheckMint returns ErrCapExceeded when minting qty would raise the supply above the cap.
+func (e *IERC20CappedInteractions) CheckMint(qty *big.Int) error {
+	headroom, err := e.Headroom()
+	if err != nil {
+		return err
+	}
+	if qty.Cmp(headroom) > 0 {
+		return fmt.Errorf("%w: minting %s leaves room for %s only", ErrCapExceeded, qty, headroom)
+	}
+	return nil
+}
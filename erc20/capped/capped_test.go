@@ -0,0 +1,72 @@
+package capped_test
+
+// Package capped_test contains tests for capped interactions.
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/erc20/capped"
+	"github.com/OCharless/eth-interfaces/erc20/mintable"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Extended"
+	"github.com/OCharless/eth-interfaces/testkit"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Instantiation verifies that the capped interactions are only created for contracts implementing cap.
+func Test_Instantiation(t *testing.T) {
+	kit := testkit.New(t).WithERC20().Build()
+	owner := kit.Accounts[0]
+
+	_, err := capped.NewIERC20Capped(owner.ERC20, []capped.ERC20CappedSignatures{capped.Cap})
+	assert.ErrorContains(t, err, "not supported functions")
+}
+
+// Test_Cap verifies the cap, the remaining headroom and the mints checked against it.
+func Test_Cap(t *testing.T) {
+	kit := testkit.New(t).Build()
+	owner := kit.Accounts[0]
+	token, _, _, err := ERC20Extended.DeployERC20Extended(owner.Auth, kit.Client(), big.NewInt(10000))
+	assert.Nil(t, err)
+	kit.Commit()
+
+	erc20Interactions, err := erc20.NewIERC20Interactions(owner.Base, token, []erc20.BaseERC20Signature{}, owner.Auth)
+	assert.Nil(t, err)
+	cappedToken, err := capped.NewIERC20Capped(erc20Interactions, []capped.ERC20CappedSignatures{capped.Cap})
+	assert.Nil(t, err)
+	mintableToken, err := mintable.NewIERC20Mintable(erc20Interactions, []mintable.ERC20MintableSignatures{mintable.Mint})
+	assert.Nil(t, err)
+
+	_, err = mintableToken.Mint(owner.Address, big.NewInt(4000))
+	assert.Nil(t, err)
+	kit.Commit()
+
+	t.Run("OK - Cap and headroom", func(t *testing.T) {
+		maxSupply, err := cappedToken.Cap()
+		assert.Nil(t, err)
+		assert.Equal(t, "10000", maxSupply.String())
+		headroom, err := cappedToken.Headroom()
+		assert.Nil(t, err)
+		assert.Equal(t, "6000", headroom.String())
+	})
+
+	testCases := []struct {
+		Name          string
+		Qty           int64
+		ExpectedError error
+	}{
+		{Name: "OK - Mint up to the cap", Qty: 6000},
+		{Name: "KO - Mint above the cap", Qty: 6001, ExpectedError: capped.ErrCapExceeded},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.ErrorIs(t, cappedToken.CheckMint(big.NewInt(tc.Qty)), tc.ExpectedError)
+		})
+	}
+
+	t.Run("KO - Mint reverted above the cap", func(t *testing.T) {
+		_, err := mintableToken.Mint(owner.Address, big.NewInt(6001))
+		assert.ErrorContains(t, err, "ERC20ExceededCap")
+	})
+}
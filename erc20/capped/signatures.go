@@ -0,0 +1,20 @@
+package capped
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type ERC20CappedSignatures string
+
+const (
+	Cap ERC20CappedSignatures = "cap()"
+)
+
+func (s ERC20CappedSignatures) GetHex() string {
+	hash := crypto.NewKeccakState()
+	hash.Write([]byte(s))
+	selector := hash.Sum(nil)[:4]
+	return hex.EncodeToString(selector)
+}
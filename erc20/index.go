@@ -0,0 +1,26 @@
+package erc20
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// HolderIndex answers holder queries from an off-chain index, such as the indexer package.
+type HolderIndex interface {
+	HoldersOf(contract common.Address) (map[common.Address]*big.Int, error)
+}
+
+// SetHolderIndex sets the index used by Holders, nil disables it.
+func (d *ERC20Interactions) SetHolderIndex(index HolderIndex) {
+	d.holderIndex = index
+}
+
+// Holders returns the addresses holding the token along with their balances according to the holder index.
+func (d *ERC20Interactions) Holders() (map[common.Address]*big.Int, error) {
+	if d.holderIndex == nil {
+		return nil, errors.New("no holder index set")
+	}
+	return d.holderIndex.HoldersOf(d.nftAddress)
+}
@@ -0,0 +1,92 @@
+package mintable
+
+// Package mintable provides functions to interact with ERC20 tokens minted by authorized accounts.
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/contractextension/access"
+	"github.com/OCharless/eth-interfaces/customerrors"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Extended"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// MinterRole is the AccessControl role allowed to mint.
+var MinterRole = access.RoleID("MINTER_ROLE")
+
+// IERC20MintableInteractions wraps interactions with a mintable ERC20 contract, extending basic ERC20 interactions.
+type IERC20MintableInteractions struct {
+	*erc20.ERC20Interactions
+	erc20Mintable *ERC20Extended.ERC20ExtendedSession
+	callError     func(string, error) *base.CallError
+	access        *access.AccessInteractions
+}
+
+// NewIERC20Mintable creates a new mintable interaction instance using the provided base ERC20 interactions.
+func NewIERC20Mintable(baseIERC20 *erc20.ERC20Interactions, signatures []ERC20MintableSignatures) (*IERC20MintableInteractions, error) {
+	erc20Mintable, err := ERC20Extended.NewERC20Extended(baseIERC20.GetAddress(), baseIERC20.Client)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("ierc20Mintable", err)
+	}
+	session := ERC20Extended.ERC20ExtendedSession{
+		Contract:     erc20Mintable,
+		CallOpts:     baseIERC20.GetSession().CallOpts,
+		TransactOpts: baseIERC20.GetSession().TransactOpts,
+	}
+
+	var converted []utils.Signature
+	for _, sig := range signatures {
+		converted = append(converted, sig)
+	}
+
+	err = baseIERC20.CheckSignatures(baseIERC20.GetAddress(), converted)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("ierc20Mintable", err)
+	}
+
+	callError := func(field string, err error) *base.CallError {
+		return baseIERC20.WrapCallError(ERC20Extended.ERC20ExtendedABI, field, err)
+	}
+
+	accessInteractions, err := access.NewAccessInteractions(baseIERC20.BaseInteractions, baseIERC20.GetAddress(), []access.AccessSignature{}, &session.TransactOpts)
+	if errors.Is(err, access.ErrNoAccessControl) {
+		accessInteractions = nil
+	} else if err != nil {
+		return nil, customerrors.WrapinterfacingError("ierc20Mintable", err)
+	}
+
+	return &IERC20MintableInteractions{baseIERC20, &session, callError, accessInteractions}, nil
+}
+
+// CanMint reports whether the account holds MinterRole, or owns the token when it is only Ownable.
+func (e *IERC20MintableInteractions) CanMint(account common.Address) (bool, error) {
+	if e.access == nil {
+		return true, nil
+	}
+	err := e.access.CheckAuthorized(MinterRole, account)
+	if errors.Is(err, access.ErrUnauthorized) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Mint creates qty tokens for to, after checking that the account is allowed to mint. See access.CheckAuthorized.
+func (e *IERC20MintableInteractions) Mint(to common.Address, qty *big.Int) (*types.Transaction, error) {
+	if e.access != nil {
+		if err := e.access.CheckAuthorized(MinterRole, e.Address); err != nil {
+			return nil, err
+		}
+	}
+	tx, err := base.Observe(e.BaseInteractions, base.SendOperation, "erc20.Mint()", func() (*types.Transaction, error) {
+		return e.erc20Mintable.Mint(to, qty)
+	})
+	if err != nil {
+		return nil, e.callError("erc20.Mint()", err)
+	}
+	return tx, nil
+}
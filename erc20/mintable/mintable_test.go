@@ -0,0 +1,94 @@
+package mintable_test
+
+// Package mintable_test contains tests for mintable interactions.
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/contractextension/access"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/erc20/mintable"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Extended"
+	"github.com/OCharless/eth-interfaces/testkit"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Instantiation verifies that the mintable interactions are only created for contracts implementing mint.
+func Test_Instantiation(t *testing.T) {
+	kit := testkit.New(t).WithERC20().Build()
+	owner := kit.Accounts[0]
+	token, _, _, err := ERC20Extended.DeployERC20Extended(owner.Auth, kit.Client(), big.NewInt(10000))
+	assert.Nil(t, err)
+	kit.Commit()
+
+	testCases := []struct {
+		Name          string
+		ContractAddr  common.Address
+		ExpectedError string
+	}{
+		{
+			Name:         "OK - Successfully instantiated",
+			ContractAddr: token,
+		},
+		{
+			Name:          "KO - Token without mint",
+			ContractAddr:  kit.ERC20,
+			ExpectedError: "not supported functions",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			erc20Interactions, err := erc20.NewIERC20Interactions(owner.Base, tc.ContractAddr, []erc20.BaseERC20Signature{}, owner.Auth)
+			assert.Nil(t, err)
+			_, err = mintable.NewIERC20Mintable(erc20Interactions, []mintable.ERC20MintableSignatures{mintable.Mint})
+			if tc.ExpectedError != "" {
+				assert.ErrorContains(t, err, tc.ExpectedError)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
+
+// Test_Mint verifies that minting is checked against MINTER_ROLE before any transaction is sent.
+func Test_Mint(t *testing.T) {
+	kit := testkit.New(t).WithAccounts(2).Build()
+	owner, other := kit.Accounts[0], kit.Accounts[1]
+	token, _, _, err := ERC20Extended.DeployERC20Extended(owner.Auth, kit.Client(), big.NewInt(10000))
+	assert.Nil(t, err)
+	kit.Commit()
+
+	minter := func(account *testkit.Account) *mintable.IERC20MintableInteractions {
+		erc20Interactions, err := erc20.NewIERC20Interactions(account.Base, token, []erc20.BaseERC20Signature{}, account.Auth)
+		assert.Nil(t, err)
+		interactions, err := mintable.NewIERC20Mintable(erc20Interactions, []mintable.ERC20MintableSignatures{mintable.Mint})
+		assert.Nil(t, err)
+		return interactions
+	}
+	ownerMinter, otherMinter := minter(owner), minter(other)
+
+	t.Run("OK - Can mint", func(t *testing.T) {
+		canMint, err := ownerMinter.CanMint(owner.Address)
+		assert.Nil(t, err)
+		assert.True(t, canMint)
+		canMint, err = ownerMinter.CanMint(other.Address)
+		assert.Nil(t, err)
+		assert.False(t, canMint)
+	})
+
+	t.Run("OK - Mint", func(t *testing.T) {
+		_, err := ownerMinter.Mint(other.Address, big.NewInt(1000))
+		assert.Nil(t, err)
+		kit.Commit()
+		balance, err := ownerMinter.BalanceOf(other.Address)
+		assert.Nil(t, err)
+		assert.Equal(t, "1000", balance.String())
+	})
+
+	t.Run("KO - Mint without role", func(t *testing.T) {
+		_, err := otherMinter.Mint(other.Address, big.NewInt(1000))
+		assert.ErrorIs(t, err, access.ErrUnauthorized)
+	})
+}
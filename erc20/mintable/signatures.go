@@ -0,0 +1,20 @@
+package mintable
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type ERC20MintableSignatures string
+
+const (
+	Mint ERC20MintableSignatures = "mint(address,uint256)"
+)
+
+func (s ERC20MintableSignatures) GetHex() string {
+	hash := crypto.NewKeccakState()
+	hash.Write([]byte(s))
+	selector := hash.Sum(nil)[:4]
+	return hex.EncodeToString(selector)
+}
@@ -0,0 +1,96 @@
+package pausable
+
+// Package pausable provides functions to interact with ERC20 tokens whose transfers can be paused.
+
+import (
+	"errors"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/contractextension/access"
+	"github.com/OCharless/eth-interfaces/customerrors"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Extended"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// PauserRole is the AccessControl role allowed to pause and unpause.
+var PauserRole = access.RoleID("PAUSER_ROLE")
+
+// IERC20PausableInteractions wraps interactions with a pausable ERC20 contract, extending basic ERC20 interactions.
+type IERC20PausableInteractions struct {
+	*erc20.ERC20Interactions
+	erc20Pausable *ERC20Extended.ERC20ExtendedSession
+	callError     func(string, error) *base.CallError
+	access        *access.AccessInteractions
+}
+
+// NewIERC20Pausable creates a new pausable interaction instance using the provided base ERC20 interactions.
+func NewIERC20Pausable(baseIERC20 *erc20.ERC20Interactions, signatures []ERC20PausableSignatures) (*IERC20PausableInteractions, error) {
+	erc20Pausable, err := ERC20Extended.NewERC20Extended(baseIERC20.GetAddress(), baseIERC20.Client)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("ierc20Pausable", err)
+	}
+	session := ERC20Extended.ERC20ExtendedSession{
+		Contract:     erc20Pausable,
+		CallOpts:     baseIERC20.GetSession().CallOpts,
+		TransactOpts: baseIERC20.GetSession().TransactOpts,
+	}
+
+	var converted []utils.Signature
+	for _, sig := range signatures {
+		converted = append(converted, sig)
+	}
+
+	err = baseIERC20.CheckSignatures(baseIERC20.GetAddress(), converted)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("ierc20Pausable", err)
+	}
+
+	callError := func(field string, err error) *base.CallError {
+		return baseIERC20.WrapCallError(ERC20Extended.ERC20ExtendedABI, field, err)
+	}
+
+	accessInteractions, err := access.NewAccessInteractions(baseIERC20.BaseInteractions, baseIERC20.GetAddress(), []access.AccessSignature{}, &session.TransactOpts)
+	if errors.Is(err, access.ErrNoAccessControl) {
+		accessInteractions = nil
+	} else if err != nil {
+		return nil, customerrors.WrapinterfacingError("ierc20Pausable", err)
+	}
+
+	return &IERC20PausableInteractions{baseIERC20, &session, callError, accessInteractions}, nil
+}
+
+// Paused reports whether transfers are paused.
+func (e *IERC20PausableInteractions) Paused() (bool, error) {
+	paused, err := base.Observe(e.BaseInteractions, base.ReadOperation, "erc20.Paused()", func() (bool, error) {
+		return e.erc20Pausable.Paused()
+	})
+	if err != nil {
+		return false, e.callError("erc20.Paused()", err)
+	}
+	return paused, nil
+}
+
+// Pause pauses transfers, after checking that the account is allowed to. See access.CheckAuthorized.
+func (e *IERC20PausableInteractions) Pause() (*types.Transaction, error) {
+	return e.send("erc20.Pause()", e.erc20Pausable.Pause)
+}
+
+// Unpause resumes transfers, after checking that the account is allowed to. See access.CheckAuthorized.
+func (e *IERC20PausableInteractions) Unpause() (*types.Transaction, error) {
+	return e.send("erc20.Unpause()", e.erc20Pausable.Unpause)
+}
+
+func (e *IERC20PausableInteractions) send(method string, call func() (*types.Transaction, error)) (*types.Transaction, error) {
+	if e.access != nil {
+		if err := e.access.CheckAuthorized(PauserRole, e.Address); err != nil {
+			return nil, err
+		}
+	}
+	tx, err := base.Observe(e.BaseInteractions, base.SendOperation, method, call)
+	if err != nil {
+		return nil, e.callError(method, err)
+	}
+	return tx, nil
+}
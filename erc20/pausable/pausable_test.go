@@ -0,0 +1,85 @@
+package pausable_test
+
+// Package pausable_test contains tests for pausable interactions.
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/contractextension/access"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/erc20/pausable"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Extended"
+	"github.com/OCharless/eth-interfaces/testkit"
+	"github.com/stretchr/testify/assert"
+)
+
+var allSignatures = []pausable.ERC20PausableSignatures{pausable.Pause, pausable.Unpause, pausable.Paused}
+
+// Test_Instantiation verifies that the pausable interactions are only created for contracts implementing pause.
+func Test_Instantiation(t *testing.T) {
+	kit := testkit.New(t).WithERC20().Build()
+	owner := kit.Accounts[0]
+
+	_, err := pausable.NewIERC20Pausable(owner.ERC20, allSignatures)
+	assert.ErrorContains(t, err, "not supported functions")
+}
+
+// Test_Pause verifies that pausing stops transfers and is checked against PAUSER_ROLE before any transaction is sent.
+func Test_Pause(t *testing.T) {
+	kit := testkit.New(t).WithAccounts(2).Build()
+	owner, other := kit.Accounts[0], kit.Accounts[1]
+	token, _, contract, err := ERC20Extended.DeployERC20Extended(owner.Auth, kit.Client(), big.NewInt(10000))
+	assert.Nil(t, err)
+	kit.Commit()
+	_, err = contract.Mint(owner.Auth, owner.Address, big.NewInt(1000))
+	assert.Nil(t, err)
+	kit.Commit()
+
+	pauser := func(account *testkit.Account) *pausable.IERC20PausableInteractions {
+		erc20Interactions, err := erc20.NewIERC20Interactions(account.Base, token, []erc20.BaseERC20Signature{}, account.Auth)
+		assert.Nil(t, err)
+		interactions, err := pausable.NewIERC20Pausable(erc20Interactions, allSignatures)
+		assert.Nil(t, err)
+		return interactions
+	}
+	ownerPauser, otherPauser := pauser(owner), pauser(other)
+	paused := func() bool {
+		t.Helper()
+		paused, err := ownerPauser.Paused()
+		assert.Nil(t, err)
+		return paused
+	}
+
+	t.Run("OK - Pause", func(t *testing.T) {
+		assert.False(t, paused())
+		_, err := ownerPauser.Pause()
+		assert.Nil(t, err)
+		kit.Commit()
+		assert.True(t, paused())
+	})
+
+	t.Run("KO - Transfer while paused", func(t *testing.T) {
+		_, err := ownerPauser.TransferTo(other.Address, big.NewInt(1))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("KO - Pause twice", func(t *testing.T) {
+		_, err := ownerPauser.Pause()
+		assert.ErrorContains(t, err, "EnforcedPause")
+	})
+
+	t.Run("KO - Unpause without role", func(t *testing.T) {
+		_, err := otherPauser.Unpause()
+		assert.ErrorIs(t, err, access.ErrUnauthorized)
+	})
+
+	t.Run("OK - Unpause", func(t *testing.T) {
+		_, err := ownerPauser.Unpause()
+		assert.Nil(t, err)
+		kit.Commit()
+		assert.False(t, paused())
+		_, err = ownerPauser.TransferTo(other.Address, big.NewInt(1))
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,22 @@
+package pausable
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type ERC20PausableSignatures string
+
+const (
+	Pause   ERC20PausableSignatures = "pause()"
+	Unpause ERC20PausableSignatures = "unpause()"
+	Paused  ERC20PausableSignatures = "paused()"
+)
+
+func (s ERC20PausableSignatures) GetHex() string {
+	hash := crypto.NewKeccakState()
+	hash.Write([]byte(s))
+	selector := hash.Sum(nil)[:4]
+	return hex.EncodeToString(selector)
+}
@@ -0,0 +1,119 @@
+package erc20
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrFalseReturned is returned when a simulated transfer or approval returns false instead of reverting.
+var ErrFalseReturned = errors.New("token returned false")
+
+// TransferBehavior compares the balance changes of a transfer with its amount, between the blocks before and after it.
+type TransferBehavior struct {
+	Tx     *types.Transaction
+	Amount *big.Int
+	// Sent and Received are the balance changes of the sender and of the recipient, Fee is their difference.
+	Sent     *big.Int
+	Received *big.Int
+	Fee      *big.Int
+	// FeeOnTransfer is set when the sender paid the amount and the recipient received less.
+	FeeOnTransfer bool
+	// Rebasing is set when the balances moved by something else than the amount, other transfers in the block aside.
+	Rebasing bool
+}
+
+// DetectTransferBehavior transfers amount to an address other than the account through TransferConfirmed
+// and compares the balance changes with the amount to detect fee-on-transfer and rebasing tokens.
+func (d *ERC20Interactions) DetectTransferBehavior(to common.Address, amount *big.Int) (*TransferBehavior, error) {
+	result, err := d.TransferConfirmed(to, amount)
+	if err != nil {
+		return nil, err
+	}
+	behavior := &TransferBehavior{
+		Tx:       result.Tx,
+		Amount:   amount,
+		Sent:     result.Sent,
+		Received: result.Received,
+		Fee:      result.Fee,
+	}
+	behavior.FeeOnTransfer = behavior.Sent.Cmp(amount) == 0 && behavior.Received.Cmp(amount) < 0
+	behavior.Rebasing = behavior.Sent.Cmp(amount) != 0 || behavior.Received.Cmp(amount) > 0
+	return behavior, nil
+}
+
+// balanceAt returns the balance of owner at a given block.
+func (d *ERC20Interactions) balanceAt(owner common.Address, block *big.Int) (*big.Int, error) {
+	balance, err := base.Observe(d.BaseInteractions, base.ReadOperation, "erc20.BalanceOf()", func() (*big.Int, error) {
+		return d.ierc20Session.Contract.BalanceOf(&bind.CallOpts{BlockNumber: block, Context: d.Ctx}, owner)
+	})
+	if err != nil {
+		return nil, d.callError("erc20.BalanceOf()", err)
+	}
+	return balance, nil
+}
+
+// readText calls the name or symbol getter, accepting the bytes32 values returned by tokens such as MKR.
+func (d *ERC20Interactions) readText(name, method string) (string, error) {
+	output, err := d.call(base.ReadOperation, name, method)
+	if err != nil {
+		return "", err
+	}
+	if len(output) == 32 {
+		return string(bytes.TrimRight(output, "\x00")), nil
+	}
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		return "", err
+	}
+	values, err := abi.Arguments{{Type: stringType}}.Unpack(output)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s returned by %s: %w", method, d.nftAddress.Hex(), err)
+	}
+	return values[0].(string), nil
+}
+
+// checkSuccess simulates a transfer or an approval, accepting the empty return data of tokens such as USDT and
+// rejecting a false return value, which the transaction would not revert on. Reverts are returned, decoded, so that
+// the transaction is not sent.
+func (d *ERC20Interactions) checkSuccess(name, method string, args ...any) error {
+	output, err := d.call(base.SimulationOperation, name, method, args...)
+	if err != nil {
+		return err
+	}
+	if len(output) == 0 {
+		return nil
+	}
+	if len(output) < 32 || new(big.Int).SetBytes(output[:32]).Sign() == 0 {
+		return fmt.Errorf("%w on %s", ErrFalseReturned, method)
+	}
+	return nil
+}
+
+// call calls a method of the token from the account on the pending state and returns its raw output, name labelling the call.
+func (d *ERC20Interactions) call(kind base.OperationKind, name, method string, args ...any) ([]byte, error) {
+	parsed, err := ERC20Burnable.ERC20BurnableMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsed.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	output, err := base.Observe(d.BaseInteractions, kind, name, func() ([]byte, error) {
+		return d.Client.PendingCallContract(d.Ctx, ethereum.CallMsg{From: d.Address, To: &d.nftAddress, Data: data})
+	})
+	if err != nil {
+		return nil, d.callError(name, err)
+	}
+	return output, nil
+}
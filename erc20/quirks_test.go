@@ -0,0 +1,173 @@
+package erc20_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/QuirkyERC20"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Quirks verifies the interactions against tokens deviating from the standard, as enabled by the QuirkyERC20 flags.
+func Test_Quirks(t *testing.T) {
+	recipient := common.HexToAddress("0x1")
+	amount := big.NewInt(1000)
+
+	testCases := []struct {
+		Name  string
+		Flags int64
+		Check func(t *testing.T, token *erc20.ERC20Interactions)
+	}{
+		{
+			Name:  "OK - Standard token",
+			Flags: 0,
+			Check: func(t *testing.T, token *erc20.ERC20Interactions) {
+				behavior, err := token.DetectTransferBehavior(recipient, amount)
+				assert.Nil(t, err)
+				assert.False(t, behavior.FeeOnTransfer)
+				assert.False(t, behavior.Rebasing)
+				assert.Equal(t, "1000", behavior.Received.String())
+				assert.Equal(t, "0", behavior.Fee.String())
+			},
+		},
+		{
+			Name:  "OK - No return value",
+			Flags: 1,
+			Check: func(t *testing.T, token *erc20.ERC20Interactions) {
+				tx, err := token.TransferTo(recipient, amount)
+				assert.Nil(t, err)
+				waitMined(t, token, tx)
+				balance, err := token.BalanceOf(recipient)
+				assert.Nil(t, err)
+				assert.Equal(t, "1000", balance.String())
+			},
+		},
+		{
+			Name:  "OK - Bytes32 name and symbol",
+			Flags: 2,
+			Check: func(t *testing.T, token *erc20.ERC20Interactions) {
+				name, err := token.Name()
+				assert.Nil(t, err)
+				assert.Equal(t, "Quirky", name)
+				symbol, err := token.Symbol()
+				assert.Nil(t, err)
+				assert.Equal(t, "QUIRK", symbol)
+			},
+		},
+		{
+			Name:  "OK - Fee on transfer",
+			Flags: 4,
+			Check: func(t *testing.T, token *erc20.ERC20Interactions) {
+				behavior, err := token.DetectTransferBehavior(recipient, amount)
+				assert.Nil(t, err)
+				assert.True(t, behavior.FeeOnTransfer)
+				assert.False(t, behavior.Rebasing)
+				assert.Equal(t, "1000", behavior.Sent.String())
+				assert.Equal(t, "990", behavior.Received.String())
+				assert.Equal(t, "10", behavior.Fee.String())
+			},
+		},
+		{
+			Name:  "OK - Rebasing",
+			Flags: 8,
+			Check: func(t *testing.T, token *erc20.ERC20Interactions) {
+				behavior, err := token.DetectTransferBehavior(recipient, amount)
+				assert.Nil(t, err)
+				assert.True(t, behavior.Rebasing)
+				assert.False(t, behavior.FeeOnTransfer)
+			},
+		},
+		{
+			Name:  "KO - False returned",
+			Flags: 16,
+			Check: func(t *testing.T, token *erc20.ERC20Interactions) {
+				supply, err := token.TotalSupply()
+				assert.Nil(t, err)
+				_, err = token.TransferTo(recipient, new(big.Int).Add(supply, common.Big1))
+				assert.ErrorIs(t, err, erc20.ErrFalseReturned)
+			},
+		},
+		{
+			Name:  "KO - Revert returned before sending",
+			Flags: 0,
+			Check: func(t *testing.T, token *erc20.ERC20Interactions) {
+				supply, err := token.TotalSupply()
+				assert.Nil(t, err)
+				nonce, err := token.Client.PendingNonceAt(context.Background(), token.Address)
+				assert.Nil(t, err)
+				_, err = token.TransferTo(recipient, new(big.Int).Add(supply, common.Big1))
+				assert.Error(t, err)
+				assert.NotErrorIs(t, err, erc20.ErrFalseReturned)
+				after, err := token.Client.PendingNonceAt(context.Background(), token.Address)
+				assert.Nil(t, err)
+				assert.Equal(t, nonce, after)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			backend, auth, contractAddress, privKey, err := utils.SetupBlockchain(t,
+				QuirkyERC20.QuirkyERC20ABI,
+				QuirkyERC20.QuirkyERC20Bin,
+				big.NewInt(tc.Flags),
+			)
+			assert.Nil(t, err)
+			defer backend.Close()
+
+			done := make(chan struct{})
+			defer close(done)
+			go func() {
+				for {
+					select {
+					case <-done:
+						return
+					case <-time.After(10 * time.Millisecond):
+						backend.Commit()
+					}
+				}
+			}()
+
+			baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+			token, err := erc20.NewIERC20Interactions(baseInteractions, *contractAddress, []erc20.BaseERC20Signature{}, auth)
+			assert.Nil(t, err)
+			tc.Check(t, token)
+		})
+	}
+
+	t.Run("OK - USDT approval", func(t *testing.T) {
+		backend, auth, contractAddress, privKey, err := utils.SetupBlockchain(t,
+			QuirkyERC20.QuirkyERC20ABI,
+			QuirkyERC20.QuirkyERC20Bin,
+			big.NewInt(1),
+		)
+		assert.Nil(t, err)
+		defer backend.Close()
+		backend.Commit()
+
+		baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+		token, err := erc20.NewIERC20Interactions(baseInteractions, *contractAddress, []erc20.BaseERC20Signature{}, auth)
+		assert.Nil(t, err)
+		_, err = token.Approve(recipient, amount)
+		assert.Nil(t, err)
+		backend.Commit()
+
+		allowance, err := token.Allowance(baseInteractions.Address, recipient)
+		assert.Nil(t, err)
+		assert.Equal(t, "1000", allowance.String())
+	})
+}
+
+func waitMined(t *testing.T, token *erc20.ERC20Interactions, tx *types.Transaction) {
+	t.Helper()
+	receipt, err := bind.WaitMined(context.Background(), token.Client, tx)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+}
@@ -18,6 +18,10 @@ const (
 	Approve          BaseERC20Signature = "approve(address,uint256)"
 	TransferFrom     BaseERC20Signature = "transferFrom(address,address,uint256)"
 	SafeTransferFrom BaseERC20Signature = "safeTransferFrom(address,address,uint256)"
+
+	Allowance         BaseERC20Signature = "allowance(address,address)"
+	IncreaseAllowance BaseERC20Signature = "increaseAllowance(address,uint256)"
+	DecreaseAllowance BaseERC20Signature = "decreaseAllowance(address,uint256)"
 )
 
 func (s BaseERC20Signature) GetHex() string {
@@ -0,0 +1,110 @@
+package erc20
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrNotReceived is returned when a mined transfer did not raise the balance of the recipient or emitted no matching Transfer event.
+var ErrNotReceived = errors.New("transfer not received")
+
+// TransferEvent is a Transfer event emitted by the token in a transaction.
+type TransferEvent struct {
+	From     common.Address
+	To       common.Address
+	Value    *big.Int
+	LogIndex uint
+}
+
+// TransferResult is a mined transfer, along with the balance changes it caused.
+// Balances are compared between the blocks before and after the transaction, so other transfers of the block are counted too.
+type TransferResult struct {
+	Tx      *types.Transaction
+	Receipt *types.Receipt
+	To      common.Address
+	Amount  *big.Int
+	// Sent and Received are the balance changes of the sender and of the recipient, Fee is the part of Sent not received.
+	Sent     *big.Int
+	Received *big.Int
+	Fee      *big.Int
+	// GasCost is the ether paid for the gas of the transaction.
+	GasCost *big.Int
+	// Events holds the Transfer events emitted by the token in the transaction, fee transfers included.
+	Events []TransferEvent
+	// Link is the explorer link of the transaction, empty when no explorer is set.
+	Link string
+}
+
+// TransferConfirmed transfers amount to an address other than the account and waits for the transfer to be confirmed. See ConfirmTransfer.
+func (d *ERC20Interactions) TransferConfirmed(to common.Address, amount *big.Int) (*TransferResult, error) {
+	if to == d.Address {
+		return nil, errors.New("cannot confirm a transfer to the account itself")
+	}
+	tx, err := d.TransferTo(to, amount)
+	if err != nil {
+		return nil, err
+	}
+	return d.ConfirmTransfer(tx, to, amount)
+}
+
+// ConfirmTransfer waits for a transfer of amount to be mined, decodes its Transfer events and checks the balance of the recipient.
+// ErrNotReceived is returned along with the result when the recipient balance did not grow or no event credited it.
+func (d *ERC20Interactions) ConfirmTransfer(tx *types.Transaction, to common.Address, amount *big.Int) (*TransferResult, error) {
+	receipt, err := d.WaitMined(tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for tx %s: %w", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("transfer reverted in tx %s", tx.Hash().Hex())
+	}
+
+	before := new(big.Int).Sub(receipt.BlockNumber, common.Big1)
+	balances := make([]*big.Int, 0, 4)
+	for _, query := range []struct {
+		owner common.Address
+		block *big.Int
+	}{{d.Address, before}, {d.Address, receipt.BlockNumber}, {to, before}, {to, receipt.BlockNumber}} {
+		balance, err := d.balanceAt(query.owner, query.block)
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, balance)
+	}
+
+	result := &TransferResult{
+		Tx:       tx,
+		Receipt:  receipt,
+		To:       to,
+		Amount:   amount,
+		Sent:     new(big.Int).Sub(balances[0], balances[1]),
+		Received: new(big.Int).Sub(balances[3], balances[2]),
+		Fee:      new(big.Int),
+		GasCost:  new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice),
+		Link:     d.Explorer().TxURL(tx.Hash()),
+	}
+	if result.Received.Cmp(result.Sent) < 0 {
+		result.Fee.Sub(result.Sent, result.Received)
+	}
+
+	credited := false
+	for _, log := range receipt.Logs {
+		// ERC721 Transfer events share the topic of ERC20 ones but index the token ID.
+		if log.Address != d.nftAddress || len(log.Topics) != 3 {
+			continue
+		}
+		event, err := d.ierc20Session.Contract.ParseTransfer(*log)
+		if err != nil {
+			continue
+		}
+		result.Events = append(result.Events, TransferEvent{event.From, event.To, event.Value, log.Index})
+		credited = credited || event.To == to
+	}
+	if !credited || result.Received.Sign() <= 0 {
+		return result, fmt.Errorf("%w: %s received %s of %s in tx %s", ErrNotReceived, to.Hex(), result.Received, amount, tx.Hash().Hex())
+	}
+	return result, nil
+}
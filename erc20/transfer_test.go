@@ -0,0 +1,129 @@
+package erc20_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/QuirkyERC20"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_TransferConfirmed verifies the balance changes and events reported for confirmed transfers.
+func Test_TransferConfirmed(t *testing.T) {
+	recipient := common.HexToAddress("0x1")
+
+	testCases := []struct {
+		Name             string
+		Flags            int64
+		Confirm          common.Address
+		ExpectedReceived string
+		ExpectedFee      string
+		ExpectedEvents   int
+		ExpectedError    error
+	}{
+		{
+			Name:             "OK - Standard token",
+			Flags:            0,
+			Confirm:          recipient,
+			ExpectedReceived: "1000",
+			ExpectedFee:      "0",
+			ExpectedEvents:   1,
+		},
+		{
+			Name:             "OK - Fee on transfer",
+			Flags:            4,
+			Confirm:          recipient,
+			ExpectedReceived: "990",
+			ExpectedFee:      "10",
+			ExpectedEvents:   2,
+		},
+		{
+			Name:             "KO - Other recipient",
+			Flags:            0,
+			Confirm:          common.HexToAddress("0x2"),
+			ExpectedReceived: "0",
+			ExpectedFee:      "1000",
+			ExpectedEvents:   1,
+			ExpectedError:    erc20.ErrNotReceived,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			backend, auth, contractAddress, privKey, err := utils.SetupBlockchain(t,
+				QuirkyERC20.QuirkyERC20ABI,
+				QuirkyERC20.QuirkyERC20Bin,
+				big.NewInt(tc.Flags),
+			)
+			assert.Nil(t, err)
+			defer backend.Close()
+
+			done := make(chan struct{})
+			defer close(done)
+			go func() {
+				for {
+					select {
+					case <-done:
+						return
+					case <-time.After(10 * time.Millisecond):
+						backend.Commit()
+					}
+				}
+			}()
+
+			baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, base.NewExplorer("Test", "https://explorer.test"))
+			token, err := erc20.NewIERC20Interactions(baseInteractions, *contractAddress, []erc20.BaseERC20Signature{}, auth)
+			assert.Nil(t, err)
+
+			tx, err := token.TransferTo(recipient, big.NewInt(1000))
+			assert.Nil(t, err)
+			result, err := token.ConfirmTransfer(tx, tc.Confirm, big.NewInt(1000))
+			assert.ErrorIs(t, err, tc.ExpectedError)
+			assert.Equal(t, "1000", result.Sent.String())
+			assert.Equal(t, tc.ExpectedReceived, result.Received.String())
+			assert.Equal(t, tc.ExpectedFee, result.Fee.String())
+			assert.Equal(t, 1, result.GasCost.Sign())
+			assert.Len(t, result.Events, tc.ExpectedEvents)
+			assert.Equal(t, erc20.TransferEvent{From: auth.From, To: recipient, Value: big.NewInt(1000 - 10*tc.Flags/4), LogIndex: 0}, result.Events[0])
+			assert.Equal(t, "https://explorer.test/tx/"+tx.Hash().Hex(), result.Link)
+		})
+	}
+
+	t.Run("KO - Transfer to the account", func(t *testing.T) {
+		backend, auth, contractAddress, privKey, err := utils.SetupBlockchain(t,
+			QuirkyERC20.QuirkyERC20ABI,
+			QuirkyERC20.QuirkyERC20Bin,
+			big.NewInt(0),
+		)
+		assert.Nil(t, err)
+		defer backend.Close()
+
+		baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+		token, err := erc20.NewIERC20Interactions(baseInteractions, *contractAddress, []erc20.BaseERC20Signature{}, auth)
+		assert.Nil(t, err)
+		_, err = token.TransferConfirmed(auth.From, big.NewInt(1000))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("KO - Not mined in time", func(t *testing.T) {
+		backend, auth, contractAddress, privKey, err := utils.SetupBlockchain(t,
+			QuirkyERC20.QuirkyERC20ABI,
+			QuirkyERC20.QuirkyERC20Bin,
+			big.NewInt(0),
+		)
+		assert.Nil(t, err)
+		defer backend.Close()
+
+		baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+		baseInteractions.SetMineTimeout(50 * time.Millisecond)
+		token, err := erc20.NewIERC20Interactions(baseInteractions, *contractAddress, []erc20.BaseERC20Signature{}, auth)
+		assert.Nil(t, err)
+		_, err = token.TransferConfirmed(recipient, big.NewInt(1000))
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,35 @@
+package vault
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type IERC4626Signatures string
+
+const (
+	Asset           IERC4626Signatures = "asset()"
+	TotalAssets     IERC4626Signatures = "totalAssets()"
+	ConvertToShares IERC4626Signatures = "convertToShares(uint256)"
+	ConvertToAssets IERC4626Signatures = "convertToAssets(uint256)"
+	MaxDeposit      IERC4626Signatures = "maxDeposit(address)"
+	MaxMint         IERC4626Signatures = "maxMint(address)"
+	MaxWithdraw     IERC4626Signatures = "maxWithdraw(address)"
+	MaxRedeem       IERC4626Signatures = "maxRedeem(address)"
+	PreviewDeposit  IERC4626Signatures = "previewDeposit(uint256)"
+	PreviewMint     IERC4626Signatures = "previewMint(uint256)"
+	PreviewWithdraw IERC4626Signatures = "previewWithdraw(uint256)"
+	PreviewRedeem   IERC4626Signatures = "previewRedeem(uint256)"
+	Deposit         IERC4626Signatures = "deposit(uint256,address)"
+	Mint            IERC4626Signatures = "mint(uint256,address)"
+	Withdraw        IERC4626Signatures = "withdraw(uint256,address,address)"
+	Redeem          IERC4626Signatures = "redeem(uint256,address,address)"
+)
+
+func (s IERC4626Signatures) GetHex() string {
+	hash := crypto.NewKeccakState()
+	hash.Write([]byte(s))
+	selector := hash.Sum(nil)[:4]
+	return hex.EncodeToString(selector)
+}
@@ -0,0 +1,250 @@
+package vault
+
+// Package vault provides functions to interact with ERC4626 tokenized vaults, whose shares are ERC20 tokens.
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/customerrors"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/ERC4626Vault"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrSlippage is returned when the previewed outcome of a deposit, mint, withdrawal or redemption is worse than the given bound.
+var ErrSlippage = errors.New("slippage exceeded")
+
+// IERC4626Interactions wraps interactions with an ERC4626 vault, extending the ERC20 interactions of its shares.
+type IERC4626Interactions struct {
+	*erc20.ERC20Interactions
+	vault     *ERC4626Vault.ERC4626VaultSession
+	callError func(string, error) *base.CallError
+	asset     *erc20.ERC20Interactions
+}
+
+// NewIERC4626 creates a new vault interaction instance using the ERC20 interactions of its shares.
+func NewIERC4626(baseIERC20 *erc20.ERC20Interactions, signatures []IERC4626Signatures) (*IERC4626Interactions, error) {
+	vault, err := ERC4626Vault.NewERC4626Vault(baseIERC20.GetAddress(), baseIERC20.Client)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("erc4626", err)
+	}
+	session := ERC4626Vault.ERC4626VaultSession{
+		Contract:     vault,
+		CallOpts:     baseIERC20.GetSession().CallOpts,
+		TransactOpts: baseIERC20.GetSession().TransactOpts,
+	}
+
+	var converted []utils.Signature
+	for _, sig := range signatures {
+		converted = append(converted, sig)
+	}
+
+	err = baseIERC20.CheckSignatures(baseIERC20.GetAddress(), converted)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("erc4626", err)
+	}
+
+	callError := func(field string, err error) *base.CallError {
+		return baseIERC20.WrapCallError(ERC4626Vault.ERC4626VaultABI, field, err)
+	}
+
+	return &IERC4626Interactions{baseIERC20, &session, callError, nil}, nil
+}
+
+// Asset returns the address of the underlying token of the vault.
+func (e *IERC4626Interactions) Asset() (common.Address, error) {
+	asset, err := base.Observe(e.BaseInteractions, base.ReadOperation, "vault.Asset()", func() (common.Address, error) {
+		return e.vault.Asset()
+	})
+	if err != nil {
+		return common.Address{}, e.callError("vault.Asset()", err)
+	}
+	return asset, nil
+}
+
+// AssetInteractions returns the ERC20 interactions of the underlying token, sending from the same account with the same options.
+func (e *IERC4626Interactions) AssetInteractions() (*erc20.ERC20Interactions, error) {
+	if e.asset != nil {
+		return e.asset, nil
+	}
+	address, err := e.Asset()
+	if err != nil {
+		return nil, err
+	}
+	opts := e.vault.TransactOpts
+	asset, err := erc20.NewIERC20Interactions(e.BaseInteractions, address, []erc20.BaseERC20Signature{erc20.Approve, erc20.Allowance}, &opts)
+	if err != nil {
+		return nil, err
+	}
+	e.asset = asset
+	return asset, nil
+}
+
+// TotalAssets returns the amount of underlying tokens managed by the vault.
+func (e *IERC4626Interactions) TotalAssets() (*big.Int, error) {
+	return e.read("vault.TotalAssets()", e.vault.TotalAssets)
+}
+
+// ConvertToShares returns the shares worth an amount of assets, without fees nor rounding against the caller.
+func (e *IERC4626Interactions) ConvertToShares(assets *big.Int) (*big.Int, error) {
+	return e.read("vault.ConvertToShares()", func() (*big.Int, error) { return e.vault.ConvertToShares(assets) })
+}
+
+// ConvertToAssets returns the assets worth an amount of shares, without fees nor rounding against the caller.
+func (e *IERC4626Interactions) ConvertToAssets(shares *big.Int) (*big.Int, error) {
+	return e.read("vault.ConvertToAssets()", func() (*big.Int, error) { return e.vault.ConvertToAssets(shares) })
+}
+
+// MaxDeposit returns the largest amount of assets that can be deposited for receiver.
+func (e *IERC4626Interactions) MaxDeposit(receiver common.Address) (*big.Int, error) {
+	return e.read("vault.MaxDeposit()", func() (*big.Int, error) { return e.vault.MaxDeposit(receiver) })
+}
+
+// MaxMint returns the largest amount of shares that can be minted for receiver.
+func (e *IERC4626Interactions) MaxMint(receiver common.Address) (*big.Int, error) {
+	return e.read("vault.MaxMint()", func() (*big.Int, error) { return e.vault.MaxMint(receiver) })
+}
+
+// MaxWithdraw returns the largest amount of assets owner can withdraw.
+func (e *IERC4626Interactions) MaxWithdraw(owner common.Address) (*big.Int, error) {
+	return e.read("vault.MaxWithdraw()", func() (*big.Int, error) { return e.vault.MaxWithdraw(owner) })
+}
+
+// MaxRedeem returns the largest amount of shares owner can redeem.
+func (e *IERC4626Interactions) MaxRedeem(owner common.Address) (*big.Int, error) {
+	return e.read("vault.MaxRedeem()", func() (*big.Int, error) { return e.vault.MaxRedeem(owner) })
+}
+
+// PreviewDeposit returns the shares a deposit of assets would mint in the pending block.
+func (e *IERC4626Interactions) PreviewDeposit(assets *big.Int) (*big.Int, error) {
+	return e.read("vault.PreviewDeposit()", func() (*big.Int, error) { return e.vault.PreviewDeposit(assets) })
+}
+
+// PreviewMint returns the assets a mint of shares would take in the pending block.
+func (e *IERC4626Interactions) PreviewMint(shares *big.Int) (*big.Int, error) {
+	return e.read("vault.PreviewMint()", func() (*big.Int, error) { return e.vault.PreviewMint(shares) })
+}
+
+// PreviewWithdraw returns the shares a withdrawal of assets would burn in the pending block.
+func (e *IERC4626Interactions) PreviewWithdraw(assets *big.Int) (*big.Int, error) {
+	return e.read("vault.PreviewWithdraw()", func() (*big.Int, error) { return e.vault.PreviewWithdraw(assets) })
+}
+
+// PreviewRedeem returns the assets a redemption of shares would give in the pending block.
+func (e *IERC4626Interactions) PreviewRedeem(shares *big.Int) (*big.Int, error) {
+	return e.read("vault.PreviewRedeem()", func() (*big.Int, error) { return e.vault.PreviewRedeem(shares) })
+}
+
+// Deposit deposits assets for receiver, failing with ErrSlippage when the previewed shares are below minShares.
+// The vault allowance is raised first through EnsureAllowance on the underlying token and awaited,
+// the returned transactions are the approvals followed by the deposit.
+func (e *IERC4626Interactions) Deposit(assets *big.Int, receiver common.Address, minShares *big.Int) ([]*types.Transaction, error) {
+	shares, err := e.PreviewDeposit(assets)
+	if err != nil {
+		return nil, err
+	}
+	if shares.Cmp(minShares) < 0 {
+		return nil, fmt.Errorf("%w: depositing %s assets mints %s shares, below %s", ErrSlippage, assets, shares, minShares)
+	}
+	return e.pull(assets, "vault.Deposit()", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return e.vault.Contract.Deposit(opts, assets, receiver)
+	})
+}
+
+// Mint mints shares for receiver, failing with ErrSlippage when the previewed assets are above maxAssets.
+// The vault is approved for maxAssets, which bounds the assets taken even when the price moves before the mint is mined.
+// The returned transactions are the approvals followed by the mint.
+func (e *IERC4626Interactions) Mint(shares *big.Int, receiver common.Address, maxAssets *big.Int) ([]*types.Transaction, error) {
+	assets, err := e.PreviewMint(shares)
+	if err != nil {
+		return nil, err
+	}
+	if assets.Cmp(maxAssets) > 0 {
+		return nil, fmt.Errorf("%w: minting %s shares takes %s assets, above %s", ErrSlippage, shares, assets, maxAssets)
+	}
+	return e.pull(maxAssets, "vault.Mint()", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return e.vault.Contract.Mint(opts, shares, receiver)
+	})
+}
+
+// Withdraw withdraws assets of the account to receiver, failing with ErrSlippage when the previewed shares burnt are above maxShares.
+func (e *IERC4626Interactions) Withdraw(assets *big.Int, receiver common.Address, maxShares *big.Int) (*types.Transaction, error) {
+	shares, err := e.PreviewWithdraw(assets)
+	if err != nil {
+		return nil, err
+	}
+	if shares.Cmp(maxShares) > 0 {
+		return nil, fmt.Errorf("%w: withdrawing %s assets burns %s shares, above %s", ErrSlippage, assets, shares, maxShares)
+	}
+	tx, err := base.Observe(e.BaseInteractions, base.SendOperation, "vault.Withdraw()", func() (*types.Transaction, error) {
+		return e.vault.Withdraw(assets, receiver, e.Address)
+	})
+	if err != nil {
+		return nil, e.callError("vault.Withdraw()", err)
+	}
+	return tx, nil
+}
+
+// Redeem redeems shares of the account for receiver, failing with ErrSlippage when the previewed assets are below minAssets.
+func (e *IERC4626Interactions) Redeem(shares *big.Int, receiver common.Address, minAssets *big.Int) (*types.Transaction, error) {
+	assets, err := e.PreviewRedeem(shares)
+	if err != nil {
+		return nil, err
+	}
+	if assets.Cmp(minAssets) < 0 {
+		return nil, fmt.Errorf("%w: redeeming %s shares gives %s assets, below %s", ErrSlippage, shares, assets, minAssets)
+	}
+	tx, err := base.Observe(e.BaseInteractions, base.SendOperation, "vault.Redeem()", func() (*types.Transaction, error) {
+		return e.vault.Redeem(shares, receiver, e.Address)
+	})
+	if err != nil {
+		return nil, e.callError("vault.Redeem()", err)
+	}
+	return tx, nil
+}
+
+// pull ensures the vault may take amount of the underlying token, waits for the approvals and sends the transaction built by send
+// with the next nonce, since the vault could not take the assets before the approvals are mined.
+func (e *IERC4626Interactions) pull(amount *big.Int, method string, send func(*bind.TransactOpts) (*types.Transaction, error)) ([]*types.Transaction, error) {
+	asset, err := e.AssetInteractions()
+	if err != nil {
+		return nil, err
+	}
+	txs, err := asset.EnsureAllowance(e.GetAddress(), amount)
+	if err != nil {
+		return txs, err
+	}
+	opts := e.vault.TransactOpts
+	if len(txs) > 0 {
+		last := txs[len(txs)-1]
+		receipt, err := e.WaitMined(last)
+		if err != nil {
+			return txs, fmt.Errorf("failed to wait for tx %s: %w", last.Hash().Hex(), err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return txs, fmt.Errorf("approval reverted in tx %s", last.Hash().Hex())
+		}
+		opts.Nonce = new(big.Int).SetUint64(last.Nonce() + 1)
+	}
+	tx, err := base.Observe(e.BaseInteractions, base.SendOperation, method, func() (*types.Transaction, error) {
+		return send(&opts)
+	})
+	if err != nil {
+		return txs, e.callError(method, err)
+	}
+	return append(txs, tx), nil
+}
+
+func (e *IERC4626Interactions) read(method string, call func() (*big.Int, error)) (*big.Int, error) {
+	value, err := base.Observe(e.BaseInteractions, base.ReadOperation, method, call)
+	if err != nil {
+		return nil, e.callError(method, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,170 @@
+package vault_test
+
+// Package vault_test contains tests for ERC4626 vault interactions.
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/erc20/vault"
+	"github.com/OCharless/eth-interfaces/inferences/ERC4626Vault"
+	"github.com/OCharless/eth-interfaces/testkit"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var allSignatures = []vault.IERC4626Signatures{
+	vault.Asset, vault.TotalAssets, vault.ConvertToShares, vault.ConvertToAssets,
+	vault.MaxDeposit, vault.MaxMint, vault.MaxWithdraw, vault.MaxRedeem,
+	vault.PreviewDeposit, vault.PreviewMint, vault.PreviewWithdraw, vault.PreviewRedeem,
+	vault.Deposit, vault.Mint, vault.Withdraw, vault.Redeem,
+}
+
+// Test_Instantiation verifies that the vault interactions are only created for contracts implementing ERC4626.
+func Test_Instantiation(t *testing.T) {
+	kit := testkit.New(t).WithERC20().Build()
+	owner := kit.Accounts[0]
+	vaultAddress, _, _, err := ERC4626Vault.DeployERC4626Vault(owner.Auth, kit.Client(), kit.ERC20)
+	assert.Nil(t, err)
+	kit.Commit()
+
+	testCases := []struct {
+		Name          string
+		Shares        func() *erc20.ERC20Interactions
+		ExpectedError string
+	}{
+		{
+			Name:   "OK - Successfully instantiated",
+			Shares: func() *erc20.ERC20Interactions { return shares(t, kit, vaultAddress) },
+		},
+		{
+			Name:          "KO - Plain ERC20",
+			Shares:        func() *erc20.ERC20Interactions { return owner.ERC20 },
+			ExpectedError: "not supported functions",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := vault.NewIERC4626(tc.Shares(), allSignatures)
+			if tc.ExpectedError != "" {
+				assert.ErrorContains(t, err, tc.ExpectedError)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
+
+// Test_Vault verifies the conversions, the slippage guards and the asset approvals of deposits, mints, withdrawals and redemptions.
+func Test_Vault(t *testing.T) {
+	kit := testkit.New(t).WithERC20().Build()
+	owner := kit.Accounts[0]
+	vaultAddress, _, _, err := ERC4626Vault.DeployERC4626Vault(owner.Auth, kit.Client(), kit.ERC20)
+	assert.Nil(t, err)
+	kit.Commit()
+
+	// Deposits and mints wait for their approvals to be mined.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+				kit.Commit()
+			}
+		}
+	}()
+
+	v, err := vault.NewIERC4626(shares(t, kit, vaultAddress), allSignatures)
+	assert.Nil(t, err)
+	mined := func(txs ...*types.Transaction) {
+		t.Helper()
+		for _, tx := range txs {
+			receipt, err := bind.WaitMined(context.Background(), kit.Client(), tx)
+			assert.Nil(t, err)
+			assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+		}
+	}
+	value := func(get func() (*big.Int, error)) string {
+		t.Helper()
+		v, err := get()
+		assert.Nil(t, err)
+		return v.String()
+	}
+
+	t.Run("OK - Asset", func(t *testing.T) {
+		asset, err := v.Asset()
+		assert.Nil(t, err)
+		assert.Equal(t, kit.ERC20, asset)
+	})
+
+	t.Run("OK - Deposit", func(t *testing.T) {
+		txs, err := v.Deposit(big.NewInt(1000), owner.Address, big.NewInt(1000))
+		assert.Nil(t, err)
+		assert.Len(t, txs, 2)
+		mined(txs...)
+		assert.Equal(t, "1000", value(v.GetBalance))
+		assert.Equal(t, "1000", value(v.TotalAssets))
+	})
+
+	// Assets sent to the vault halve the shares minted per asset.
+	tx, err := owner.ERC20.TransferTo(vaultAddress, big.NewInt(1001))
+	assert.Nil(t, err)
+	mined(tx)
+
+	t.Run("OK - Conversions", func(t *testing.T) {
+		assert.Equal(t, "500", value(func() (*big.Int, error) { return v.ConvertToShares(big.NewInt(1000)) }))
+		assert.Equal(t, "2000", value(func() (*big.Int, error) { return v.ConvertToAssets(big.NewInt(1000)) }))
+		assert.Equal(t, "200", value(func() (*big.Int, error) { return v.PreviewMint(big.NewInt(100)) }))
+		assert.Equal(t, "51", value(func() (*big.Int, error) { return v.PreviewWithdraw(big.NewInt(101)) }))
+		assert.Equal(t, "1000", value(func() (*big.Int, error) { return v.MaxRedeem(owner.Address) }))
+	})
+
+	t.Run("KO - Slippage", func(t *testing.T) {
+		_, err := v.Deposit(big.NewInt(1000), owner.Address, big.NewInt(1000))
+		assert.ErrorIs(t, err, vault.ErrSlippage)
+		_, err = v.Mint(big.NewInt(100), owner.Address, big.NewInt(199))
+		assert.ErrorIs(t, err, vault.ErrSlippage)
+		_, err = v.Withdraw(big.NewInt(1000), owner.Address, big.NewInt(100))
+		assert.ErrorIs(t, err, vault.ErrSlippage)
+		_, err = v.Redeem(big.NewInt(100), owner.Address, big.NewInt(1000))
+		assert.ErrorIs(t, err, vault.ErrSlippage)
+	})
+
+	t.Run("OK - Mint", func(t *testing.T) {
+		txs, err := v.Mint(big.NewInt(100), owner.Address, big.NewInt(250))
+		assert.Nil(t, err)
+		assert.Len(t, txs, 2)
+		mined(txs...)
+		assert.Equal(t, "1100", value(v.GetBalance))
+		assert.Equal(t, "2201", value(v.TotalAssets))
+	})
+
+	t.Run("OK - Withdraw and redeem", func(t *testing.T) {
+		tx, err := v.Withdraw(big.NewInt(202), owner.Address, big.NewInt(101))
+		assert.Nil(t, err)
+		mined(tx)
+		assert.Equal(t, "999", value(v.GetBalance))
+
+		tx, err = v.Redeem(big.NewInt(999), owner.Address, big.NewInt(1990))
+		assert.Nil(t, err)
+		mined(tx)
+		assert.Equal(t, "0", value(v.GetBalance))
+	})
+}
+
+// shares returns the ERC20 interactions of the vault shares for the first account.
+func shares(t *testing.T, kit *testkit.Kit, address common.Address) *erc20.ERC20Interactions {
+	t.Helper()
+	owner := kit.Accounts[0]
+	interactions, err := erc20.NewIERC20Interactions(owner.Base, address, []erc20.BaseERC20Signature{}, owner.Auth)
+	assert.Nil(t, err)
+	return interactions
+}
@@ -0,0 +1,28 @@
+package votes
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type ERC20VotesSignatures string
+
+const (
+	Delegates          ERC20VotesSignatures = "delegates(address)"
+	Delegate           ERC20VotesSignatures = "delegate(address)"
+	DelegateBySig      ERC20VotesSignatures = "delegateBySig(address,uint256,uint256,uint8,bytes32,bytes32)"
+	GetVotes           ERC20VotesSignatures = "getVotes(address)"
+	GetPastVotes       ERC20VotesSignatures = "getPastVotes(address,uint256)"
+	GetPastTotalSupply ERC20VotesSignatures = "getPastTotalSupply(uint256)"
+	Nonces             ERC20VotesSignatures = "nonces(address)"
+	Clock              ERC20VotesSignatures = "clock()"
+	EIP712Domain       ERC20VotesSignatures = "eip712Domain()"
+)
+
+func (s ERC20VotesSignatures) GetHex() string {
+	hash := crypto.NewKeccakState()
+	hash.Write([]byte(s))
+	selector := hash.Sum(nil)[:4]
+	return hex.EncodeToString(selector)
+}
@@ -0,0 +1,251 @@
+package votes
+
+// Package votes provides functions to interact with ERC20Votes tokens, whose balances are delegated as voting power.
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/customerrors"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Extended"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// DomainVersion is the version of the EIP-712 domain delegations are signed for when the token does not implement eip712Domain(),
+// the one OpenZeppelin tokens use by default.
+const DomainVersion = "1"
+
+// DelegationSignature is a delegation signed by a token holder, which anyone can submit through DelegateBySig.
+type DelegationSignature struct {
+	Delegator common.Address
+	Delegatee common.Address
+	Nonce     *big.Int
+	Expiry    *big.Int
+	V         uint8
+	R         [32]byte
+	S         [32]byte
+}
+
+// IERC20VotesInteractions wraps interactions with an ERC20Votes contract, extending basic ERC20 interactions.
+type IERC20VotesInteractions struct {
+	*erc20.ERC20Interactions
+	erc20Votes *ERC20Extended.ERC20ExtendedSession
+	callError  func(string, error) *base.CallError
+}
+
+// NewIERC20Votes creates a new votes interaction instance using the provided base ERC20 interactions.
+func NewIERC20Votes(baseIERC20 *erc20.ERC20Interactions, signatures []ERC20VotesSignatures) (*IERC20VotesInteractions, error) {
+	erc20Votes, err := ERC20Extended.NewERC20Extended(baseIERC20.GetAddress(), baseIERC20.Client)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("ierc20Votes", err)
+	}
+	session := ERC20Extended.ERC20ExtendedSession{
+		Contract:     erc20Votes,
+		CallOpts:     baseIERC20.GetSession().CallOpts,
+		TransactOpts: baseIERC20.GetSession().TransactOpts,
+	}
+
+	var converted []utils.Signature
+	for _, sig := range signatures {
+		converted = append(converted, sig)
+	}
+
+	err = baseIERC20.CheckSignatures(baseIERC20.GetAddress(), converted)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("ierc20Votes", err)
+	}
+
+	callError := func(field string, err error) *base.CallError {
+		return baseIERC20.WrapCallError(ERC20Extended.ERC20ExtendedABI, field, err)
+	}
+
+	return &IERC20VotesInteractions{baseIERC20, &session, callError}, nil
+}
+
+// Delegates returns the account the voting power of account is delegated to, the zero address when it is not delegated.
+func (e *IERC20VotesInteractions) Delegates(account common.Address) (common.Address, error) {
+	delegatee, err := base.Observe(e.BaseInteractions, base.ReadOperation, "erc20.Delegates()", func() (common.Address, error) {
+		return e.erc20Votes.Delegates(account)
+	})
+	if err != nil {
+		return common.Address{}, e.callError("erc20.Delegates()", err)
+	}
+	return delegatee, nil
+}
+
+// Delegate delegates the voting power of the account to delegatee, which may be the account itself.
+func (e *IERC20VotesInteractions) Delegate(delegatee common.Address) (*types.Transaction, error) {
+	tx, err := base.Observe(e.BaseInteractions, base.SendOperation, "erc20.Delegate()", func() (*types.Transaction, error) {
+		return e.erc20Votes.Delegate(delegatee)
+	})
+	if err != nil {
+		return nil, e.callError("erc20.Delegate()", err)
+	}
+	return tx, nil
+}
+
+// GetVotes returns the current voting power of account.
+func (e *IERC20VotesInteractions) GetVotes(account common.Address) (*big.Int, error) {
+	return e.read("erc20.GetVotes()", func() (*big.Int, error) { return e.erc20Votes.GetVotes(account) })
+}
+
+// GetPastVotes returns the voting power of account at a past timepoint of the token clock, a block number unless the clock says otherwise.
+func (e *IERC20VotesInteractions) GetPastVotes(account common.Address, timepoint *big.Int) (*big.Int, error) {
+	return e.read("erc20.GetPastVotes()", func() (*big.Int, error) { return e.erc20Votes.GetPastVotes(account, timepoint) })
+}
+
+// GetPastTotalSupply returns the total supply at a past timepoint of the token clock.
+func (e *IERC20VotesInteractions) GetPastTotalSupply(timepoint *big.Int) (*big.Int, error) {
+	return e.read("erc20.GetPastTotalSupply()", func() (*big.Int, error) { return e.erc20Votes.GetPastTotalSupply(timepoint) })
+}
+
+// Nonces returns the next nonce of owner for signed delegations.
+func (e *IERC20VotesInteractions) Nonces(owner common.Address) (*big.Int, error) {
+	return e.read("erc20.Nonces()", func() (*big.Int, error) { return e.erc20Votes.Nonces(owner) })
+}
+
+// Clock returns the current timepoint of the token, against which past votes are looked up.
+func (e *IERC20VotesInteractions) Clock() (*big.Int, error) {
+	return e.read("erc20.Clock()", e.erc20Votes.Clock)
+}
+
+// SignDelegation signs with the account key a delegation to delegatee valid until the expiry timestamp, using the next nonce of the account.
+// The EIP-712 domain is read from eip712Domain() (ERC-5267) when the token implements it, built from the token name
+// and DomainVersion otherwise.
+func (e *IERC20VotesInteractions) SignDelegation(delegatee common.Address, expiry *big.Int) (*DelegationSignature, error) {
+	nonce, err := e.Nonces(e.Address)
+	if err != nil {
+		return nil, err
+	}
+	domainType, domain, err := e.Domain()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := e.SignTypedData(apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": domainType,
+			"Delegation": {
+				{Name: "delegatee", Type: "address"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "expiry", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Delegation",
+		Domain:      domain,
+		Message: apitypes.TypedDataMessage{
+			"delegatee": delegatee.Hex(),
+			"nonce":     nonce,
+			"expiry":    expiry,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	delegation := &DelegationSignature{
+		Delegator: e.Address,
+		Delegatee: delegatee,
+		Nonce:     nonce,
+		Expiry:    expiry,
+		V:         signature[64],
+	}
+	copy(delegation.R[:], signature[:32])
+	copy(delegation.S[:], signature[32:64])
+	return delegation, nil
+}
+
+// Domain returns the EIP-712 domain of the token along with its type, which only lists the fields the domain uses.
+// It is read from eip712Domain() (ERC-5267) when the token implements it, and built from the token name, DomainVersion,
+// the chain ID and the token address otherwise.
+func (e *IERC20VotesInteractions) Domain() ([]apitypes.Type, apitypes.TypedDataDomain, error) {
+	err := e.CheckSignatures(e.GetAddress(), []utils.Signature{EIP712Domain})
+	var notSupported *customerrors.InterfacingError
+	if errors.As(err, &notSupported) {
+		name, err := e.Name()
+		if err != nil {
+			return nil, apitypes.TypedDataDomain{}, err
+		}
+		chainID, err := base.Observe(e.BaseInteractions, base.ReadOperation, "votes.ChainID()", func() (*big.Int, error) {
+			return e.Client.ChainID(e.Ctx)
+		})
+		if err != nil {
+			return nil, apitypes.TypedDataDomain{}, customerrors.WrapinterfacingError("ChainID", err)
+		}
+		return []apitypes.Type{
+			{Name: "name", Type: "string"},
+			{Name: "version", Type: "string"},
+			{Name: "chainId", Type: "uint256"},
+			{Name: "verifyingContract", Type: "address"},
+		}, apitypes.TypedDataDomain{
+			Name:              name,
+			Version:           DomainVersion,
+			ChainId:           (*math.HexOrDecimal256)(chainID),
+			VerifyingContract: e.GetAddress().Hex(),
+		}, nil
+	}
+	if err != nil {
+		return nil, apitypes.TypedDataDomain{}, err
+	}
+
+	eip712Domain, err := base.Observe(e.BaseInteractions, base.ReadOperation, "erc20.Eip712Domain()", e.erc20Votes.Eip712Domain)
+	if err != nil {
+		return nil, apitypes.TypedDataDomain{}, e.callError("erc20.Eip712Domain()", err)
+	}
+	if len(eip712Domain.Extensions) > 0 {
+		return nil, apitypes.TypedDataDomain{}, e.callError("erc20.Eip712Domain()", fmt.Errorf("unsupported domain extensions %v", eip712Domain.Extensions))
+	}
+
+	// The bits of fields flag, from the lowest, the name, version, chainId, verifyingContract and salt of the domain.
+	fields := eip712Domain.Fields[0]
+	domainType := []apitypes.Type{}
+	domain := apitypes.TypedDataDomain{}
+	if fields&0x01 != 0 {
+		domainType = append(domainType, apitypes.Type{Name: "name", Type: "string"})
+		domain.Name = eip712Domain.Name
+	}
+	if fields&0x02 != 0 {
+		domainType = append(domainType, apitypes.Type{Name: "version", Type: "string"})
+		domain.Version = eip712Domain.Version
+	}
+	if fields&0x04 != 0 {
+		domainType = append(domainType, apitypes.Type{Name: "chainId", Type: "uint256"})
+		domain.ChainId = (*math.HexOrDecimal256)(eip712Domain.ChainId)
+	}
+	if fields&0x08 != 0 {
+		domainType = append(domainType, apitypes.Type{Name: "verifyingContract", Type: "address"})
+		domain.VerifyingContract = eip712Domain.VerifyingContract.Hex()
+	}
+	if fields&0x10 != 0 {
+		domainType = append(domainType, apitypes.Type{Name: "salt", Type: "bytes32"})
+		domain.Salt = hexutil.Encode(eip712Domain.Salt[:])
+	}
+	return domainType, domain, nil
+}
+
+// DelegateBySig submits a signed delegation, the account paying for the transaction on behalf of the delegator.
+func (e *IERC20VotesInteractions) DelegateBySig(signature *DelegationSignature) (*types.Transaction, error) {
+	tx, err := base.Observe(e.BaseInteractions, base.SendOperation, "erc20.DelegateBySig()", func() (*types.Transaction, error) {
+		return e.erc20Votes.DelegateBySig(signature.Delegatee, signature.Nonce, signature.Expiry, signature.V, signature.R, signature.S)
+	})
+	if err != nil {
+		return nil, e.callError("erc20.DelegateBySig()", err)
+	}
+	return tx, nil
+}
+
+func (e *IERC20VotesInteractions) read(method string, call func() (*big.Int, error)) (*big.Int, error) {
+	value, err := base.Observe(e.BaseInteractions, base.ReadOperation, method, call)
+	if err != nil {
+		return nil, e.callError(method, err)
+	}
+	return value, nil
+}
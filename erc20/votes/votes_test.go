@@ -0,0 +1,153 @@
+package votes_test
+
+// Package votes_test contains tests for votes interactions.
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/erc20/votes"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Extended"
+	"github.com/OCharless/eth-interfaces/testkit"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+var allSignatures = []votes.ERC20VotesSignatures{
+	votes.Delegates, votes.Delegate, votes.DelegateBySig, votes.GetVotes,
+	votes.GetPastVotes, votes.GetPastTotalSupply, votes.Nonces, votes.Clock,
+}
+
+// Test_Instantiation verifies that the votes interactions are only created for contracts implementing delegation.
+func Test_Instantiation(t *testing.T) {
+	kit := testkit.New(t).WithERC20().Build()
+	owner := kit.Accounts[0]
+
+	_, err := votes.NewIERC20Votes(owner.ERC20, allSignatures)
+	assert.ErrorContains(t, err, "not supported functions")
+}
+
+// Test_Votes verifies delegation, past lookups and signed delegations relayed by another account.
+func Test_Votes(t *testing.T) {
+	kit := testkit.New(t).WithAccounts(2).Build()
+	owner, other := kit.Accounts[0], kit.Accounts[1]
+	token, _, contract, err := ERC20Extended.DeployERC20Extended(owner.Auth, kit.Client(), big.NewInt(10000))
+	assert.Nil(t, err)
+	kit.Commit()
+	_, err = contract.Mint(owner.Auth, owner.Address, big.NewInt(1000))
+	assert.Nil(t, err)
+	_, err = contract.Mint(owner.Auth, other.Address, big.NewInt(500))
+	assert.Nil(t, err)
+	kit.Commit()
+
+	voter := func(account *testkit.Account) *votes.IERC20VotesInteractions {
+		erc20Interactions, err := erc20.NewIERC20Interactions(account.Base, token, []erc20.BaseERC20Signature{}, account.Auth)
+		assert.Nil(t, err)
+		interactions, err := votes.NewIERC20Votes(erc20Interactions, allSignatures)
+		assert.Nil(t, err)
+		return interactions
+	}
+	ownerVoter, otherVoter := voter(owner), voter(other)
+	votesOf := func(account *testkit.Account) string {
+		t.Helper()
+		power, err := ownerVoter.GetVotes(account.Address)
+		assert.Nil(t, err)
+		return power.String()
+	}
+
+	clock, err := ownerVoter.Clock()
+	assert.Nil(t, err)
+	before := new(big.Int).Sub(clock, big.NewInt(1))
+
+	t.Run("OK - Delegate to self", func(t *testing.T) {
+		assert.Equal(t, "0", votesOf(owner))
+		_, err := ownerVoter.Delegate(owner.Address)
+		assert.Nil(t, err)
+		kit.Commit()
+		assert.Equal(t, "1000", votesOf(owner))
+		delegatee, err := ownerVoter.Delegates(owner.Address)
+		assert.Nil(t, err)
+		assert.Equal(t, owner.Address, delegatee)
+	})
+
+	t.Run("OK - Past votes", func(t *testing.T) {
+		kit.Commit()
+		past, err := ownerVoter.GetPastVotes(owner.Address, before)
+		assert.Nil(t, err)
+		assert.Equal(t, "0", past.String())
+		supply, err := ownerVoter.GetPastTotalSupply(before)
+		assert.Nil(t, err)
+		assert.Equal(t, "1500", supply.String())
+	})
+
+	t.Run("KO - Future lookup", func(t *testing.T) {
+		clock, err := ownerVoter.Clock()
+		assert.Nil(t, err)
+		_, err = ownerVoter.GetPastVotes(owner.Address, new(big.Int).Add(clock, big.NewInt(10)))
+		assert.ErrorContains(t, err, "ERC5805FutureLookup")
+	})
+
+	expiry := big.NewInt(1 << 40)
+	signature, err := otherVoter.SignDelegation(owner.Address, expiry)
+	assert.Nil(t, err)
+
+	t.Run("OK - Delegate by signature", func(t *testing.T) {
+		_, err := ownerVoter.DelegateBySig(signature)
+		assert.Nil(t, err)
+		kit.Commit()
+		assert.Equal(t, "1500", votesOf(owner))
+		delegatee, err := ownerVoter.Delegates(other.Address)
+		assert.Nil(t, err)
+		assert.Equal(t, owner.Address, delegatee)
+		nonce, err := ownerVoter.Nonces(other.Address)
+		assert.Nil(t, err)
+		assert.Equal(t, "1", nonce.String())
+	})
+
+	t.Run("KO - Replayed signature", func(t *testing.T) {
+		_, err := ownerVoter.DelegateBySig(signature)
+		assert.ErrorContains(t, err, "InvalidAccountNonce")
+	})
+
+	t.Run("KO - Expired signature", func(t *testing.T) {
+		expired, err := otherVoter.SignDelegation(other.Address, big.NewInt(1))
+		assert.Nil(t, err)
+		_, err = ownerVoter.DelegateBySig(expired)
+		assert.ErrorContains(t, err, "VotesExpiredSignature")
+	})
+}
+
+// Test_Domain verifies that the EIP-712 domain is read from eip712Domain() when the token implements it.
+func Test_Domain(t *testing.T) {
+	kit := testkit.New(t).WithERC20().Build()
+	owner := kit.Accounts[0]
+	token, _, _, err := ERC20Extended.DeployERC20Extended(owner.Auth, kit.Client(), big.NewInt(10000))
+	assert.Nil(t, err)
+	kit.Commit()
+
+	testCases := []struct {
+		Name            string
+		ContractAddr    common.Address
+		ExpectedName    string
+		ExpectedVersion string
+	}{
+		{Name: "OK - ERC-5267 domain", ContractAddr: token, ExpectedName: "Extended Votes", ExpectedVersion: "2"},
+		{Name: "OK - Token without eip712Domain", ContractAddr: kit.ERC20, ExpectedName: "TESTToken", ExpectedVersion: votes.DomainVersion},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			erc20Interactions, err := erc20.NewIERC20Interactions(owner.Base, tc.ContractAddr, []erc20.BaseERC20Signature{}, owner.Auth)
+			assert.Nil(t, err)
+			voter, err := votes.NewIERC20Votes(erc20Interactions, []votes.ERC20VotesSignatures{})
+			assert.Nil(t, err)
+			domainType, domain, err := voter.Domain()
+			assert.Nil(t, err)
+			assert.Len(t, domainType, 4)
+			assert.Equal(t, tc.ExpectedName, domain.Name)
+			assert.Equal(t, tc.ExpectedVersion, domain.Version)
+			assert.Equal(t, testkit.ChainID.String(), (*big.Int)(domain.ChainId).String())
+			assert.Equal(t, tc.ContractAddr.Hex(), domain.VerifyingContract)
+		})
+	}
+}
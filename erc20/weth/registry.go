@@ -0,0 +1,56 @@
+package weth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/customerrors"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrUnknownChain is returned by NewIWETHForChain when no canonical WETH contract is known for the chain.
+var ErrUnknownChain = errors.New("no canonical WETH for chain")
+
+// Addresses holds the canonical WETH9 contracts of the chains whose native currency is ether, keyed by chain ID.
+var Addresses = map[uint64]common.Address{
+	1:        common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"),
+	11155111: common.HexToAddress("0xfFf9976782d46CC05630D1f6eBAb18b2324d6B14"),
+	17000:    common.HexToAddress("0x94373a4919B3240D86eA41593D5eBa789FEF3848"),
+	10:       common.HexToAddress("0x4200000000000000000000000000000000000006"),
+	8453:     common.HexToAddress("0x4200000000000000000000000000000000000006"),
+	84532:    common.HexToAddress("0x4200000000000000000000000000000000000006"),
+	42161:    common.HexToAddress("0x82aF49447D8a07e3bd95BD0d56f35241523fBab1"),
+	421614:   common.HexToAddress("0x980B62Da83eFf3D4576C647993b0c1D7faf17c73"),
+	59144:    common.HexToAddress("0xe5D7C2a44FfDDf6b295A15c148167daaAf5Cf34f"),
+	534352:   common.HexToAddress("0x5300000000000000000000000000000000000004"),
+}
+
+// AddressForChain returns the canonical WETH contract of a chain, false when there is none.
+func AddressForChain(chainID *big.Int) (common.Address, bool) {
+	if chainID == nil || !chainID.IsUint64() {
+		return common.Address{}, false
+	}
+	address, ok := Addresses[chainID.Uint64()]
+	return address, ok
+}
+
+// NewIWETHForChain creates the WETH interactions of the canonical contract of the chain the client is connected to.
+func NewIWETHForChain(baseInteractions *base.BaseInteractions, transactOps ...*bind.TransactOpts) (*IWETHInteractions, error) {
+	chainID, err := baseInteractions.Client.ChainID(baseInteractions.Ctx)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("ChainID", err)
+	}
+	address, ok := AddressForChain(chainID)
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownChain, chainID)
+	}
+	baseIERC20, err := erc20.NewIERC20Interactions(baseInteractions, address, []erc20.BaseERC20Signature{}, transactOps...)
+	if err != nil {
+		return nil, err
+	}
+	return NewIWETH(baseIERC20, []WETHSignatures{Deposit, Withdraw})
+}
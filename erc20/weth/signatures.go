@@ -0,0 +1,21 @@
+package weth
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type WETHSignatures string
+
+const (
+	Deposit  WETHSignatures = "deposit()"
+	Withdraw WETHSignatures = "withdraw(uint256)"
+)
+
+func (s WETHSignatures) GetHex() string {
+	hash := crypto.NewKeccakState()
+	hash.Write([]byte(s))
+	selector := hash.Sum(nil)[:4]
+	return hex.EncodeToString(selector)
+}
@@ -0,0 +1,245 @@
+package weth
+
+// Package weth provides functions to wrap ether into WETH9 tokens and back, and to sweep or disperse funds across both forms.
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/customerrors"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/WETH9"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TransferGasLimit is the gas limit of the WETH transfer of SweepWrapped, enough for WETH9 to credit a new holder.
+// It is reserved out of the wrapped ether since the transfer cannot be estimated before the deposit is mined.
+const TransferGasLimit = 60_000
+
+// IWETHInteractions wraps interactions with a WETH9 contract, extending basic ERC20 interactions.
+type IWETHInteractions struct {
+	*erc20.ERC20Interactions
+	weth      *WETH9.WETH9Session
+	callError func(string, error) *base.CallError
+}
+
+// NewIWETH creates a new WETH interaction instance using the provided base ERC20 interactions.
+func NewIWETH(baseIERC20 *erc20.ERC20Interactions, signatures []WETHSignatures) (*IWETHInteractions, error) {
+	weth, err := WETH9.NewWETH9(baseIERC20.GetAddress(), baseIERC20.Client)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("iweth", err)
+	}
+	session := WETH9.WETH9Session{
+		Contract:     weth,
+		CallOpts:     baseIERC20.GetSession().CallOpts,
+		TransactOpts: baseIERC20.GetSession().TransactOpts,
+	}
+
+	var converted []utils.Signature
+	for _, sig := range signatures {
+		converted = append(converted, sig)
+	}
+
+	err = baseIERC20.CheckSignatures(baseIERC20.GetAddress(), converted)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("iweth", err)
+	}
+
+	callError := func(field string, err error) *base.CallError {
+		return baseIERC20.WrapCallError(WETH9.WETH9ABI, field, err)
+	}
+
+	return &IWETHInteractions{baseIERC20, &session, callError}, nil
+}
+
+// Wrap deposits amount of ether, minting the same amount of WETH to the account.
+func (e *IWETHInteractions) Wrap(amount *big.Int) (*types.Transaction, error) {
+	opts := e.weth.TransactOpts
+	return e.wrap(&opts, amount)
+}
+
+// Unwrap withdraws amount of WETH of the account, which receives the same amount of ether.
+func (e *IWETHInteractions) Unwrap(amount *big.Int) (*types.Transaction, error) {
+	opts := e.weth.TransactOpts
+	return e.unwrap(&opts, amount)
+}
+
+// SweepWrapped wraps the ether balance of the account, less the fees of the deposit and of the transfer,
+// and transfers the whole WETH balance to `to` once the deposit is mined. The returned transactions are the deposit and the transfer.
+func (e *IWETHInteractions) SweepWrapped(to common.Address) ([]*types.Transaction, error) {
+	balance, err := base.Observe(e.BaseInteractions, base.ReadOperation, "weth.BalanceAt()", func() (*big.Int, error) {
+		return e.Client.BalanceAt(e.Ctx, e.Address, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	weth := e.GetAddress()
+	depositGas, err := base.Observe(e.BaseInteractions, base.SimulationOperation, "weth.EstimateGas()", func() (uint64, error) {
+		return e.Client.EstimateGas(e.Ctx, ethereum.CallMsg{
+			From:  e.Address,
+			To:    &weth,
+			Value: balance,
+			Data:  common.Hex2Bytes(Deposit.GetHex()),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	gasPrice, err := base.Observe(e.BaseInteractions, base.ReadOperation, "weth.SuggestGasPrice()", func() (*big.Int, error) {
+		return e.Client.SuggestGasPrice(e.Ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fees := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(depositGas+TransferGasLimit))
+	value := new(big.Int).Sub(balance, fees)
+	if value.Sign() <= 0 {
+		return nil, fmt.Errorf(
+			"fees exceed balances\nfees : %f ETH\nbalance : %f ETH",
+			utils.ParseEther(fees),
+			utils.ParseEther(balance),
+		)
+	}
+
+	opts := e.legacyOpts(gasPrice, depositGas)
+	tx, err := e.wrap(opts, value)
+	if err != nil {
+		return nil, err
+	}
+	txs := []*types.Transaction{tx}
+	if err := e.await(tx); err != nil {
+		return txs, err
+	}
+
+	wrapped, err := e.GetBalance()
+	if err != nil {
+		return txs, err
+	}
+	opts = e.legacyOpts(gasPrice, TransferGasLimit)
+	opts.Nonce = new(big.Int).SetUint64(tx.Nonce() + 1)
+	tx, err = base.Observe(e.BaseInteractions, base.SendOperation, "weth.Transfer()", func() (*types.Transaction, error) {
+		return e.weth.Contract.Transfer(opts, to, wrapped)
+	})
+	if err != nil {
+		return txs, e.callError("weth.Transfer()", err)
+	}
+	return append(txs, tx), nil
+}
+
+// SweepUnwrapped unwraps the whole WETH balance of the account and, once the withdrawal is mined, sends all its ether to `to`
+// through SendAllFunds. The returned transactions are the withdrawal, left out when there is no WETH, and the ether transfer.
+func (e *IWETHInteractions) SweepUnwrapped(to common.Address) ([]*types.Transaction, error) {
+	var txs []*types.Transaction
+	wrapped, err := e.GetBalance()
+	if err != nil {
+		return nil, err
+	}
+	if wrapped.Sign() > 0 {
+		tx, err := e.Unwrap(wrapped)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+		if err := e.await(tx); err != nil {
+			return txs, err
+		}
+	}
+	tx, err := e.SendAllFunds(to)
+	if err != nil {
+		return txs, err
+	}
+	return append(txs, tx), nil
+}
+
+// DisperseWrapped wraps totalValue of ether and splits it evenly as WETH between the addresses through the disperse contract.
+// Only the part of totalValue divisible by the number of addresses is wrapped, the remainder stays as ether in the account.
+// The disperse contract is approved for that part first when its allowance is lower, and the disperse is sent once the deposit is mined.
+// It returns the link or hash of the disperse transaction, as Disperse does.
+func (e *IWETHInteractions) DisperseWrapped(addresses []common.Address, totalValue *big.Int) (string, error) {
+	disperse := e.DisperseAddress()
+	if disperse == (common.Address{}) {
+		return base.FailedTx(fmt.Errorf("disperse contract not initialized"))
+	}
+	if len(addresses) == 0 {
+		return base.FailedTx(fmt.Errorf("no address to disperse to"))
+	}
+	count := big.NewInt(int64(len(addresses)))
+	value := new(big.Int).Mul(new(big.Int).Div(totalValue, count), count)
+	approvals, err := e.EnsureAllowance(disperse, value)
+	if err != nil {
+		return base.FailedTx(err)
+	}
+	opts := e.weth.TransactOpts
+	if len(approvals) > 0 {
+		opts.Nonce = new(big.Int).SetUint64(approvals[len(approvals)-1].Nonce() + 1)
+	}
+	tx, err := e.wrap(&opts, value)
+	if err != nil {
+		return base.FailedTx(err)
+	}
+	if err := e.await(tx); err != nil {
+		return base.FailedTx(err)
+	}
+	return e.DisperseToken(e.GetAddress(), addresses, value)
+}
+
+// DisperseUnwrapped unwraps totalValue of WETH and, once the withdrawal is mined, splits the ether evenly between the addresses
+// through DisperseEther, the disperse contract refunding the remainder of the division.
+func (e *IWETHInteractions) DisperseUnwrapped(addresses []common.Address, totalValue *big.Int) (string, error) {
+	tx, err := e.Unwrap(totalValue)
+	if err != nil {
+		return base.FailedTx(err)
+	}
+	if err := e.await(tx); err != nil {
+		return base.FailedTx(err)
+	}
+	return e.DisperseEther(addresses, totalValue)
+}
+
+func (e *IWETHInteractions) wrap(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	opts.Value = amount
+	tx, err := base.Observe(e.BaseInteractions, base.SendOperation, "weth.Deposit()", func() (*types.Transaction, error) {
+		return e.weth.Contract.Deposit(opts)
+	})
+	if err != nil {
+		return nil, e.callError("weth.Deposit()", err)
+	}
+	return tx, nil
+}
+
+func (e *IWETHInteractions) unwrap(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	tx, err := base.Observe(e.BaseInteractions, base.SendOperation, "weth.Withdraw()", func() (*types.Transaction, error) {
+		return e.weth.Contract.Withdraw(opts, amount)
+	})
+	if err != nil {
+		return nil, e.callError("weth.Withdraw()", err)
+	}
+	return tx, nil
+}
+
+// legacyOpts returns the options of the session with a fixed gas price and limit, so that the fees of a sweep are known upfront.
+func (e *IWETHInteractions) legacyOpts(gasPrice *big.Int, gasLimit uint64) *bind.TransactOpts {
+	opts := e.weth.TransactOpts
+	opts.GasPrice = gasPrice
+	opts.GasFeeCap, opts.GasTipCap = nil, nil
+	opts.GasLimit = gasLimit
+	return &opts
+}
+
+// await waits for tx to be mined, within the mine timeout, and fails when it reverted.
+func (e *IWETHInteractions) await(tx *types.Transaction) error {
+	receipt, err := e.WaitMined(tx)
+	if err != nil {
+		return fmt.Errorf("failed to wait for tx %s: %w", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("tx %s reverted", tx.Hash().Hex())
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package weth_test
+
+// Package weth_test contains tests for WETH interactions.
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/erc20/weth"
+	"github.com/OCharless/eth-interfaces/inferences/WETH9"
+	"github.com/OCharless/eth-interfaces/testkit"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var allSignatures = []weth.WETHSignatures{weth.Deposit, weth.Withdraw}
+
+var ether = big.NewInt(1e18)
+
+// Test_Registry verifies the lookup of the canonical WETH contracts.
+func Test_Registry(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		ChainID         *big.Int
+		ExpectedAddress common.Address
+		ExpectedFound   bool
+	}{
+		{Name: "OK - Mainnet", ChainID: big.NewInt(1), ExpectedAddress: common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"), ExpectedFound: true},
+		{Name: "OK - Base", ChainID: big.NewInt(8453), ExpectedAddress: common.HexToAddress("0x4200000000000000000000000000000000000006"), ExpectedFound: true},
+		{Name: "KO - Unknown chain", ChainID: testkit.ChainID},
+		{Name: "KO - Nil chain", ChainID: nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			address, found := weth.AddressForChain(tc.ChainID)
+			assert.Equal(t, tc.ExpectedFound, found)
+			assert.Equal(t, tc.ExpectedAddress, address)
+		})
+	}
+
+	t.Run("KO - Instantiation on an unknown chain", func(t *testing.T) {
+		kit := testkit.New(t).Build()
+		_, err := weth.NewIWETHForChain(kit.Accounts[0].Base, kit.Accounts[0].Auth)
+		assert.ErrorIs(t, err, weth.ErrUnknownChain)
+	})
+}
+
+// Test_Instantiation verifies that the WETH interactions are only created for contracts implementing deposit and withdraw.
+func Test_Instantiation(t *testing.T) {
+	kit := testkit.New(t).WithERC20().Build()
+	owner := kit.Accounts[0]
+
+	_, err := weth.NewIWETH(owner.ERC20, allSignatures)
+	assert.ErrorContains(t, err, "not supported functions")
+}
+
+// Test_Wrap verifies that wrapping and unwrapping move ether and WETH one for one.
+func Test_Wrap(t *testing.T) {
+	kit := testkit.New(t).Build()
+	owner := kit.Accounts[0]
+	w := deploy(t, kit)(owner)
+
+	t.Run("OK - Wrap", func(t *testing.T) {
+		_, err := w.Wrap(ether)
+		assert.Nil(t, err)
+		kit.Commit()
+		assert.Equal(t, ether.String(), value(t, w.GetBalance))
+		assert.Equal(t, ether.String(), value(t, w.TotalSupply))
+	})
+
+	t.Run("OK - Unwrap", func(t *testing.T) {
+		before := balanceAt(t, kit, w.GetAddress())
+		_, err := w.Unwrap(big.NewInt(4e17))
+		assert.Nil(t, err)
+		kit.Commit()
+		assert.Equal(t, "600000000000000000", value(t, w.GetBalance))
+		assert.Equal(t, new(big.Int).Sub(before, big.NewInt(4e17)), balanceAt(t, kit, w.GetAddress()))
+	})
+
+	t.Run("KO - Unwrap above the balance", func(t *testing.T) {
+		_, err := w.Unwrap(ether)
+		assert.NotNil(t, err)
+	})
+}
+
+// Test_Sweep verifies that sweeps wrap or unwrap the whole balance of the account before sending it.
+func Test_Sweep(t *testing.T) {
+	kit := testkit.New(t).WithAccounts(3).Build()
+	sweeper, holder, receiver := kit.Accounts[0], kit.Accounts[1], kit.Accounts[2]
+	interactions := deploy(t, kit)
+	mine(t, kit)
+
+	t.Run("OK - Sweep wrapped", func(t *testing.T) {
+		// The gas price only decreases as empty blocks are mined, the one suggested now bounds the one of the sweep.
+		gasPrice, err := kit.Client().SuggestGasPrice(context.Background())
+		assert.Nil(t, err)
+		txs, err := interactions(sweeper).SweepWrapped(holder.Address)
+		assert.Nil(t, err)
+		assert.Len(t, txs, 2)
+		mined(t, kit, txs...)
+
+		wrapped, err := interactions(holder).GetBalance()
+		assert.Nil(t, err)
+		reserve := new(big.Int).Mul(gasPrice, big.NewInt(weth.TransferGasLimit))
+		assert.True(t, balanceAt(t, kit, sweeper.Address).Cmp(reserve) <= 0)
+		assert.True(t, wrapped.Cmp(new(big.Int).Mul(big.NewInt(999_999), ether)) > 0)
+	})
+
+	t.Run("OK - Sweep unwrapped", func(t *testing.T) {
+		h := interactions(holder)
+		before := balanceAt(t, kit, receiver.Address)
+		wrapped, err := h.GetBalance()
+		assert.Nil(t, err)
+		txs, err := h.SweepUnwrapped(receiver.Address)
+		assert.Nil(t, err)
+		assert.Len(t, txs, 2)
+		mined(t, kit, txs...)
+
+		assert.Equal(t, "0", value(t, h.GetBalance))
+		received := new(big.Int).Sub(balanceAt(t, kit, receiver.Address), before)
+		assert.True(t, received.Cmp(wrapped) > 0)
+	})
+}
+
+// Test_Disperse verifies that disperses wrap or unwrap the value before splitting it.
+func Test_Disperse(t *testing.T) {
+	kit := testkit.New(t).WithAccounts(4).WithDisperse().Build()
+	owner, first, second, third := kit.Accounts[0], kit.Accounts[1], kit.Accounts[2], kit.Accounts[3]
+	interactions := deploy(t, kit)
+	mine(t, kit)
+
+	t.Run("OK - Disperse wrapped", func(t *testing.T) {
+		// The wei left over by the division is not wrapped.
+		o := interactions(owner)
+		_, err := o.DisperseWrapped([]common.Address{first.Address, second.Address}, new(big.Int).Add(new(big.Int).Mul(big.NewInt(2), ether), big.NewInt(1)))
+		assert.Nil(t, err)
+		for _, account := range []*testkit.Account{first, second} {
+			assert.Equal(t, ether.String(), value(t, interactions(account).GetBalance))
+		}
+		assert.Equal(t, "0", value(t, o.GetBalance))
+	})
+
+	t.Run("OK - Disperse unwrapped", func(t *testing.T) {
+		before := balanceAt(t, kit, third.Address)
+		f := interactions(first)
+		_, err := f.DisperseUnwrapped([]common.Address{third.Address}, ether)
+		assert.Nil(t, err)
+		assert.Equal(t, "0", value(t, f.GetBalance))
+		assert.Equal(t, new(big.Int).Add(before, ether), balanceAt(t, kit, third.Address))
+	})
+
+	t.Run("OK - Disperse unwrapped above a uint64 of wei", func(t *testing.T) {
+		before := balanceAt(t, kit, third.Address)
+		o := interactions(owner)
+		total := new(big.Int).Mul(big.NewInt(20), ether)
+		tx, err := o.Wrap(total)
+		assert.Nil(t, err)
+		mined(t, kit, tx)
+		_, err = o.DisperseUnwrapped([]common.Address{third.Address}, total)
+		assert.Nil(t, err)
+		assert.Equal(t, new(big.Int).Add(before, total), balanceAt(t, kit, third.Address))
+	})
+
+	t.Run("KO - Disperse without contract", func(t *testing.T) {
+		noDisperse := testkit.New(t).Build()
+		_, err := deploy(t, noDisperse)(noDisperse.Accounts[0]).DisperseWrapped([]common.Address{first.Address}, ether)
+		assert.ErrorContains(t, err, "disperse contract not initialized")
+	})
+}
+
+// deploy deploys the bundled WETH9 and returns a function creating its interactions for an account of the kit.
+func deploy(t *testing.T, kit *testkit.Kit) func(*testkit.Account) *weth.IWETHInteractions {
+	t.Helper()
+	address, _, _, err := WETH9.DeployWETH9(kit.Accounts[0].Auth, kit.Client())
+	assert.Nil(t, err)
+	kit.Commit()
+	return func(account *testkit.Account) *weth.IWETHInteractions {
+		t.Helper()
+		erc20Interactions, err := erc20.NewIERC20Interactions(account.Base, address, []erc20.BaseERC20Signature{}, account.Auth)
+		assert.Nil(t, err)
+		interactions, err := weth.NewIWETH(erc20Interactions, allSignatures)
+		assert.Nil(t, err)
+		return interactions
+	}
+}
+
+// mine commits a block every 10ms until the test ends, for the flows waiting for their transactions.
+func mine(t *testing.T, kit *testkit.Kit) {
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+				kit.Commit()
+			}
+		}
+	}()
+}
+
+func mined(t *testing.T, kit *testkit.Kit, txs ...*types.Transaction) {
+	t.Helper()
+	for _, tx := range txs {
+		receipt, err := bind.WaitMined(context.Background(), kit.Client(), tx)
+		assert.Nil(t, err)
+		assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	}
+}
+
+func value(t *testing.T, get func() (*big.Int, error)) string {
+	t.Helper()
+	v, err := get()
+	assert.Nil(t, err)
+	return v.String()
+}
+
+func balanceAt(t *testing.T, kit *testkit.Kit, address common.Address) *big.Int {
+	t.Helper()
+	balance, err := kit.Client().BalanceAt(context.Background(), address, nil)
+	assert.Nil(t, err)
+	return balance
+}
@@ -1,46 +1,37 @@
 package example1
 
 import (
+	"context"
 	"log"
 	"math/big"
-	"os"
 
-	"github.com/OCharless/eth-interfaces/base"
-	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/config"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/joho/godotenv"
 )
 
 func Example1() {
+	// The config may refer to the variables of the .env file, such as ${RPC_URL} or env:PRIVATE_KEY key sources
 	err := godotenv.Load()
 	if err != nil {
 		log.Fatal("failed to get environment")
 	}
 
-	// Connect to the client
-	client, err := ethclient.Dial("http://localhost:8545")
+	// Load the networks, accounts and contracts
+	cfg, err := config.Load("config.yaml")
 	if err != nil {
-		log.Fatal("Error getting the client: ", err)
+		log.Fatal("error loading the config: ", err)
 	}
-	defer client.Close()
 
-	// Get the private key from the .env file and convert it to an ECDSA key
-	privateKey, err := crypto.HexToECDSA(os.Getenv("PRIVATE_KEY"))
+	// Connect to the default network
+	session, err := cfg.Dial(context.Background(), "")
 	if err != nil {
-		log.Fatal("error reading the PRIVATE_KEY env var: ", err)
+		log.Fatal("Error getting the client: ", err)
 	}
+	defer session.Close()
 
-	// Create a new base interaction object
-	baseInteractions := base.NewBaseInteractions(client, privateKey, nil)
-
-	// Create a new ERC721 interaction object from the base interaction
-	nftInteractions, err := nft.NewERC721Interactions(
-		baseInteractions,
-		common.HexToAddress("0"),
-		[]nft.BaseNFTSignature{nft.Name, nft.Symbol, nft.TokenURI, nft.TransferFrom},
-	)
+	// Create a new ERC721 interaction object for the contract named collection, sending from the account named deployer
+	nftInteractions, err := session.ERC721("collection", "deployer")
 	if err != nil {
 		log.Fatal("error creating the NFT interactions: ", err)
 	}
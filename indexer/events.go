@@ -0,0 +1,101 @@
+package indexer
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// TransferTopic is the topic of the ERC20 and ERC721 Transfer events, told apart by their number of indexed arguments.
+	TransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// TransferSingleTopic is the topic of the ERC1155 TransferSingle event.
+	TransferSingleTopic = crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+	// TransferBatchTopic is the topic of the ERC1155 TransferBatch event.
+	TransferBatchTopic = crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+	// ConsecutiveTransferTopic is the topic of the ERC2309 ConsecutiveTransfer event.
+	ConsecutiveTransferTopic = crypto.Keccak256Hash([]byte("ConsecutiveTransfer(uint256,uint256,address,address)"))
+
+	transferTopics = []common.Hash{TransferTopic, TransferSingleTopic, TransferBatchTopic, ConsecutiveTransferTopic}
+)
+
+// MaxConsecutiveTransfer is the largest number of tokens an ERC2309 ConsecutiveTransfer event may describe, see nft.MaxConsecutiveTransfer.
+const MaxConsecutiveTransfer = nft.MaxConsecutiveTransfer
+
+var transferBatchArguments = func() abi.Arguments {
+	uint256Array, _ := abi.NewType("uint256[]", "", nil)
+	return abi.Arguments{{Name: "ids", Type: uint256Array}, {Name: "values", Type: uint256Array}}
+}()
+
+// decodeTransfer returns the balance changes described by a transfer log. Changes of the zero address, minting and burning, are left out.
+func decodeTransfer(log types.Log) ([]Delta, error) {
+	if len(log.Topics) == 0 {
+		return nil, nil
+	}
+
+	deltas := []Delta{}
+	move := func(from, to common.Address, tokenID, amount *big.Int) {
+		if from != (common.Address{}) {
+			deltas = append(deltas, Delta{log.Address, tokenID, from, new(big.Int).Neg(amount)})
+		}
+		if to != (common.Address{}) {
+			deltas = append(deltas, Delta{log.Address, tokenID, to, new(big.Int).Set(amount)})
+		}
+	}
+	address := func(topic common.Hash) common.Address {
+		return common.BytesToAddress(topic.Bytes())
+	}
+
+	switch log.Topics[0] {
+	case TransferTopic:
+		switch {
+		case len(log.Topics) == 3 && len(log.Data) >= 32:
+			move(address(log.Topics[1]), address(log.Topics[2]), nil, new(big.Int).SetBytes(log.Data[:32]))
+		case len(log.Topics) == 4:
+			move(address(log.Topics[1]), address(log.Topics[2]), log.Topics[3].Big(), big.NewInt(1))
+		default:
+			return nil, fmt.Errorf("malformed Transfer log in tx %s", log.TxHash.Hex())
+		}
+
+	case TransferSingleTopic:
+		if len(log.Topics) != 4 || len(log.Data) < 64 {
+			return nil, fmt.Errorf("malformed TransferSingle log in tx %s", log.TxHash.Hex())
+		}
+		move(address(log.Topics[2]), address(log.Topics[3]), new(big.Int).SetBytes(log.Data[:32]), new(big.Int).SetBytes(log.Data[32:64]))
+
+	case TransferBatchTopic:
+		if len(log.Topics) != 4 {
+			return nil, fmt.Errorf("malformed TransferBatch log in tx %s", log.TxHash.Hex())
+		}
+		values, err := transferBatchArguments.Unpack(log.Data)
+		if err != nil {
+			return nil, fmt.Errorf("malformed TransferBatch log in tx %s: %w", log.TxHash.Hex(), err)
+		}
+		ids, amounts := values[0].([]*big.Int), values[1].([]*big.Int)
+		if len(ids) != len(amounts) {
+			return nil, fmt.Errorf("malformed TransferBatch log in tx %s", log.TxHash.Hex())
+		}
+		for i := range ids {
+			move(address(log.Topics[2]), address(log.Topics[3]), ids[i], amounts[i])
+		}
+
+	case ConsecutiveTransferTopic:
+		if len(log.Topics) != 4 || len(log.Data) < 32 {
+			return nil, fmt.Errorf("malformed ConsecutiveTransfer log in tx %s", log.TxHash.Hex())
+		}
+		from, to := log.Topics[1].Big(), new(big.Int).SetBytes(log.Data[:32])
+		size := new(big.Int).Sub(to, from)
+		if size.Sign() < 0 || size.Cmp(big.NewInt(MaxConsecutiveTransfer)) >= 0 {
+			return nil, fmt.Errorf("malformed ConsecutiveTransfer log in tx %s: range %s to %s exceeds %d tokens", log.TxHash.Hex(), from, to, MaxConsecutiveTransfer)
+		}
+		for id := new(big.Int).Set(from); id.Cmp(to) <= 0; id.Add(id, common.Big1) {
+			move(address(log.Topics[2]), address(log.Topics[3]), new(big.Int).Set(id), big.NewInt(1))
+		}
+	}
+	return deltas, nil
+}
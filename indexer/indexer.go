@@ -0,0 +1,302 @@
+package indexer
+
+// Package indexer backfills and follows the transfer logs of token contracts to maintain owner and balance tables,
+// answering the ownership queries that contracts without the Enumerable extension cannot.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Config configures the indexed contracts and how the chain is followed.
+type Config struct {
+	// Contracts lists the indexed token contracts.
+	Contracts []common.Address
+	// StartBlock is the first indexed block, usually the block the contracts were deployed in.
+	StartBlock uint64
+	// BatchSize is the number of blocks fetched per log query.
+	BatchSize uint64
+	// Confirmations is the number of blocks the indexer stays behind the head.
+	Confirmations uint64
+	// KeepBlocks is the number of blocks journaled to roll back reorgs. A number larger than the chain keeps every block.
+	KeepBlocks uint64
+	// PollInterval is the delay between two syncs of Run.
+	PollInterval time.Duration
+}
+
+// DefaultConfig fetches 2000 blocks per query, follows the head and journals the last 128 blocks.
+var DefaultConfig = Config{
+	BatchSize:    2000,
+	KeepBlocks:   128,
+	PollInterval: 12 * time.Second,
+}
+
+// Indexer maintains the balances of the configured contracts in a Storage.
+type Indexer struct {
+	*base.BaseInteractions
+	storage Storage
+	config  Config
+}
+
+// NewIndexer creates a new Indexer. The batch size, the journaled blocks and the poll interval default to those of DefaultConfig when zero.
+func NewIndexer(baseInteractions *base.BaseInteractions, storage Storage, config Config) *Indexer {
+	if config.BatchSize == 0 {
+		config.BatchSize = DefaultConfig.BatchSize
+	}
+	if config.KeepBlocks == 0 {
+		config.KeepBlocks = DefaultConfig.KeepBlocks
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultConfig.PollInterval
+	}
+	return &Indexer{baseInteractions, storage, config}
+}
+
+// Storage returns the storage the indexer writes to.
+func (i *Indexer) Storage() Storage {
+	return i.storage
+}
+
+// Run syncs the indexer every PollInterval until ctx is done.
+func (i *Indexer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(i.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := i.Sync(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync rolls back reorged blocks, then indexes the blocks up to the head minus the confirmations. It returns the new cursor.
+func (i *Indexer) Sync(ctx context.Context) (*Cursor, error) {
+	cursor, err := i.storage.Cursor()
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil {
+		cursor, err = i.rollback(ctx, cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	head, err := base.Observe(i.BaseInteractions, base.ReadOperation, "indexer.BlockNumber()", func() (uint64, error) {
+		return i.Client.BlockNumber(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block number: %w", err)
+	}
+	if head < i.config.Confirmations {
+		return cursor, nil
+	}
+	head -= i.config.Confirmations
+
+	from := i.config.StartBlock
+	final := i.config.StartBlock
+	if cursor != nil {
+		from, final = cursor.Number+1, cursor.Final
+	}
+	for from <= head {
+		to := min(from+i.config.BatchSize-1, head)
+		if err := i.indexRange(ctx, from, to); err != nil {
+			return nil, err
+		}
+
+		header, err := i.headerByNumber(ctx, to)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get header %d: %w", to, err)
+		}
+		if to >= i.config.KeepBlocks && to-i.config.KeepBlocks > final {
+			final = to - i.config.KeepBlocks
+			if err := i.storage.Prune(final); err != nil {
+				return nil, err
+			}
+		}
+		cursor = &Cursor{Number: to, Hash: header.Hash(), Final: final}
+		if err := i.storage.SetCursor(*cursor); err != nil {
+			return nil, err
+		}
+		from = to + 1
+	}
+	return cursor, nil
+}
+
+// rollback reverts the journaled blocks that are no longer part of the chain and moves the cursor back to the last block still known valid.
+// Blocks below the final block of the cursor are trusted. A nil cursor means indexing starts over from StartBlock.
+func (i *Indexer) rollback(ctx context.Context, cursor *Cursor) (*Cursor, error) {
+	last, err := i.storage.LastBlock()
+	if err != nil {
+		return nil, err
+	}
+	valid, err := i.isCanonical(ctx, cursor.Number, cursor.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if valid && last != nil {
+		valid, err = i.isCanonical(ctx, last.Number, last.Hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if valid {
+		return cursor, nil
+	}
+
+	// Every block above the last valid journaled block is indexed again.
+	resume := cursor.Final
+	for last != nil {
+		canonical, err := i.isCanonical(ctx, last.Number, last.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if canonical {
+			resume = last.Number + 1
+			break
+		}
+		if _, err := i.storage.RevertBlock(); err != nil {
+			return nil, err
+		}
+		if last, err = i.storage.LastBlock(); err != nil {
+			return nil, err
+		}
+	}
+	if resume <= i.config.StartBlock {
+		return nil, nil
+	}
+	header, err := i.headerByNumber(ctx, resume-1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get header %d: %w", resume-1, err)
+	}
+	rewound := &Cursor{Number: resume - 1, Hash: header.Hash(), Final: cursor.Final}
+	return rewound, i.storage.SetCursor(*rewound)
+}
+
+// isCanonical tells whether the block with the given number has the given hash.
+func (i *Indexer) isCanonical(ctx context.Context, number uint64, hash common.Hash) (bool, error) {
+	header, err := i.headerByNumber(ctx, number)
+	if errors.Is(err, ethereum.NotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get header %d: %w", number, err)
+	}
+	return header.Hash() == hash, nil
+}
+
+// headerByNumber reads the header of a block through Observe.
+func (i *Indexer) headerByNumber(ctx context.Context, number uint64) (*types.Header, error) {
+	return base.Observe(i.BaseInteractions, base.ReadOperation, "indexer.HeaderByNumber()", func() (*types.Header, error) {
+		return i.Client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	})
+}
+
+// indexRange applies the transfer logs of the configured contracts between two blocks, both included.
+func (i *Indexer) indexRange(ctx context.Context, from, to uint64) error {
+	logs, err := base.Observe(i.BaseInteractions, base.ReadOperation, "indexer.FilterLogs()", func() ([]types.Log, error) {
+		return i.Client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: i.config.Contracts,
+			Topics:    [][]common.Hash{transferTopics},
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to filter logs from %d to %d: %w", from, to, err)
+	}
+
+	var block *Block
+	for _, log := range logs {
+		if block != nil && block.Number != log.BlockNumber {
+			if err := i.storage.ApplyBlock(block); err != nil {
+				return err
+			}
+			block = nil
+		}
+		if block == nil {
+			block = &Block{Number: log.BlockNumber, Hash: log.BlockHash, Deltas: []Delta{}}
+		}
+		deltas, err := decodeTransfer(log)
+		if err != nil {
+			return err
+		}
+		block.Deltas = append(block.Deltas, deltas...)
+	}
+	if block != nil {
+		return i.storage.ApplyBlock(block)
+	}
+	return nil
+}
+
+// OwnerOf returns the owner of a non-fungible token, the zero address when it is not owned by a single holder.
+func (i *Indexer) OwnerOf(contract common.Address, tokenID *big.Int) (common.Address, error) {
+	holders, err := i.storage.Holders(contract, tokenID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	owner := common.Address{}
+	for _, holding := range holders {
+		if holding.Amount.Sign() > 0 {
+			if owner != (common.Address{}) {
+				return common.Address{}, nil
+			}
+			owner = holding.Holder
+		}
+	}
+	return owner, nil
+}
+
+// TokensOf returns the ids of the tokens of a contract owned by a holder.
+func (i *Indexer) TokensOf(contract, owner common.Address) ([]*big.Int, error) {
+	holdings, err := i.storage.Holdings(contract, owner)
+	if err != nil {
+		return nil, err
+	}
+	tokenIDs := []*big.Int{}
+	for _, holding := range holdings {
+		if holding.TokenID != nil && holding.Amount.Sign() > 0 {
+			tokenIDs = append(tokenIDs, holding.TokenID)
+		}
+	}
+	return tokenIDs, nil
+}
+
+// BalanceOf returns the indexed balance of a holder, tokenID is nil for fungible tokens.
+func (i *Indexer) BalanceOf(contract common.Address, tokenID *big.Int, holder common.Address) (*big.Int, error) {
+	return i.storage.Balance(contract, tokenID, holder)
+}
+
+// HoldersOf returns the holders of a fungible token with a positive balance.
+func (i *Indexer) HoldersOf(contract common.Address) (map[common.Address]*big.Int, error) {
+	holdings, err := i.storage.Holders(contract, nil)
+	if err != nil {
+		return nil, err
+	}
+	holders := map[common.Address]*big.Int{}
+	for _, holding := range holdings {
+		if holding.Amount.Sign() > 0 {
+			holders[holding.Holder] = holding.Amount
+		}
+	}
+	return holders, nil
+}
+
+var (
+	_ nft.TokenIndex    = (*Indexer)(nil)
+	_ erc20.HolderIndex = (*Indexer)(nil)
+)
@@ -0,0 +1,307 @@
+package indexer_test
+
+// Package indexer_test contains tests for the transfer-log indexer and its storages.
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/erc1155"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/indexer"
+	"github.com/OCharless/eth-interfaces/inferences/ERC1155Complete"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/OCharless/eth-interfaces/inferences/ERC721Complete"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/testkit"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	recipient = common.HexToAddress("0x000000000000000000000000000000000000beef")
+	ether     = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+)
+
+func ethers(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), ether)
+}
+
+// Test_Sync verifies the balances built from the transfer logs of every supported standard.
+func Test_Sync(t *testing.T) {
+	backend, auth, erc20Addr, privKey, err := utils.SetupBlockchain(t,
+		ERC20Burnable.ERC20BurnableABI,
+		ERC20Burnable.ERC20BurnableBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	erc721Addr, _, _, err := utils.DeployContract(auth, backend.Client(), ERC721Complete.ERC721CompleteABI, ERC721Complete.ERC721CompleteBin, "MyNFT", "MNFT")
+	assert.Nil(t, err)
+	erc1155Addr, _, erc1155Contract, err := utils.DeployContract(auth, backend.Client(), ERC1155Complete.ERC1155CompleteABI, ERC1155Complete.ERC1155CompleteBin)
+	assert.Nil(t, err)
+	backend.Commit()
+
+	_, err = erc1155Contract.Transact(auth, "mint", auth.From, big.NewInt(7), big.NewInt(10))
+	assert.Nil(t, err)
+	_, err = erc1155Contract.Transact(auth, "mint", auth.From, big.NewInt(8), big.NewInt(5))
+	assert.Nil(t, err)
+	backend.Commit()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	token, err := erc20.NewIERC20Interactions(baseInteractions, *erc20Addr, []erc20.BaseERC20Signature{}, auth)
+	assert.Nil(t, err)
+	_, err = token.TransferTo(recipient, ethers(2))
+	assert.Nil(t, err)
+	collection, err := nft.NewERC721Interactions(baseInteractions, erc721Addr, []nft.BaseNFTSignature{}, auth)
+	assert.Nil(t, err)
+	_, err = collection.TransferTo(recipient, big.NewInt(4))
+	assert.Nil(t, err)
+	multi, err := erc1155.NewERC1155Interactions(baseInteractions, erc1155Addr, []erc1155.BaseERC1155Signature{}, auth)
+	assert.Nil(t, err)
+	_, err = multi.BatchTransferTo(recipient, []*big.Int{big.NewInt(7), big.NewInt(8)}, []*big.Int{big.NewInt(3), big.NewInt(5)})
+	assert.Nil(t, err)
+	backend.Commit()
+
+	config := indexer.DefaultConfig
+	config.Contracts = []common.Address{*erc20Addr, erc721Addr, erc1155Addr}
+	config.BatchSize = 2
+	idx := indexer.NewIndexer(baseInteractions, indexer.NewMemoryStorage(), config)
+	cursor, err := idx.Sync(context.Background())
+	assert.Nil(t, err)
+	head, err := backend.Client().BlockNumber(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, head, cursor.Number)
+
+	t.Run("OK - ERC20 holders", func(t *testing.T) {
+		holders, err := idx.HoldersOf(*erc20Addr)
+		assert.Nil(t, err)
+		assert.Len(t, holders, 2)
+		assert.Equal(t, ethers(2), holders[recipient])
+		assert.Equal(t, ethers(99_999_998), holders[auth.From])
+	})
+
+	t.Run("OK - ERC721 owners", func(t *testing.T) {
+		tokenIDs, err := idx.TokensOf(erc721Addr, recipient)
+		assert.Nil(t, err)
+		assert.Equal(t, "[4]", fmt.Sprint(tokenIDs))
+
+		tokenIDs, err = idx.TokensOf(erc721Addr, auth.From)
+		assert.Nil(t, err)
+		assert.Len(t, tokenIDs, 29)
+		assert.Equal(t, "0", tokenIDs[0].String())
+
+		owner, err := idx.OwnerOf(erc721Addr, big.NewInt(4))
+		assert.Nil(t, err)
+		assert.Equal(t, recipient, owner)
+		owner, err = idx.OwnerOf(erc721Addr, big.NewInt(30))
+		assert.Nil(t, err)
+		assert.Equal(t, common.Address{}, owner)
+	})
+
+	t.Run("OK - ERC1155 balances", func(t *testing.T) {
+		testCases := []struct {
+			Name    string
+			Holder  common.Address
+			TokenID int64
+			Balance string
+		}{
+			{"Deployer id 7", auth.From, 7, "7"},
+			{"Deployer id 8", auth.From, 8, "0"},
+			{"Recipient id 7", recipient, 7, "3"},
+			{"Recipient id 8", recipient, 8, "5"},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.Name, func(t *testing.T) {
+				balance, err := idx.BalanceOf(erc1155Addr, big.NewInt(tc.TokenID), tc.Holder)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.Balance, balance.String())
+			})
+		}
+
+		tokenIDs, err := idx.TokensOf(erc1155Addr, auth.From)
+		assert.Nil(t, err)
+		assert.Equal(t, "[7]", fmt.Sprint(tokenIDs))
+	})
+
+	t.Run("OK - Interactions through the index", func(t *testing.T) {
+		_, err := collection.OwnedTokensFromIndex(recipient)
+		assert.Error(t, err)
+		collection.SetTokenIndex(idx)
+		tokenIDs, err := collection.OwnedTokensFromIndex(recipient)
+		assert.Nil(t, err)
+		assert.Equal(t, "[4]", fmt.Sprint(tokenIDs))
+
+		_, err = token.Holders()
+		assert.Error(t, err)
+		token.SetHolderIndex(idx)
+		holders, err := token.Holders()
+		assert.Nil(t, err)
+		assert.Equal(t, ethers(2), holders[recipient])
+	})
+
+	t.Run("OK - Idempotent sync", func(t *testing.T) {
+		again, err := idx.Sync(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, cursor, again)
+		balance, err := idx.BalanceOf(*erc20Addr, nil, recipient)
+		assert.Nil(t, err)
+		assert.Equal(t, ethers(2), balance)
+	})
+
+	t.Run("OK - Run with the default poll interval", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+		idx := indexer.NewIndexer(baseInteractions, indexer.NewMemoryStorage(), indexer.Config{Contracts: config.Contracts})
+		assert.ErrorIs(t, idx.Run(ctx), context.DeadlineExceeded)
+		balance, err := idx.BalanceOf(*erc20Addr, nil, recipient)
+		assert.Nil(t, err)
+		assert.Equal(t, ethers(2), balance)
+	})
+}
+
+// Test_Reorg verifies that the blocks dropped by a reorg are rolled back before indexing the new chain.
+func Test_Reorg(t *testing.T) {
+	backend, auth, erc20Addr, privKey, err := utils.SetupBlockchain(t,
+		ERC20Burnable.ERC20BurnableABI,
+		ERC20Burnable.ERC20BurnableBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	token, err := erc20.NewIERC20Interactions(baseInteractions, *erc20Addr, []erc20.BaseERC20Signature{}, auth)
+	assert.Nil(t, err)
+	parent, err := backend.Client().HeaderByNumber(context.Background(), nil)
+	assert.Nil(t, err)
+
+	_, err = token.TransferTo(recipient, ethers(2))
+	assert.Nil(t, err)
+	dropped := backend.Commit()
+
+	config := indexer.DefaultConfig
+	config.Contracts = []common.Address{*erc20Addr}
+	idx := indexer.NewIndexer(baseInteractions, indexer.NewMemoryStorage(), config)
+	_, err = idx.Sync(context.Background())
+	assert.Nil(t, err)
+	last, err := idx.Storage().LastBlock()
+	assert.Nil(t, err)
+	assert.Equal(t, dropped, last.Hash)
+
+	// The transfer is sent again on the side chain, which becomes canonical once longer.
+	assert.Nil(t, backend.Fork(parent.Hash()))
+	backend.Commit()
+	backend.Commit()
+	head, err := backend.Client().HeaderByNumber(context.Background(), nil)
+	assert.Nil(t, err)
+
+	cursor, err := idx.Sync(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, head.Hash(), cursor.Hash)
+	last, err = idx.Storage().LastBlock()
+	assert.Nil(t, err)
+	assert.NotEqual(t, dropped, last.Hash)
+
+	balance, err := idx.BalanceOf(*erc20Addr, nil, recipient)
+	assert.Nil(t, err)
+	onChain, err := token.BalanceOf(recipient)
+	assert.Nil(t, err)
+	assert.Equal(t, onChain, balance)
+}
+
+// Test_ConsecutiveTransferLimit verifies that a ConsecutiveTransfer log beyond the ERC2309 limit is rejected instead of being expanded.
+func Test_ConsecutiveTransferLimit(t *testing.T) {
+	// The contract emits ConsecutiveTransfer(0, 1e9, address(0), address(1)) when called.
+	code := append([]byte{0x63, 0x3b, 0x9a, 0xca, 0x00, 0x60, 0x00, 0x52, 0x60, 0x01, 0x60, 0x00, 0x60, 0x00, 0x7f}, indexer.ConsecutiveTransferTopic.Bytes()...)
+	code = append(code, 0x60, 0x20, 0x60, 0x00, 0xa4, 0x00)
+	contract := common.HexToAddress("0x0000000000000000000000000000000000002309")
+	kit := testkit.New(t).WithState(types.GenesisAlloc{contract: {Code: code, Balance: new(big.Int)}}).Build()
+	owner := kit.Accounts[0]
+
+	_, err := bind.NewBoundContract(contract, abi.ABI{}, kit.Client(), kit.Client(), kit.Client()).Transfer(owner.Auth)
+	assert.Nil(t, err)
+	kit.Commit()
+
+	idx := indexer.NewIndexer(owner.Base, indexer.NewMemoryStorage(), indexer.Config{Contracts: []common.Address{contract}})
+	_, err = idx.Sync(context.Background())
+	assert.ErrorContains(t, err, "exceeds 5000 tokens")
+}
+
+// Test_FileStorage verifies that the balances and the cursor survive reopening the database.
+func Test_FileStorage(t *testing.T) {
+	path := t.TempDir()
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000123")
+
+	storage, err := indexer.NewFileStorage(path)
+	assert.Nil(t, err)
+	assert.Nil(t, storage.ApplyBlock(&indexer.Block{Number: 5, Deltas: []indexer.Delta{
+		{Contract: contract, Holder: recipient, Amount: big.NewInt(40)},
+		{Contract: contract, TokenID: big.NewInt(1), Holder: recipient, Amount: big.NewInt(1)},
+	}}))
+	assert.Nil(t, storage.ApplyBlock(&indexer.Block{Number: 6, Deltas: []indexer.Delta{
+		{Contract: contract, Holder: recipient, Amount: big.NewInt(-15)},
+	}}))
+	assert.Nil(t, storage.SetCursor(indexer.Cursor{Number: 6, Final: 2}))
+	assert.Nil(t, storage.Close())
+
+	storage, err = indexer.NewFileStorage(path)
+	assert.Nil(t, err)
+	defer storage.Close()
+
+	cursor, err := storage.Cursor()
+	assert.Nil(t, err)
+	assert.Equal(t, &indexer.Cursor{Number: 6, Final: 2}, cursor)
+	balance, err := storage.Balance(contract, nil, recipient)
+	assert.Nil(t, err)
+	assert.Equal(t, "25", balance.String())
+
+	t.Run("OK - Revert", func(t *testing.T) {
+		reverted, err := storage.RevertBlock()
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(6), reverted.Number)
+		balance, err := storage.Balance(contract, nil, recipient)
+		assert.Nil(t, err)
+		assert.Equal(t, "40", balance.String())
+		last, err := storage.LastBlock()
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(5), last.Number)
+	})
+
+	t.Run("OK - Prune", func(t *testing.T) {
+		assert.Nil(t, storage.Prune(6))
+		last, err := storage.LastBlock()
+		assert.Nil(t, err)
+		assert.Nil(t, last)
+		holdings, err := storage.Holdings(contract, recipient)
+		assert.Nil(t, err)
+		assert.Len(t, holdings, 2)
+		assert.Nil(t, holdings[0].TokenID)
+		assert.Equal(t, "1", holdings[1].TokenID.String())
+	})
+
+	t.Run("OK - Revert after prune", func(t *testing.T) {
+		for _, number := range []uint64{7, 9, 12} {
+			assert.Nil(t, storage.ApplyBlock(&indexer.Block{Number: number, Deltas: []indexer.Delta{}}))
+		}
+		assert.Nil(t, storage.Prune(9))
+		for _, number := range []uint64{12, 9} {
+			last, err := storage.LastBlock()
+			assert.Nil(t, err)
+			assert.Equal(t, number, last.Number)
+			reverted, err := storage.RevertBlock()
+			assert.Nil(t, err)
+			assert.Equal(t, number, reverted.Number)
+		}
+		last, err := storage.LastBlock()
+		assert.Nil(t, err)
+		assert.Nil(t, last)
+	})
+}
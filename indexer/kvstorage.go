@@ -0,0 +1,385 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+)
+
+// Key layout of the key-value storage:
+// - cursorKey: JSON encoded cursor
+// - lastKey: number of the most recent journaled block
+// - blockPrefix + number: JSON encoded journaled block
+// - previousPrefix + number: number of the journaled block before it, absent for the oldest one
+// - tokenPrefix + contract + token + holder: balance, indexed by token
+// - holderPrefix + contract + holder + token: balance, indexed by holder
+// where token is 0x00 for fungible tokens or 0x01 followed by the 32 bytes token id.
+var (
+	cursorKey      = []byte("cursor")
+	lastKey        = []byte("last")
+	blockPrefix    = []byte("b")
+	previousPrefix = []byte("p")
+	tokenPrefix    = []byte("t")
+	holderPrefix   = []byte("h")
+)
+
+// KVStorage is a Storage backed by a key-value database.
+type KVStorage struct {
+	mu sync.RWMutex
+	db ethdb.KeyValueStore
+}
+
+// NewKVStorage creates a new storage on top of a key-value database.
+func NewKVStorage(db ethdb.KeyValueStore) *KVStorage {
+	return &KVStorage{db: db}
+}
+
+// NewMemoryStorage creates a new in-memory storage.
+func NewMemoryStorage() *KVStorage {
+	return NewKVStorage(memorydb.New())
+}
+
+// NewFileStorage opens, or creates, a storage persisted in a LevelDB database at the given path.
+func NewFileStorage(path string) (*KVStorage, error) {
+	db, err := leveldb.New(path, 16, 16, "", false)
+	if err != nil {
+		return nil, err
+	}
+	return NewKVStorage(db), nil
+}
+
+// Cursor returns the last processed block, nil when nothing was indexed yet.
+func (s *KVStorage) Cursor() (*Cursor, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cursor := &Cursor{}
+	found, err := s.getJSON(cursorKey, cursor)
+	if err != nil || !found {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// SetCursor records the last processed block.
+func (s *KVStorage) SetCursor(cursor Cursor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	encoded, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+	return s.db.Put(cursorKey, encoded)
+}
+
+// LastBlock returns the most recent journaled block, nil when the journal is empty.
+func (s *KVStorage) LastBlock() (*Block, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastBlock()
+}
+
+// ApplyBlock adds the deltas of a block to the balances and journals it. Blocks at or below LastBlock are ignored.
+func (s *KVStorage) ApplyBlock(block *Block) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last, found, err := s.number(lastKey)
+	if err != nil {
+		return err
+	}
+	if found && block.Number <= last {
+		return nil
+	}
+
+	encoded, err := json.Marshal(block)
+	if err != nil {
+		return err
+	}
+	batch := s.db.NewBatch()
+	if err := batch.Put(blockKey(block.Number), encoded); err != nil {
+		return err
+	}
+	if found {
+		if err := batch.Put(previousKey(block.Number), binary.BigEndian.AppendUint64(nil, last)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Put(lastKey, binary.BigEndian.AppendUint64(nil, block.Number)); err != nil {
+		return err
+	}
+	if err := s.addDeltas(batch, block.Deltas, false); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// RevertBlock subtracts the deltas of the most recent journaled block and removes it from the journal.
+func (s *KVStorage) RevertBlock() (*Block, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	block, err := s.lastBlock()
+	if err != nil || block == nil {
+		return nil, err
+	}
+	batch := s.db.NewBatch()
+	if err := batch.Delete(blockKey(block.Number)); err != nil {
+		return nil, err
+	}
+	previous, found, err := s.number(previousKey(block.Number))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		if err := batch.Delete(lastKey); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := batch.Delete(previousKey(block.Number)); err != nil {
+			return nil, err
+		}
+		if err := batch.Put(lastKey, binary.BigEndian.AppendUint64(nil, previous)); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.addDeltas(batch, block.Deltas, true); err != nil {
+		return nil, err
+	}
+	return block, batch.Write()
+}
+
+// Prune removes the journal of the blocks below the given number.
+func (s *KVStorage) Prune(before uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	batch := s.db.NewBatch()
+	iterator := s.db.NewIterator(blockPrefix, nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		number := binary.BigEndian.Uint64(iterator.Key()[len(blockPrefix):])
+		// The oldest block kept loses the link to the pruned ones.
+		if err := batch.Delete(previousKey(number)); err != nil {
+			return err
+		}
+		if number >= before {
+			break
+		}
+		if err := batch.Delete(common.CopyBytes(iterator.Key())); err != nil {
+			return err
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return err
+	}
+	if last, found, err := s.number(lastKey); err != nil {
+		return err
+	} else if found && last < before {
+		if err := batch.Delete(lastKey); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// Balance returns the balance of a holder, tokenID is nil for fungible tokens.
+func (s *KVStorage) Balance(contract common.Address, tokenID *big.Int, holder common.Address) (*big.Int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.balance(tokenKey(contract, tokenID, holder))
+}
+
+// Holders returns the non-zero holdings of a token, tokenID is nil for fungible tokens.
+func (s *KVStorage) Holders(contract common.Address, tokenID *big.Int) ([]Holding, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	prefix := append(append(common.CopyBytes(tokenPrefix), contract.Bytes()...), tokenPart(tokenID)...)
+	holdings := []Holding{}
+	iterator := s.db.NewIterator(prefix, nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		holdings = append(holdings, Holding{
+			Contract: contract,
+			TokenID:  tokenID,
+			Holder:   common.BytesToAddress(iterator.Key()[len(prefix):]),
+			Amount:   decodeAmount(iterator.Value()),
+		})
+	}
+	return holdings, iterator.Error()
+}
+
+// Holdings returns the non-zero holdings of a holder for a contract, ordered by token id.
+func (s *KVStorage) Holdings(contract, holder common.Address) ([]Holding, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	prefix := append(append(common.CopyBytes(holderPrefix), contract.Bytes()...), holder.Bytes()...)
+	holdings := []Holding{}
+	iterator := s.db.NewIterator(prefix, nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		holding := Holding{Contract: contract, Holder: holder, Amount: decodeAmount(iterator.Value())}
+		if token := iterator.Key()[len(prefix):]; token[0] == 1 {
+			holding.TokenID = new(big.Int).SetBytes(token[1:])
+		}
+		holdings = append(holdings, holding)
+	}
+	return holdings, iterator.Error()
+}
+
+// Close closes the underlying database.
+func (s *KVStorage) Close() error {
+	return s.db.Close()
+}
+
+func (s *KVStorage) lastBlock() (*Block, error) {
+	last, found, err := s.number(lastKey)
+	if err != nil || !found {
+		return nil, err
+	}
+	block := &Block{}
+	if _, err := s.getJSON(blockKey(last), block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// number reads a block number stored at key.
+func (s *KVStorage) number(key []byte) (uint64, bool, error) {
+	found, err := s.db.Has(key)
+	if err != nil || !found {
+		return 0, false, err
+	}
+	encoded, err := s.db.Get(key)
+	if err != nil {
+		return 0, false, err
+	}
+	return binary.BigEndian.Uint64(encoded), true, nil
+}
+
+// addDeltas writes the balances updated by the deltas, or by their opposite when reverting, into the batch.
+func (s *KVStorage) addDeltas(batch ethdb.Batch, deltas []Delta, revert bool) error {
+	type balanceKey struct {
+		contract common.Address
+		token    string
+		holder   common.Address
+	}
+	sums := map[balanceKey]*big.Int{}
+	tokenIDs := map[string]*big.Int{}
+	order := []balanceKey{}
+	for _, delta := range deltas {
+		key := balanceKey{delta.Contract, string(tokenPart(delta.TokenID)), delta.Holder}
+		if _, ok := sums[key]; !ok {
+			sums[key] = new(big.Int)
+			tokenIDs[key.token] = delta.TokenID
+			order = append(order, key)
+		}
+		if revert {
+			sums[key].Sub(sums[key], delta.Amount)
+		} else {
+			sums[key].Add(sums[key], delta.Amount)
+		}
+	}
+
+	for _, key := range order {
+		tokenID := tokenIDs[key.token]
+		byToken := tokenKey(key.contract, tokenID, key.holder)
+		byHolder := holderKey(key.contract, key.holder, tokenID)
+		balance, err := s.balance(byToken)
+		if err != nil {
+			return err
+		}
+		balance.Add(balance, sums[key])
+		if balance.Sign() == 0 {
+			if err := batch.Delete(byToken); err != nil {
+				return err
+			}
+			if err := batch.Delete(byHolder); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := batch.Put(byToken, encodeAmount(balance)); err != nil {
+			return err
+		}
+		if err := batch.Put(byHolder, encodeAmount(balance)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *KVStorage) balance(key []byte) (*big.Int, error) {
+	found, err := s.db.Has(key)
+	if err != nil || !found {
+		return new(big.Int), err
+	}
+	value, err := s.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	return decodeAmount(value), nil
+}
+
+func (s *KVStorage) getJSON(key []byte, value any) (bool, error) {
+	found, err := s.db.Has(key)
+	if err != nil || !found {
+		return false, err
+	}
+	encoded, err := s.db.Get(key)
+	if err != nil {
+		return false, err
+	}
+	return true, json.Unmarshal(encoded, value)
+}
+
+func blockKey(number uint64) []byte {
+	return binary.BigEndian.AppendUint64(common.CopyBytes(blockPrefix), number)
+}
+
+func previousKey(number uint64) []byte {
+	return binary.BigEndian.AppendUint64(common.CopyBytes(previousPrefix), number)
+}
+
+func tokenPart(tokenID *big.Int) []byte {
+	if tokenID == nil {
+		return []byte{0}
+	}
+	return append([]byte{1}, common.BigToHash(tokenID).Bytes()...)
+}
+
+func tokenKey(contract common.Address, tokenID *big.Int, holder common.Address) []byte {
+	return bytes.Join([][]byte{tokenPrefix, contract.Bytes(), tokenPart(tokenID), holder.Bytes()}, nil)
+}
+
+func holderKey(contract, holder common.Address, tokenID *big.Int) []byte {
+	return bytes.Join([][]byte{holderPrefix, contract.Bytes(), holder.Bytes(), tokenPart(tokenID)}, nil)
+}
+
+// encodeAmount encodes a signed amount as a sign byte followed by its absolute value.
+// Balances may be negative when indexing starts after the contract deployment.
+func encodeAmount(amount *big.Int) []byte {
+	sign := byte(0)
+	if amount.Sign() < 0 {
+		sign = 1
+	}
+	return append([]byte{sign}, new(big.Int).Abs(amount).Bytes()...)
+}
+
+func decodeAmount(encoded []byte) *big.Int {
+	amount := new(big.Int).SetBytes(encoded[1:])
+	if encoded[0] == 1 {
+		amount.Neg(amount)
+	}
+	return amount
+}
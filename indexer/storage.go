@@ -0,0 +1,62 @@
+package indexer
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Cursor is the last block the indexer processed. Blocks below Final are considered final and their journal may be pruned.
+type Cursor struct {
+	Number uint64      `json:"number"`
+	Hash   common.Hash `json:"hash"`
+	Final  uint64      `json:"final"`
+}
+
+// Delta is a balance change of a holder. TokenID is nil for fungible tokens.
+type Delta struct {
+	Contract common.Address `json:"contract"`
+	TokenID  *big.Int       `json:"tokenId,omitempty"`
+	Holder   common.Address `json:"holder"`
+	Amount   *big.Int       `json:"amount"`
+}
+
+// Block is the journal of the balance changes applied for a block, kept to roll them back on reorgs.
+type Block struct {
+	Number uint64      `json:"number"`
+	Hash   common.Hash `json:"hash"`
+	Deltas []Delta     `json:"deltas"`
+}
+
+// Holding is the balance of a holder for a contract. TokenID is nil for fungible tokens.
+type Holding struct {
+	Contract common.Address
+	TokenID  *big.Int
+	Holder   common.Address
+	Amount   *big.Int
+}
+
+// Storage persists the balances built by the indexer along with the journal of the recent blocks.
+// Implementations must be safe for concurrent use and apply or revert each block atomically.
+type Storage interface {
+	// Cursor returns the last processed block, nil when nothing was indexed yet.
+	Cursor() (*Cursor, error)
+	// SetCursor records the last processed block.
+	SetCursor(cursor Cursor) error
+	// LastBlock returns the most recent journaled block, nil when the journal is empty.
+	LastBlock() (*Block, error)
+	// ApplyBlock adds the deltas of a block to the balances and journals it. Blocks at or below LastBlock are ignored.
+	ApplyBlock(block *Block) error
+	// RevertBlock subtracts the deltas of the most recent journaled block and removes it from the journal.
+	RevertBlock() (*Block, error)
+	// Prune removes the journal of the blocks below the given number.
+	Prune(before uint64) error
+	// Balance returns the balance of a holder, tokenID is nil for fungible tokens.
+	Balance(contract common.Address, tokenID *big.Int, holder common.Address) (*big.Int, error)
+	// Holders returns the non-zero holdings of a token, tokenID is nil for fungible tokens.
+	Holders(contract common.Address, tokenID *big.Int) ([]Holding, error)
+	// Holdings returns the non-zero holdings of a holder for a contract, ordered by token id.
+	Holdings(contract, holder common.Address) ([]Holding, error)
+	// Close releases the storage.
+	Close() error
+}
@@ -0,0 +1,1069 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ERC1155Complete
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ERC1155CompleteMetaData contains all meta data concerning the ERC1155Complete contract.
+var ERC1155CompleteMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ERC1155InsufficientBalance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"}],\"name\":\"ERC1155InvalidApprover\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"idsLength\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"valuesLength\",\"type\":\"uint256\"}],\"name\":\"ERC1155InvalidArrayLength\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"ERC1155InvalidOperator\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC1155InvalidReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"ERC1155InvalidSender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"ERC1155MissingApprovalForAll\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"TransferBatch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"TransferSingle\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"URI\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"accounts\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"}],\"name\":\"balanceOfBatch\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeBatchTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"uri\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Bin: "0x608060405234801561001057600080fd5b5060408051808201909152601081526f697066733a2f2f6d6574612f7b69647d60801b602082015261004181610047565b506101b4565b600261005382826100f6565b5050565b634e487b7160e01b600052604160045260246000fd5b600181811c9082168061008157607f821691505b6020821081036100a157634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156100f157806000526020600020601f840160051c810160208510156100ce5750805b601f840160051c820191505b818110156100ee57600081556001016100da565b50505b505050565b81516001600160401b0381111561010f5761010f610057565b6101238161011d845461006d565b846100a7565b6020601f821160018114610157576000831561013f5750848201515b600019600385901b1c1916600184901b1784556100ee565b600084815260208120601f198516915b828110156101875787850151825560209485019460019092019101610167565b50848210156101a55786840151600019600387901b60f8161c191681555b50505050600190811b01905550565b611179806101c36000396000f3fe608060405234801561001057600080fd5b50600436106100925760003560e01c80632eb2c2d6116100665780632eb2c2d6146101155780634e1273f414610128578063a22cb46514610148578063e985e9c51461015b578063f242432a1461016e57600080fd5b8062fdd58e1461009757806301ffc9a7146100bd5780630e89341c146100e0578063156e29f614610100575b600080fd5b6100aa6100a5366004610afa565b610181565b6040519081526020015b60405180910390f35b6100d06100cb366004610b3d565b6101a9565b60405190151581526020016100b4565b6100f36100ee366004610b61565b6101f9565b6040516100b49190610bc0565b61011361010e366004610bd3565b61028d565b005b610113610123366004610d4e565b6102ad565b61013b610136366004610e04565b610319565b6040516100b49190610f09565b610113610156366004610f1c565b6103e6565b6100d0610169366004610f58565b6103f5565b61011361017c366004610f8b565b610423565b6000818152602081815260408083206001600160a01b03861684529091529020545b92915050565b60006001600160e01b03198216636cdb3d1360e11b14806101da57506001600160e01b031982166303a24d0760e21b145b806101a357506301ffc9a760e01b6001600160e01b03198316146101a3565b60606002805461020890610fe4565b80601f016020809104026020016040519081016040528092919081815260200182805461023490610fe4565b80156102815780601f1061025657610100808354040283529160200191610281565b820191906000526020600020905b81548152906001019060200180831161026457829003601f168201915b50505050509050919050565b6102a883838360405180602001604052806000815250610482565b505050565b336001600160a01b03861681148015906102ce57506102cc86826103f5565b155b156103045760405163711bec9160e11b81526001600160a01b038083166004830152871660248201526044015b60405180910390fd5b61031186868686866104df565b505050505050565b6060815183511461034a5781518351604051635b05999160e01b8152600481019290925260248201526044016102fb565b6000835167ffffffffffffffff81111561036657610366610c06565b60405190808252806020026020018201604052801561038f578160200160208202803683370190505b50905060005b84518110156103de576020808202860101516103b990602080840287010151610181565b8282815181106103cb576103cb61101e565b6020908102919091010152600101610395565b509392505050565b6103f1338383610546565b5050565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205460ff1690565b336001600160a01b0386168114801590610444575061044286826103f5565b155b156104755760405163711bec9160e11b81526001600160a01b038083166004830152871660248201526044016102fb565b61031186868686866105dc565b6001600160a01b0384166104ac57604051632bfa23e760e11b8152600060048201526024016102fb565b6040805160018082526020820186905281830190815260608201859052608082019092529061031160008784848761066a565b6001600160a01b03841661050957604051632bfa23e760e11b8152600060048201526024016102fb565b6001600160a01b03851661053257604051626a0d4560e21b8152600060048201526024016102fb565b61053f858585858561066a565b5050505050565b6001600160a01b03821661056f5760405162ced3e160e81b8152600060048201526024016102fb565b6001600160a01b03838116600081815260016020908152604080832094871680845294825291829020805460ff191686151590811790915591519182527f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a3505050565b6001600160a01b03841661060657604051632bfa23e760e11b8152600060048201526024016102fb565b6001600160a01b03851661062f57604051626a0d4560e21b8152600060048201526024016102fb565b60408051600180825260208201869052818301908152606082018590526080820190925290610661878784848761066a565b50505050505050565b610676858585856106bd565b6001600160a01b0384161561053f57825133906001036106af57602084810151908401516106a88389898585896108d1565b5050610311565b6103118187878787876109f5565b80518251146106ec5781518151604051635b05999160e01b8152600481019290925260248201526044016102fb565b3360005b83518110156107f2576020818102858101820151908501909101516001600160a01b038816156107a3576000828152602081815260408083206001600160a01b038c1684529091529020548181101561077c576040516303dee4c560e01b81526001600160a01b038a1660048201526024810182905260448101839052606481018490526084016102fb565b6000838152602081815260408083206001600160a01b038d16845290915290209082900390555b6001600160a01b038716156107e8576000828152602081815260408083206001600160a01b038b168452909152812080548392906107e2908490611034565b90915550505b50506001016106f0565b5082516001036108735760208301516000906020840151909150856001600160a01b0316876001600160a01b0316846001600160a01b03167fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f628585604051610864929190918252602082015260400190565b60405180910390a4505061053f565b836001600160a01b0316856001600160a01b0316826001600160a01b03167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb86866040516108c2929190611055565b60405180910390a45050505050565b6001600160a01b0384163b156103115760405163f23a6e6160e01b81526001600160a01b0385169063f23a6e61906109159089908990889088908890600401611083565b6020604051808303816000875af1925050508015610950575060408051601f3d908101601f1916820190925261094d918101906110c8565b60015b6109b9573d80801561097e576040519150601f19603f3d011682016040523d82523d6000602084013e610983565b606091505b5080516000036109b157604051632bfa23e760e11b81526001600160a01b03861660048201526024016102fb565b805181602001fd5b6001600160e01b0319811663f23a6e6160e01b1461066157604051632bfa23e760e11b81526001600160a01b03861660048201526024016102fb565b6001600160a01b0384163b156103115760405163bc197c8160e01b81526001600160a01b0385169063bc197c8190610a3990899089908890889088906004016110e5565b6020604051808303816000875af1925050508015610a74575060408051601f3d908101601f19168201909252610a71918101906110c8565b60015b610aa2573d80801561097e576040519150601f19603f3d011682016040523d82523d6000602084013e610983565b6001600160e01b0319811663bc197c8160e01b1461066157604051632bfa23e760e11b81526001600160a01b03861660048201526024016102fb565b80356001600160a01b0381168114610af557600080fd5b919050565b60008060408385031215610b0d57600080fd5b610b1683610ade565b946020939093013593505050565b6001600160e01b031981168114610b3a57600080fd5b50565b600060208284031215610b4f57600080fd5b8135610b5a81610b24565b9392505050565b600060208284031215610b7357600080fd5b5035919050565b6000815180845260005b81811015610ba057602081850181015186830182015201610b84565b506000602082860101526020601f19601f83011685010191505092915050565b602081526000610b5a6020830184610b7a565b600080600060608486031215610be857600080fd5b610bf184610ade565b95602085013595506040909401359392505050565b634e487b7160e01b600052604160045260246000fd5b604051601f8201601f1916810167ffffffffffffffff81118282101715610c4557610c45610c06565b604052919050565b600067ffffffffffffffff821115610c6757610c67610c06565b5060051b60200190565b600082601f830112610c8257600080fd5b8135610c95610c9082610c4d565b610c1c565b8082825260208201915060208360051b860101925085831115610cb757600080fd5b602085015b83811015610cd4578035835260209283019201610cbc565b5095945050505050565b600082601f830112610cef57600080fd5b813567ffffffffffffffff811115610d0957610d09610c06565b610d1c601f8201601f1916602001610c1c565b818152846020838601011115610d3157600080fd5b816020850160208301376000918101602001919091529392505050565b600080600080600060a08688031215610d6657600080fd5b610d6f86610ade565b9450610d7d60208701610ade565b9350604086013567ffffffffffffffff811115610d9957600080fd5b610da588828901610c71565b935050606086013567ffffffffffffffff811115610dc257600080fd5b610dce88828901610c71565b925050608086013567ffffffffffffffff811115610deb57600080fd5b610df788828901610cde565b9150509295509295909350565b60008060408385031215610e1757600080fd5b823567ffffffffffffffff811115610e2e57600080fd5b8301601f81018513610e3f57600080fd5b8035610e4d610c9082610c4d565b8082825260208201915060208360051b850101925087831115610e6f57600080fd5b6020840193505b82841015610e9857610e8784610ade565b825260209384019390910190610e76565b9450505050602083013567ffffffffffffffff811115610eb757600080fd5b610ec385828601610c71565b9150509250929050565b600081518084526020840193506020830160005b82811015610eff578151865260209586019590910190600101610ee1565b5093949350505050565b602081526000610b5a6020830184610ecd565b60008060408385031215610f2f57600080fd5b610f3883610ade565b915060208301358015158114610f4d57600080fd5b809150509250929050565b60008060408385031215610f6b57600080fd5b610f7483610ade565b9150610f8260208401610ade565b90509250929050565b600080600080600060a08688031215610fa357600080fd5b610fac86610ade565b9450610fba60208701610ade565b93506040860135925060608601359150608086013567ffffffffffffffff811115610deb57600080fd5b600181811c90821680610ff857607f821691505b60208210810361101857634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052603260045260246000fd5b808201808211156101a357634e487b7160e01b600052601160045260246000fd5b6040815260006110686040830185610ecd565b828103602084015261107a8185610ecd565b95945050505050565b6001600160a01b03868116825285166020820152604081018490526060810183905260a0608082018190526000906110bd90830184610b7a565b979650505050505050565b6000602082840312156110da57600080fd5b8151610b5a81610b24565b6001600160a01b0386811682528516602082015260a06040820181905260009061111190830186610ecd565b82810360608401526111238186610ecd565b905082810360808401526111378185610b7a565b9897505050505050505056fea26469706673582212205ade9c9e281e12a92c784e8341a0e945f71400e022ad3d5f09587f180b9aedb864736f6c634300081e0033",
+}
+
+// ERC1155CompleteABI is the input ABI used to generate the binding from.
+// Deprecated: Use ERC1155CompleteMetaData.ABI instead.
+var ERC1155CompleteABI = ERC1155CompleteMetaData.ABI
+
+// ERC1155CompleteBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ERC1155CompleteMetaData.Bin instead.
+var ERC1155CompleteBin = ERC1155CompleteMetaData.Bin
+
+// DeployERC1155Complete deploys a new Ethereum contract, binding an instance of ERC1155Complete to it.
+func DeployERC1155Complete(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ERC1155Complete, error) {
+	parsed, err := ERC1155CompleteMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ERC1155CompleteBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC1155Complete{ERC1155CompleteCaller: ERC1155CompleteCaller{contract: contract}, ERC1155CompleteTransactor: ERC1155CompleteTransactor{contract: contract}, ERC1155CompleteFilterer: ERC1155CompleteFilterer{contract: contract}}, nil
+}
+
+// ERC1155Complete is an auto generated Go binding around an Ethereum contract.
+type ERC1155Complete struct {
+	ERC1155CompleteCaller     // Read-only binding to the contract
+	ERC1155CompleteTransactor // Write-only binding to the contract
+	ERC1155CompleteFilterer   // Log filterer for contract events
+}
+
+// ERC1155CompleteCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC1155CompleteCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC1155CompleteTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC1155CompleteTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC1155CompleteFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC1155CompleteFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC1155CompleteSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC1155CompleteSession struct {
+	Contract     *ERC1155Complete  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC1155CompleteCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC1155CompleteCallerSession struct {
+	Contract *ERC1155CompleteCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// ERC1155CompleteTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC1155CompleteTransactorSession struct {
+	Contract     *ERC1155CompleteTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// ERC1155CompleteRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC1155CompleteRaw struct {
+	Contract *ERC1155Complete // Generic contract binding to access the raw methods on
+}
+
+// ERC1155CompleteCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC1155CompleteCallerRaw struct {
+	Contract *ERC1155CompleteCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC1155CompleteTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC1155CompleteTransactorRaw struct {
+	Contract *ERC1155CompleteTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC1155Complete creates a new instance of ERC1155Complete, bound to a specific deployed contract.
+func NewERC1155Complete(address common.Address, backend bind.ContractBackend) (*ERC1155Complete, error) {
+	contract, err := bindERC1155Complete(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1155Complete{ERC1155CompleteCaller: ERC1155CompleteCaller{contract: contract}, ERC1155CompleteTransactor: ERC1155CompleteTransactor{contract: contract}, ERC1155CompleteFilterer: ERC1155CompleteFilterer{contract: contract}}, nil
+}
+
+// NewERC1155CompleteCaller creates a new read-only instance of ERC1155Complete, bound to a specific deployed contract.
+func NewERC1155CompleteCaller(address common.Address, caller bind.ContractCaller) (*ERC1155CompleteCaller, error) {
+	contract, err := bindERC1155Complete(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1155CompleteCaller{contract: contract}, nil
+}
+
+// NewERC1155CompleteTransactor creates a new write-only instance of ERC1155Complete, bound to a specific deployed contract.
+func NewERC1155CompleteTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC1155CompleteTransactor, error) {
+	contract, err := bindERC1155Complete(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1155CompleteTransactor{contract: contract}, nil
+}
+
+// NewERC1155CompleteFilterer creates a new log filterer instance of ERC1155Complete, bound to a specific deployed contract.
+func NewERC1155CompleteFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC1155CompleteFilterer, error) {
+	contract, err := bindERC1155Complete(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1155CompleteFilterer{contract: contract}, nil
+}
+
+// bindERC1155Complete binds a generic wrapper to an already deployed contract.
+func bindERC1155Complete(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ERC1155CompleteMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC1155Complete *ERC1155CompleteRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC1155Complete.Contract.ERC1155CompleteCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC1155Complete *ERC1155CompleteRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC1155Complete.Contract.ERC1155CompleteTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC1155Complete *ERC1155CompleteRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC1155Complete.Contract.ERC1155CompleteTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC1155Complete *ERC1155CompleteCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC1155Complete.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC1155Complete *ERC1155CompleteTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC1155Complete.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC1155Complete *ERC1155CompleteTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC1155Complete.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+//
+// Solidity: function balanceOf(address account, uint256 id) view returns(uint256)
+func (_ERC1155Complete *ERC1155CompleteCaller) BalanceOf(opts *bind.CallOpts, account common.Address, id *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC1155Complete.contract.Call(opts, &out, "balanceOf", account, id)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+//
+// Solidity: function balanceOf(address account, uint256 id) view returns(uint256)
+func (_ERC1155Complete *ERC1155CompleteSession) BalanceOf(account common.Address, id *big.Int) (*big.Int, error) {
+	return _ERC1155Complete.Contract.BalanceOf(&_ERC1155Complete.CallOpts, account, id)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+//
+// Solidity: function balanceOf(address account, uint256 id) view returns(uint256)
+func (_ERC1155Complete *ERC1155CompleteCallerSession) BalanceOf(account common.Address, id *big.Int) (*big.Int, error) {
+	return _ERC1155Complete.Contract.BalanceOf(&_ERC1155Complete.CallOpts, account, id)
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+//
+// Solidity: function balanceOfBatch(address[] accounts, uint256[] ids) view returns(uint256[])
+func (_ERC1155Complete *ERC1155CompleteCaller) BalanceOfBatch(opts *bind.CallOpts, accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	var out []interface{}
+	err := _ERC1155Complete.contract.Call(opts, &out, "balanceOfBatch", accounts, ids)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+//
+// Solidity: function balanceOfBatch(address[] accounts, uint256[] ids) view returns(uint256[])
+func (_ERC1155Complete *ERC1155CompleteSession) BalanceOfBatch(accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	return _ERC1155Complete.Contract.BalanceOfBatch(&_ERC1155Complete.CallOpts, accounts, ids)
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+//
+// Solidity: function balanceOfBatch(address[] accounts, uint256[] ids) view returns(uint256[])
+func (_ERC1155Complete *ERC1155CompleteCallerSession) BalanceOfBatch(accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	return _ERC1155Complete.Contract.BalanceOfBatch(&_ERC1155Complete.CallOpts, accounts, ids)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address account, address operator) view returns(bool)
+func (_ERC1155Complete *ERC1155CompleteCaller) IsApprovedForAll(opts *bind.CallOpts, account common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _ERC1155Complete.contract.Call(opts, &out, "isApprovedForAll", account, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address account, address operator) view returns(bool)
+func (_ERC1155Complete *ERC1155CompleteSession) IsApprovedForAll(account common.Address, operator common.Address) (bool, error) {
+	return _ERC1155Complete.Contract.IsApprovedForAll(&_ERC1155Complete.CallOpts, account, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address account, address operator) view returns(bool)
+func (_ERC1155Complete *ERC1155CompleteCallerSession) IsApprovedForAll(account common.Address, operator common.Address) (bool, error) {
+	return _ERC1155Complete.Contract.IsApprovedForAll(&_ERC1155Complete.CallOpts, account, operator)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC1155Complete *ERC1155CompleteCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _ERC1155Complete.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC1155Complete *ERC1155CompleteSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC1155Complete.Contract.SupportsInterface(&_ERC1155Complete.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC1155Complete *ERC1155CompleteCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC1155Complete.Contract.SupportsInterface(&_ERC1155Complete.CallOpts, interfaceId)
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+//
+// Solidity: function uri(uint256 ) view returns(string)
+func (_ERC1155Complete *ERC1155CompleteCaller) Uri(opts *bind.CallOpts, arg0 *big.Int) (string, error) {
+	var out []interface{}
+	err := _ERC1155Complete.contract.Call(opts, &out, "uri", arg0)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+//
+// Solidity: function uri(uint256 ) view returns(string)
+func (_ERC1155Complete *ERC1155CompleteSession) Uri(arg0 *big.Int) (string, error) {
+	return _ERC1155Complete.Contract.Uri(&_ERC1155Complete.CallOpts, arg0)
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+//
+// Solidity: function uri(uint256 ) view returns(string)
+func (_ERC1155Complete *ERC1155CompleteCallerSession) Uri(arg0 *big.Int) (string, error) {
+	return _ERC1155Complete.Contract.Uri(&_ERC1155Complete.CallOpts, arg0)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x156e29f6.
+//
+// Solidity: function mint(address to, uint256 id, uint256 value) returns()
+func (_ERC1155Complete *ERC1155CompleteTransactor) Mint(opts *bind.TransactOpts, to common.Address, id *big.Int, value *big.Int) (*types.Transaction, error) {
+	return _ERC1155Complete.contract.Transact(opts, "mint", to, id, value)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x156e29f6.
+//
+// Solidity: function mint(address to, uint256 id, uint256 value) returns()
+func (_ERC1155Complete *ERC1155CompleteSession) Mint(to common.Address, id *big.Int, value *big.Int) (*types.Transaction, error) {
+	return _ERC1155Complete.Contract.Mint(&_ERC1155Complete.TransactOpts, to, id, value)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x156e29f6.
+//
+// Solidity: function mint(address to, uint256 id, uint256 value) returns()
+func (_ERC1155Complete *ERC1155CompleteTransactorSession) Mint(to common.Address, id *big.Int, value *big.Int) (*types.Transaction, error) {
+	return _ERC1155Complete.Contract.Mint(&_ERC1155Complete.TransactOpts, to, id, value)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+//
+// Solidity: function safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] values, bytes data) returns()
+func (_ERC1155Complete *ERC1155CompleteTransactor) SafeBatchTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, ids []*big.Int, values []*big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155Complete.contract.Transact(opts, "safeBatchTransferFrom", from, to, ids, values, data)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+//
+// Solidity: function safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] values, bytes data) returns()
+func (_ERC1155Complete *ERC1155CompleteSession) SafeBatchTransferFrom(from common.Address, to common.Address, ids []*big.Int, values []*big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155Complete.Contract.SafeBatchTransferFrom(&_ERC1155Complete.TransactOpts, from, to, ids, values, data)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+//
+// Solidity: function safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] values, bytes data) returns()
+func (_ERC1155Complete *ERC1155CompleteTransactorSession) SafeBatchTransferFrom(from common.Address, to common.Address, ids []*big.Int, values []*big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155Complete.Contract.SafeBatchTransferFrom(&_ERC1155Complete.TransactOpts, from, to, ids, values, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 id, uint256 value, bytes data) returns()
+func (_ERC1155Complete *ERC1155CompleteTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, id *big.Int, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155Complete.contract.Transact(opts, "safeTransferFrom", from, to, id, value, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 id, uint256 value, bytes data) returns()
+func (_ERC1155Complete *ERC1155CompleteSession) SafeTransferFrom(from common.Address, to common.Address, id *big.Int, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155Complete.Contract.SafeTransferFrom(&_ERC1155Complete.TransactOpts, from, to, id, value, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 id, uint256 value, bytes data) returns()
+func (_ERC1155Complete *ERC1155CompleteTransactorSession) SafeTransferFrom(from common.Address, to common.Address, id *big.Int, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1155Complete.Contract.SafeTransferFrom(&_ERC1155Complete.TransactOpts, from, to, id, value, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC1155Complete *ERC1155CompleteTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC1155Complete.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC1155Complete *ERC1155CompleteSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC1155Complete.Contract.SetApprovalForAll(&_ERC1155Complete.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC1155Complete *ERC1155CompleteTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC1155Complete.Contract.SetApprovalForAll(&_ERC1155Complete.TransactOpts, operator, approved)
+}
+
+// ERC1155CompleteApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the ERC1155Complete contract.
+type ERC1155CompleteApprovalForAllIterator struct {
+	Event *ERC1155CompleteApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC1155CompleteApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC1155CompleteApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC1155CompleteApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC1155CompleteApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC1155CompleteApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC1155CompleteApprovalForAll represents a ApprovalForAll event raised by the ERC1155Complete contract.
+type ERC1155CompleteApprovalForAll struct {
+	Account  common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_ERC1155Complete *ERC1155CompleteFilterer) FilterApprovalForAll(opts *bind.FilterOpts, account []common.Address, operator []common.Address) (*ERC1155CompleteApprovalForAllIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC1155Complete.contract.FilterLogs(opts, "ApprovalForAll", accountRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1155CompleteApprovalForAllIterator{contract: _ERC1155Complete.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_ERC1155Complete *ERC1155CompleteFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *ERC1155CompleteApprovalForAll, account []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC1155Complete.contract.WatchLogs(opts, "ApprovalForAll", accountRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC1155CompleteApprovalForAll)
+				if err := _ERC1155Complete.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_ERC1155Complete *ERC1155CompleteFilterer) ParseApprovalForAll(log types.Log) (*ERC1155CompleteApprovalForAll, error) {
+	event := new(ERC1155CompleteApprovalForAll)
+	if err := _ERC1155Complete.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC1155CompleteTransferBatchIterator is returned from FilterTransferBatch and is used to iterate over the raw logs and unpacked data for TransferBatch events raised by the ERC1155Complete contract.
+type ERC1155CompleteTransferBatchIterator struct {
+	Event *ERC1155CompleteTransferBatch // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC1155CompleteTransferBatchIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC1155CompleteTransferBatch)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC1155CompleteTransferBatch)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC1155CompleteTransferBatchIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC1155CompleteTransferBatchIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC1155CompleteTransferBatch represents a TransferBatch event raised by the ERC1155Complete contract.
+type ERC1155CompleteTransferBatch struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Ids      []*big.Int
+	Values   []*big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferBatch is a free log retrieval operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+//
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_ERC1155Complete *ERC1155CompleteFilterer) FilterTransferBatch(opts *bind.FilterOpts, operator []common.Address, from []common.Address, to []common.Address) (*ERC1155CompleteTransferBatchIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC1155Complete.contract.FilterLogs(opts, "TransferBatch", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1155CompleteTransferBatchIterator{contract: _ERC1155Complete.contract, event: "TransferBatch", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferBatch is a free log subscription operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+//
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_ERC1155Complete *ERC1155CompleteFilterer) WatchTransferBatch(opts *bind.WatchOpts, sink chan<- *ERC1155CompleteTransferBatch, operator []common.Address, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC1155Complete.contract.WatchLogs(opts, "TransferBatch", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC1155CompleteTransferBatch)
+				if err := _ERC1155Complete.contract.UnpackLog(event, "TransferBatch", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferBatch is a log parse operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+//
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_ERC1155Complete *ERC1155CompleteFilterer) ParseTransferBatch(log types.Log) (*ERC1155CompleteTransferBatch, error) {
+	event := new(ERC1155CompleteTransferBatch)
+	if err := _ERC1155Complete.contract.UnpackLog(event, "TransferBatch", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC1155CompleteTransferSingleIterator is returned from FilterTransferSingle and is used to iterate over the raw logs and unpacked data for TransferSingle events raised by the ERC1155Complete contract.
+type ERC1155CompleteTransferSingleIterator struct {
+	Event *ERC1155CompleteTransferSingle // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC1155CompleteTransferSingleIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC1155CompleteTransferSingle)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC1155CompleteTransferSingle)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC1155CompleteTransferSingleIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC1155CompleteTransferSingleIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC1155CompleteTransferSingle represents a TransferSingle event raised by the ERC1155Complete contract.
+type ERC1155CompleteTransferSingle struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Id       *big.Int
+	Value    *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferSingle is a free log retrieval operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+//
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_ERC1155Complete *ERC1155CompleteFilterer) FilterTransferSingle(opts *bind.FilterOpts, operator []common.Address, from []common.Address, to []common.Address) (*ERC1155CompleteTransferSingleIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC1155Complete.contract.FilterLogs(opts, "TransferSingle", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1155CompleteTransferSingleIterator{contract: _ERC1155Complete.contract, event: "TransferSingle", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferSingle is a free log subscription operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+//
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_ERC1155Complete *ERC1155CompleteFilterer) WatchTransferSingle(opts *bind.WatchOpts, sink chan<- *ERC1155CompleteTransferSingle, operator []common.Address, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC1155Complete.contract.WatchLogs(opts, "TransferSingle", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC1155CompleteTransferSingle)
+				if err := _ERC1155Complete.contract.UnpackLog(event, "TransferSingle", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferSingle is a log parse operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+//
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_ERC1155Complete *ERC1155CompleteFilterer) ParseTransferSingle(log types.Log) (*ERC1155CompleteTransferSingle, error) {
+	event := new(ERC1155CompleteTransferSingle)
+	if err := _ERC1155Complete.contract.UnpackLog(event, "TransferSingle", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC1155CompleteURIIterator is returned from FilterURI and is used to iterate over the raw logs and unpacked data for URI events raised by the ERC1155Complete contract.
+type ERC1155CompleteURIIterator struct {
+	Event *ERC1155CompleteURI // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC1155CompleteURIIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC1155CompleteURI)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC1155CompleteURI)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC1155CompleteURIIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC1155CompleteURIIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC1155CompleteURI represents a URI event raised by the ERC1155Complete contract.
+type ERC1155CompleteURI struct {
+	Value string
+	Id    *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterURI is a free log retrieval operation binding the contract event 0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b.
+//
+// Solidity: event URI(string value, uint256 indexed id)
+func (_ERC1155Complete *ERC1155CompleteFilterer) FilterURI(opts *bind.FilterOpts, id []*big.Int) (*ERC1155CompleteURIIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _ERC1155Complete.contract.FilterLogs(opts, "URI", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1155CompleteURIIterator{contract: _ERC1155Complete.contract, event: "URI", logs: logs, sub: sub}, nil
+}
+
+// WatchURI is a free log subscription operation binding the contract event 0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b.
+//
+// Solidity: event URI(string value, uint256 indexed id)
+func (_ERC1155Complete *ERC1155CompleteFilterer) WatchURI(opts *bind.WatchOpts, sink chan<- *ERC1155CompleteURI, id []*big.Int) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _ERC1155Complete.contract.WatchLogs(opts, "URI", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC1155CompleteURI)
+				if err := _ERC1155Complete.contract.UnpackLog(event, "URI", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseURI is a log parse operation binding the contract event 0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b.
+//
+// Solidity: event URI(string value, uint256 indexed id)
+func (_ERC1155Complete *ERC1155CompleteFilterer) ParseURI(log types.Log) (*ERC1155CompleteURI, error) {
+	event := new(ERC1155CompleteURI)
+	if err := _ERC1155Complete.contract.UnpackLog(event, "URI", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,802 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ERC20Allowance
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ERC20AllowanceMetaData contains all meta data concerning the ERC20Allowance contract.
+var ERC20AllowanceMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"currentAllowance\",\"type\":\"uint256\"}],\"name\":\"ERC20ApproveFromNonZero\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"currentAllowance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requestedDecrease\",\"type\":\"uint256\"}],\"name\":\"ERC20FailedDecreaseAllowance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"allowance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientAllowance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientBalance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidApprover\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSpender\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"requestedDecrease\",\"type\":\"uint256\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x608060405234801561001057600080fd5b5060405180604001604052806009815260200168416c6c6f77616e636560b81b81525060405180604001604052806003815260200162414c5760e81b815250816003908161005e91906102af565b50600461006b82826102af565b50505061009d336100806100a260201b60201c565b61008b90600a61046c565b61009890620f4240610482565b6100a7565b6104ac565b601290565b6001600160a01b0382166100d65760405163ec442f0560e01b8152600060048201526024015b60405180910390fd5b6100e2600083836100e6565b5050565b6001600160a01b0383166101115780600260008282546101069190610499565b909155506101839050565b6001600160a01b038316600090815260208190526040902054818110156101645760405163391434e360e21b81526001600160a01b038516600482015260248101829052604481018390526064016100cd565b6001600160a01b03841660009081526020819052604090209082900390555b6001600160a01b03821661019f576002805482900390556101be565b6001600160a01b03821660009081526020819052604090208054820190555b816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8360405161020391815260200190565b60405180910390a3505050565b634e487b7160e01b600052604160045260246000fd5b600181811c9082168061023a57607f821691505b60208210810361025a57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156102aa57806000526020600020601f840160051c810160208510156102875750805b601f840160051c820191505b818110156102a75760008155600101610293565b50505b505050565b81516001600160401b038111156102c8576102c8610210565b6102dc816102d68454610226565b84610260565b6020601f82116001811461031057600083156102f85750848201515b600019600385901b1c1916600184901b1784556102a7565b600084815260208120601f198516915b828110156103405787850151825560209485019460019092019101610320565b508482101561035e5786840151600019600387901b60f8161c191681555b50505050600190811b01905550565b634e487b7160e01b600052601160045260246000fd5b6001815b60018411156103be578085048111156103a2576103a261036d565b60018416156103b057908102905b60019390931c928002610387565b935093915050565b6000826103d557506001610466565b816103e257506000610466565b81600181146103f857600281146104025761041e565b6001915050610466565b60ff8411156104135761041361036d565b50506001821b610466565b5060208310610133831016604e8410600b8410161715610441575081810a610466565b61044e6000198484610383565b80600019048211156104625761046261036d565b0290505b92915050565b600061047b60ff8416836103c6565b9392505050565b80820281158282048414176104665761046661036d565b808201808211156104665761046661036d565b610842806104bb6000396000f3fe608060405234801561001057600080fd5b50600436106100a95760003560e01c80633950935111610071578063395093511461012357806370a082311461013657806395d89b411461015f578063a457c2d714610167578063a9059cbb1461017a578063dd62ed3e1461018d57600080fd5b806306fdde03146100ae578063095ea7b3146100cc57806318160ddd146100ef57806323b872dd14610101578063313ce56714610114575b600080fd5b6100b66101a0565b6040516100c39190610670565b60405180910390f35b6100df6100da3660046106da565b610232565b60405190151581526020016100c3565b6002545b6040519081526020016100c3565b6100df61010f366004610704565b610298565b604051601281526020016100c3565b6100df6101313660046106da565b6102bc565b6100f3610144366004610741565b6001600160a01b031660009081526020819052604090205490565b6100b66102e6565b6100df6101753660046106da565b6102f5565b6100df6101883660046106da565b610357565b6100f361019b366004610763565b610365565b6060600380546101af90610796565b80601f01602080910402602001604051908101604052809291908181526020018280546101db90610796565b80156102285780601f106101fd57610100808354040283529160200191610228565b820191906000526020600020905b81548152906001019060200180831161020b57829003601f168201915b5050505050905090565b60008061023f3385610365565b9050821580159061024f57508015155b156102845760405163b7fe8b4360e01b81526001600160a01b0385166004820152602481018290526044015b60405180910390fd5b61028e8484610390565b9150505b92915050565b6000336102a685828561039e565b6102b1858585610404565b506001949350505050565b60006102dd3384846102ce3388610365565b6102d891906107e6565b610468565b50600192915050565b6060600480546101af90610796565b6000806103023385610365565b90508281101561033e57604051632983c0c360e21b81526001600160a01b0385166004820152602481018290526044810184905260640161027b565b61034d33856102d886856107f9565b5060019392505050565b60003361034d818585610404565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205490565b60003361034d818585610468565b60006103aa8484610365565b905060001981146103fe57818110156103ef57604051637dc7a0d960e11b81526001600160a01b0384166004820152602481018290526044810183905260640161027b565b6103fe84848484036000610471565b50505050565b6001600160a01b03831661042e57604051634b637e8f60e11b81526000600482015260240161027b565b6001600160a01b0382166104585760405163ec442f0560e01b81526000600482015260240161027b565b610463838383610546565b505050565b61046383838360015b6001600160a01b03841661049b5760405163e602df0560e01b81526000600482015260240161027b565b6001600160a01b0383166104c557604051634a1406b160e11b81526000600482015260240161027b565b6001600160a01b03808516600090815260016020908152604080832093871683529290522082905580156103fe57826001600160a01b0316846001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b9258460405161053891815260200190565b60405180910390a350505050565b6001600160a01b03831661057157806002600082825461056691906107e6565b909155506105e39050565b6001600160a01b038316600090815260208190526040902054818110156105c45760405163391434e360e21b81526001600160a01b0385166004820152602481018290526044810183905260640161027b565b6001600160a01b03841660009081526020819052604090209082900390555b6001600160a01b0382166105ff5760028054829003905561061e565b6001600160a01b03821660009081526020819052604090208054820190555b816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8360405161066391815260200190565b60405180910390a3505050565b602081526000825180602084015260005b8181101561069e5760208186018101516040868401015201610681565b506000604082850101526040601f19601f83011684010191505092915050565b80356001600160a01b03811681146106d557600080fd5b919050565b600080604083850312156106ed57600080fd5b6106f6836106be565b946020939093013593505050565b60008060006060848603121561071957600080fd5b610722846106be565b9250610730602085016106be565b929592945050506040919091013590565b60006020828403121561075357600080fd5b61075c826106be565b9392505050565b6000806040838503121561077657600080fd5b61077f836106be565b915061078d602084016106be565b90509250929050565b600181811c908216806107aa57607f821691505b6020821081036107ca57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b80820180821115610292576102926107d0565b81810381811115610292576102926107d056fea264697066735822122009281a15e8f43245ad3760cd8d71bc9306be87236f67b6d0e8fb5a36fd399ad364736f6c634300081e0033",
+}
+
+// ERC20AllowanceABI is the input ABI used to generate the binding from.
+// Deprecated: Use ERC20AllowanceMetaData.ABI instead.
+var ERC20AllowanceABI = ERC20AllowanceMetaData.ABI
+
+// ERC20AllowanceBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ERC20AllowanceMetaData.Bin instead.
+var ERC20AllowanceBin = ERC20AllowanceMetaData.Bin
+
+// DeployERC20Allowance deploys a new Ethereum contract, binding an instance of ERC20Allowance to it.
+func DeployERC20Allowance(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ERC20Allowance, error) {
+	parsed, err := ERC20AllowanceMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ERC20AllowanceBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC20Allowance{ERC20AllowanceCaller: ERC20AllowanceCaller{contract: contract}, ERC20AllowanceTransactor: ERC20AllowanceTransactor{contract: contract}, ERC20AllowanceFilterer: ERC20AllowanceFilterer{contract: contract}}, nil
+}
+
+// ERC20Allowance is an auto generated Go binding around an Ethereum contract.
+type ERC20Allowance struct {
+	ERC20AllowanceCaller     // Read-only binding to the contract
+	ERC20AllowanceTransactor // Write-only binding to the contract
+	ERC20AllowanceFilterer   // Log filterer for contract events
+}
+
+// ERC20AllowanceCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC20AllowanceCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC20AllowanceTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC20AllowanceTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC20AllowanceFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC20AllowanceFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC20AllowanceSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC20AllowanceSession struct {
+	Contract     *ERC20Allowance   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC20AllowanceCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC20AllowanceCallerSession struct {
+	Contract *ERC20AllowanceCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// ERC20AllowanceTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC20AllowanceTransactorSession struct {
+	Contract     *ERC20AllowanceTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// ERC20AllowanceRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC20AllowanceRaw struct {
+	Contract *ERC20Allowance // Generic contract binding to access the raw methods on
+}
+
+// ERC20AllowanceCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC20AllowanceCallerRaw struct {
+	Contract *ERC20AllowanceCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC20AllowanceTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC20AllowanceTransactorRaw struct {
+	Contract *ERC20AllowanceTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC20Allowance creates a new instance of ERC20Allowance, bound to a specific deployed contract.
+func NewERC20Allowance(address common.Address, backend bind.ContractBackend) (*ERC20Allowance, error) {
+	contract, err := bindERC20Allowance(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20Allowance{ERC20AllowanceCaller: ERC20AllowanceCaller{contract: contract}, ERC20AllowanceTransactor: ERC20AllowanceTransactor{contract: contract}, ERC20AllowanceFilterer: ERC20AllowanceFilterer{contract: contract}}, nil
+}
+
+// NewERC20AllowanceCaller creates a new read-only instance of ERC20Allowance, bound to a specific deployed contract.
+func NewERC20AllowanceCaller(address common.Address, caller bind.ContractCaller) (*ERC20AllowanceCaller, error) {
+	contract, err := bindERC20Allowance(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20AllowanceCaller{contract: contract}, nil
+}
+
+// NewERC20AllowanceTransactor creates a new write-only instance of ERC20Allowance, bound to a specific deployed contract.
+func NewERC20AllowanceTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC20AllowanceTransactor, error) {
+	contract, err := bindERC20Allowance(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20AllowanceTransactor{contract: contract}, nil
+}
+
+// NewERC20AllowanceFilterer creates a new log filterer instance of ERC20Allowance, bound to a specific deployed contract.
+func NewERC20AllowanceFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC20AllowanceFilterer, error) {
+	contract, err := bindERC20Allowance(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20AllowanceFilterer{contract: contract}, nil
+}
+
+// bindERC20Allowance binds a generic wrapper to an already deployed contract.
+func bindERC20Allowance(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ERC20AllowanceMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC20Allowance *ERC20AllowanceRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC20Allowance.Contract.ERC20AllowanceCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC20Allowance *ERC20AllowanceRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.ERC20AllowanceTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC20Allowance *ERC20AllowanceRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.ERC20AllowanceTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC20Allowance *ERC20AllowanceCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC20Allowance.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC20Allowance *ERC20AllowanceTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC20Allowance *ERC20AllowanceTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.contract.Transact(opts, method, params...)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC20Allowance *ERC20AllowanceCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Allowance.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC20Allowance *ERC20AllowanceSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ERC20Allowance.Contract.Allowance(&_ERC20Allowance.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC20Allowance *ERC20AllowanceCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ERC20Allowance.Contract.Allowance(&_ERC20Allowance.CallOpts, owner, spender)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC20Allowance *ERC20AllowanceCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Allowance.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC20Allowance *ERC20AllowanceSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ERC20Allowance.Contract.BalanceOf(&_ERC20Allowance.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC20Allowance *ERC20AllowanceCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ERC20Allowance.Contract.BalanceOf(&_ERC20Allowance.CallOpts, account)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC20Allowance *ERC20AllowanceCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ERC20Allowance.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC20Allowance *ERC20AllowanceSession) Decimals() (uint8, error) {
+	return _ERC20Allowance.Contract.Decimals(&_ERC20Allowance.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC20Allowance *ERC20AllowanceCallerSession) Decimals() (uint8, error) {
+	return _ERC20Allowance.Contract.Decimals(&_ERC20Allowance.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC20Allowance *ERC20AllowanceCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC20Allowance.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC20Allowance *ERC20AllowanceSession) Name() (string, error) {
+	return _ERC20Allowance.Contract.Name(&_ERC20Allowance.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC20Allowance *ERC20AllowanceCallerSession) Name() (string, error) {
+	return _ERC20Allowance.Contract.Name(&_ERC20Allowance.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC20Allowance *ERC20AllowanceCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC20Allowance.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC20Allowance *ERC20AllowanceSession) Symbol() (string, error) {
+	return _ERC20Allowance.Contract.Symbol(&_ERC20Allowance.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC20Allowance *ERC20AllowanceCallerSession) Symbol() (string, error) {
+	return _ERC20Allowance.Contract.Symbol(&_ERC20Allowance.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC20Allowance *ERC20AllowanceCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Allowance.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC20Allowance *ERC20AllowanceSession) TotalSupply() (*big.Int, error) {
+	return _ERC20Allowance.Contract.TotalSupply(&_ERC20Allowance.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC20Allowance *ERC20AllowanceCallerSession) TotalSupply() (*big.Int, error) {
+	return _ERC20Allowance.Contract.TotalSupply(&_ERC20Allowance.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceTransactor) Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.contract.Transact(opts, "approve", spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.Approve(&_ERC20Allowance.TransactOpts, spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceTransactorSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.Approve(&_ERC20Allowance.TransactOpts, spender, value)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 requestedDecrease) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceTransactor) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, requestedDecrease *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.contract.Transact(opts, "decreaseAllowance", spender, requestedDecrease)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 requestedDecrease) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceSession) DecreaseAllowance(spender common.Address, requestedDecrease *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.DecreaseAllowance(&_ERC20Allowance.TransactOpts, spender, requestedDecrease)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 requestedDecrease) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceTransactorSession) DecreaseAllowance(spender common.Address, requestedDecrease *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.DecreaseAllowance(&_ERC20Allowance.TransactOpts, spender, requestedDecrease)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceTransactor) IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.contract.Transact(opts, "increaseAllowance", spender, addedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.IncreaseAllowance(&_ERC20Allowance.TransactOpts, spender, addedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceTransactorSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.IncreaseAllowance(&_ERC20Allowance.TransactOpts, spender, addedValue)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceTransactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.contract.Transact(opts, "transfer", to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.Transfer(&_ERC20Allowance.TransactOpts, to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceTransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.Transfer(&_ERC20Allowance.TransactOpts, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.contract.Transact(opts, "transferFrom", from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.TransferFrom(&_ERC20Allowance.TransactOpts, from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC20Allowance *ERC20AllowanceTransactorSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Allowance.Contract.TransferFrom(&_ERC20Allowance.TransactOpts, from, to, value)
+}
+
+// ERC20AllowanceApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the ERC20Allowance contract.
+type ERC20AllowanceApprovalIterator struct {
+	Event *ERC20AllowanceApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20AllowanceApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20AllowanceApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20AllowanceApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20AllowanceApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20AllowanceApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20AllowanceApproval represents a Approval event raised by the ERC20Allowance contract.
+type ERC20AllowanceApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC20Allowance *ERC20AllowanceFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*ERC20AllowanceApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ERC20Allowance.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20AllowanceApprovalIterator{contract: _ERC20Allowance.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC20Allowance *ERC20AllowanceFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *ERC20AllowanceApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ERC20Allowance.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20AllowanceApproval)
+				if err := _ERC20Allowance.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC20Allowance *ERC20AllowanceFilterer) ParseApproval(log types.Log) (*ERC20AllowanceApproval, error) {
+	event := new(ERC20AllowanceApproval)
+	if err := _ERC20Allowance.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20AllowanceTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the ERC20Allowance contract.
+type ERC20AllowanceTransferIterator struct {
+	Event *ERC20AllowanceTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20AllowanceTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20AllowanceTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20AllowanceTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20AllowanceTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20AllowanceTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20AllowanceTransfer represents a Transfer event raised by the ERC20Allowance contract.
+type ERC20AllowanceTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC20Allowance *ERC20AllowanceFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*ERC20AllowanceTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC20Allowance.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20AllowanceTransferIterator{contract: _ERC20Allowance.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC20Allowance *ERC20AllowanceFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ERC20AllowanceTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC20Allowance.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20AllowanceTransfer)
+				if err := _ERC20Allowance.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC20Allowance *ERC20AllowanceFilterer) ParseTransfer(log types.Log) (*ERC20AllowanceTransfer, error) {
+	event := new(ERC20AllowanceTransfer)
+	if err := _ERC20Allowance.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,2757 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ERC20Extended
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// CheckpointsCheckpoint208 is an auto generated low-level Go binding around an user-defined struct.
+type CheckpointsCheckpoint208 struct {
+	Key   *big.Int
+	Value *big.Int
+}
+
+// ERC20ExtendedMetaData contains all meta data concerning the ERC20Extended contract.
+var ERC20ExtendedMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"cap_\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"AccessControlBadConfirmation\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"neededRole\",\"type\":\"bytes32\"}],\"name\":\"AccessControlUnauthorizedAccount\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"CheckpointUnorderedInsertion\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ECDSAInvalidSignature\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}],\"name\":\"ECDSAInvalidSignatureLength\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"ECDSAInvalidSignatureS\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"increasedSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"cap\",\"type\":\"uint256\"}],\"name\":\"ERC20ExceededCap\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"increasedSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"cap\",\"type\":\"uint256\"}],\"name\":\"ERC20ExceededSafeSupply\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"allowance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientAllowance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientBalance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidApprover\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"cap\",\"type\":\"uint256\"}],\"name\":\"ERC20InvalidCap\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSpender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"timepoint\",\"type\":\"uint256\"},{\"internalType\":\"uint48\",\"name\":\"clock\",\"type\":\"uint48\"}],\"name\":\"ERC5805FutureLookup\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ERC6372InconsistentClock\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"EnforcedPause\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ExpectedPause\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"currentNonce\",\"type\":\"uint256\"}],\"name\":\"InvalidAccountNonce\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidShortString\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"bits\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"SafeCastOverflowedUintDowncast\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"str\",\"type\":\"string\"}],\"name\":\"StringTooLong\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"expiry\",\"type\":\"uint256\"}],\"name\":\"VotesExpiredSignature\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"fromDelegate\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"toDelegate\",\"type\":\"address\"}],\"name\":\"DelegateChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegate\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"previousVotes\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newVotes\",\"type\":\"uint256\"}],\"name\":\"DelegateVotesChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"EIP712DomainChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"CLOCK_MODE\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DOMAIN_SEPARATOR\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MINTER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"PAUSER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"cap\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint32\",\"name\":\"pos\",\"type\":\"uint32\"}],\"name\":\"checkpoints\",\"outputs\":[{\"components\":[{\"internalType\":\"uint48\",\"name\":\"_key\",\"type\":\"uint48\"},{\"internalType\":\"uint208\",\"name\":\"_value\",\"type\":\"uint208\"}],\"internalType\":\"structCheckpoints.Checkpoint208\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"clock\",\"outputs\":[{\"internalType\":\"uint48\",\"name\":\"\",\"type\":\"uint48\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegatee\",\"type\":\"address\"}],\"name\":\"delegate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegatee\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"nonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"expiry\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"delegateBySig\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"delegates\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"eip712Domain\",\"outputs\":[{\"internalType\":\"bytes1\",\"name\":\"fields\",\"type\":\"bytes1\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"version\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"chainId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"verifyingContract\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"salt\",\"type\":\"bytes32\"},{\"internalType\":\"uint256[]\",\"name\":\"extensions\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"timepoint\",\"type\":\"uint256\"}],\"name\":\"getPastTotalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"timepoint\",\"type\":\"uint256\"}],\"name\":\"getPastVotes\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getVotes\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"nonces\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"numCheckpoints\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"callerConfirmation\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x61018060405234801561001157600080fd5b506040516128153803806128158339810160408190526100309161033e565b6040518060400160405280600e81526020016d457874656e64656420566f74657360901b815250604051806040016040528060018152602001601960f91b8152508260405180604001604052806008815260200167115e1d195b99195960c21b8152506040518060400160405280600381526020016211561560ea1b81525081600390816100be91906103f6565b5060046100cb82826103f6565b50506006805460ff191690555060008190036101025760405163392e1e2760e01b8152600060048201526024015b60405180910390fd5b60805261011082600761021f565b6101405261011f81600861021f565b61016052815160208084019190912061010052815190820120610120524660c0526101ae6101005161012051604080517f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f60208201529081019290925260608201524660808201523060a082015260009060c00160405160208183030381529060405280519060200120905090565b60a05250503060e0526101c2600033610252565b506101ed7f9f2df0fed2c77648de5860a4cc508cd0818c85b8b8a1ab4ceeef8d981c8956a633610252565b506102187f65d7a28e3265b37a6474929f336521b332c1681b933f6cb9f3376673440d862a33610252565b5050610526565b600060208351101561023b5761023483610300565b905061024c565b8161024684826103f6565b5060ff90505b92915050565b60008281526005602090815260408083206001600160a01b038516845290915281205460ff166102f85760008381526005602090815260408083206001600160a01b03861684529091529020805460ff191660011790556102b03390565b6001600160a01b0316826001600160a01b0316847f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a450600161024c565b50600061024c565b600080829050601f8151111561032b578260405163305a27a960e01b81526004016100f991906104b4565b805161033682610502565b179392505050565b60006020828403121561035057600080fd5b5051919050565b634e487b7160e01b600052604160045260246000fd5b600181811c9082168061038157607f821691505b6020821081036103a157634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156103f157806000526020600020601f840160051c810160208510156103ce5750805b601f840160051c820191505b818110156103ee57600081556001016103da565b50505b505050565b81516001600160401b0381111561040f5761040f610357565b6104238161041d845461036d565b846103a7565b6020601f821160018114610457576000831561043f5750848201515b600019600385901b1c1916600184901b1784556103ee565b600084815260208120601f198516915b828110156104875787850151825560209485019460019092019101610467565b50848210156104a55786840151600019600387901b60f8161c191681555b50505050600190811b01905550565b602081526000825180602084015260005b818110156104e257602081860181015160408684010152016104c5565b506000604082850101526040601f19601f83011684010191505092915050565b805160208083015191908110156103a15760001960209190910360031b1b16919050565b60805160a05160c05160e0516101005161012051610140516101605161228361059260003960006110bc0152600061108f01526000610d7901526000610d5101526000610cac01526000610cd601526000610d000152600081816102df0152611ade01526122836000f3fe608060405234801561001057600080fd5b506004361061021c5760003560e01c80636fcfff45116101255780639ab24eb0116100ad578063d53913931161007c578063d5391393146104e1578063d547741f14610508578063dd62ed3e1461051b578063e63ab1e914610554578063f1127ed81461057b57600080fd5b80639ab24eb0146104a0578063a217fddf146104b3578063a9059cbb146104bb578063c3cda520146104ce57600080fd5b806384b0196e116100f457806384b0196e146104385780638e539e8c1461045357806391d148541461046657806391ddadf41461047957806395d89b411461049857600080fd5b80636fcfff45146103b657806370a08231146103de5780637ecebe00146104075780638456cb591461043057600080fd5b80633644e515116101a857806340c10f191161017757806340c10f19146103395780634bf5d7e91461034c578063587cde1e146103545780635c19a95c146103985780635c975abb146103ab57600080fd5b80633644e5151461030357806336568abe1461030b5780633a46b1a81461031e5780633f4ba83a1461033157600080fd5b806323b872dd116101ef57806323b872dd14610283578063248a9ca3146102965780632f2ff15d146102b9578063313ce567146102ce578063355274ea146102dd57600080fd5b806301ffc9a71461022157806306fdde0314610249578063095ea7b31461025e57806318160ddd14610271575b600080fd5b61023461022f366004611e6d565b6105ba565b60405190151581526020015b60405180910390f35b6102516105f1565b6040516102409190611edd565b61023461026c366004611f0c565b610683565b6002545b604051908152602001610240565b610234610291366004611f36565b61069b565b6102756102a4366004611f73565b60009081526005602052604090206001015490565b6102cc6102c7366004611f8c565b6106bf565b005b60405160128152602001610240565b7f0000000000000000000000000000000000000000000000000000000000000000610275565b6102756106ea565b6102cc610319366004611f8c565b6106f9565b61027561032c366004611f0c565b610731565b6102cc6107b7565b6102cc610347366004611f0c565b6107ec565b610251610820565b610380610362366004611fb8565b6001600160a01b039081166000908152600a60205260409020541690565b6040516001600160a01b039091168152602001610240565b6102cc6103a6366004611fb8565b610898565b60065460ff16610234565b6103c96103c4366004611fb8565b6108a7565b60405163ffffffff9091168152602001610240565b6102756103ec366004611fb8565b6001600160a01b031660009081526020819052604090205490565b610275610415366004611fb8565b6001600160a01b031660009081526009602052604090205490565b6102cc6108b2565b6104406108e4565b6040516102409796959493929190611fd3565b610275610461366004611f73565b61092a565b610234610474366004611f8c565b610994565b6104816109bf565b60405165ffffffffffff9091168152602001610240565b6102516109c9565b6102756104ae366004611fb8565b6109d8565b610275600081565b6102346104c9366004611f0c565b610a08565b6102cc6104dc36600461206b565b610a16565b6102757f9f2df0fed2c77648de5860a4cc508cd0818c85b8b8a1ab4ceeef8d981c8956a681565b6102cc610516366004611f8c565b610ad3565b6102756105293660046120cb565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205490565b6102757f65d7a28e3265b37a6474929f336521b332c1681b933f6cb9f3376673440d862a81565b61058e6105893660046120f5565b610af8565b60408051825165ffffffffffff1681526020928301516001600160d01b03169281019290925201610240565b60006001600160e01b03198216637965db0b60e01b14806105eb57506301ffc9a760e01b6001600160e01b03198316145b92915050565b60606003805461060090612135565b80601f016020809104026020016040519081016040528092919081815260200182805461062c90612135565b80156106795780601f1061064e57610100808354040283529160200191610679565b820191906000526020600020905b81548152906001019060200180831161065c57829003601f168201915b5050505050905090565b600033610691818585610b1d565b5060019392505050565b6000336106a9858285610b2a565b6106b4858585610ba2565b506001949350505050565b6000828152600560205260409020600101546106da81610c01565b6106e48383610c0b565b50505050565b60006106f4610c9f565b905090565b6001600160a01b03811633146107225760405163334bd91960e11b815260040160405180910390fd5b61072c8282610dca565b505050565b60008061073c6109bf565b90508065ffffffffffff16831061077c57604051637669fc0f60e11b81526004810184905265ffffffffffff821660248201526044015b60405180910390fd5b6107a661078884610e37565b6001600160a01b0386166000908152600b6020526040902090610e6e565b6001600160d01b0316949350505050565b7f65d7a28e3265b37a6474929f336521b332c1681b933f6cb9f3376673440d862a6107e181610c01565b6107e9610f24565b50565b7f9f2df0fed2c77648de5860a4cc508cd0818c85b8b8a1ab4ceeef8d981c8956a661081681610c01565b61072c8383610f76565b606061082a610fac565b65ffffffffffff1661083a6109bf565b65ffffffffffff1614610860576040516301bfc1c560e61b815260040160405180910390fd5b5060408051808201909152601d81527f6d6f64653d626c6f636b6e756d6265722666726f6d3d64656661756c74000000602082015290565b336108a38183610fb7565b5050565b60006105eb82611029565b7f65d7a28e3265b37a6474929f336521b332c1681b933f6cb9f3376673440d862a6108dc81610c01565b6107e961104b565b6000606080600080600060606108f8611088565b6109006110b5565b60408051600080825260208201909252600f60f81b9b939a50919850469750309650945092509050565b6000806109356109bf565b90508065ffffffffffff16831061097057604051637669fc0f60e11b81526004810184905265ffffffffffff82166024820152604401610773565b61098461097c84610e37565b600c90610e6e565b6001600160d01b03169392505050565b60009182526005602090815260408084206001600160a01b0393909316845291905290205460ff1690565b60006106f4610fac565b60606004805461060090612135565b6001600160a01b0381166000908152600b602052604081206109f9906110e2565b6001600160d01b031692915050565b600033610691818585610ba2565b83421115610a3a57604051632341d78760e11b815260048101859052602401610773565b604080517fe48329057bfd03d55e49b547132e39cffd9c1820ad7b9d4c5307691425d15adf60208201526001600160a01b038816918101919091526060810186905260808101859052600090610ab490610aac9060a0016040516020818303038152906040528051906020012061111b565b858585611148565b9050610ac08187611176565b610aca8188610fb7565b50505050505050565b600082815260056020526040902060010154610aee81610c01565b6106e48383610dca565b6040805180820190915260008082526020820152610b1683836111c9565b9392505050565b61072c83838360016111ff565b6001600160a01b0383811660009081526001602090815260408083209386168352929052205460001981146106e45781811015610b9357604051637dc7a0d960e11b81526001600160a01b03841660048201526024810182905260448101839052606401610773565b6106e4848484840360006111ff565b6001600160a01b038316610bcc57604051634b637e8f60e11b815260006004820152602401610773565b6001600160a01b038216610bf65760405163ec442f0560e01b815260006004820152602401610773565b61072c8383836112d4565b6107e981336112df565b6000610c178383610994565b610c975760008381526005602090815260408083206001600160a01b03861684529091529020805460ff19166001179055610c4f3390565b6001600160a01b0316826001600160a01b0316847f2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d60405160405180910390a45060016105eb565b5060006105eb565b6000306001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016148015610cf857507f000000000000000000000000000000000000000000000000000000000000000046145b15610d2257507f000000000000000000000000000000000000000000000000000000000000000090565b6106f4604080517f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f60208201527f0000000000000000000000000000000000000000000000000000000000000000918101919091527f000000000000000000000000000000000000000000000000000000000000000060608201524660808201523060a082015260009060c00160405160208183030381529060405280519060200120905090565b6000610dd68383610994565b15610c975760008381526005602090815260408083206001600160a01b0386168085529252808320805460ff1916905551339286917ff6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b9190a45060016105eb565b600065ffffffffffff821115610e6a576040516306dfcc6560e41b81526030600482015260248101839052604401610773565b5090565b815460009081816005811115610ecd576000610e8984611318565b610e939085612185565b60008881526020902090915081015465ffffffffffff9081169087161015610ebd57809150610ecb565b610ec8816001612198565b92505b505b6000610edb87878585611471565b90508015610f1657610f0087610ef2600184612185565b600091825260209091200190565b54600160301b90046001600160d01b0316610f19565b60005b979650505050505050565b610f2c6114d3565b6006805460ff191690557f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa335b6040516001600160a01b03909116815260200160405180910390a1565b6001600160a01b038216610fa05760405163ec442f0560e01b815260006004820152602401610773565b6108a3600083836112d4565b60006106f443610e37565b6001600160a01b038281166000818152600a602052604080822080548686166001600160a01b0319821681179092559151919094169392849290917f3134e8a2e6d97e929a7e54011ea5485d7d196dd5f0ba4d4ef95803e8e3fc257f9190a461072c8183611024866114f8565b611516565b6001600160a01b0381166000908152600b60205260408120546105eb90611682565b6110536116b3565b6006805460ff191660011790557f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258610f593390565b60606106f47f000000000000000000000000000000000000000000000000000000000000000060076116d7565b60606106f47f000000000000000000000000000000000000000000000000000000000000000060086116d7565b80546000908015611112576110fc83610ef2600184612185565b54600160301b90046001600160d01b0316610b16565b60009392505050565b60006105eb611128610c9f565b8360405161190160f01b8152600281019290925260228201526042902090565b60008060008061115a88888888611782565b92509250925061116a8282611851565b50909695505050505050565b6001600160a01b038216600090815260096020526040902080546001810190915581811461072c576040516301d4b62360e61b81526001600160a01b038416600482015260248101829052604401610773565b60408051808201909152600080825260208201526001600160a01b0383166000908152600b60205260409020610b16908361190a565b6001600160a01b0384166112295760405163e602df0560e01b815260006004820152602401610773565b6001600160a01b03831661125357604051634a1406b160e11b815260006004820152602401610773565b6001600160a01b03808516600090815260016020908152604080832093871683529290522082905580156106e457826001600160a01b0316846001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925846040516112c691815260200190565b60405180910390a350505050565b61072c83838361197a565b6112e98282610994565b6108a35760405163e2517d3f60e01b81526001600160a01b038216600482015260248101839052604401610773565b600060018211611326575090565b816001600160801b821061133f5760809190911c9060401b5b68010000000000000000821061135a5760409190911c9060201b5b64010000000082106113715760209190911c9060101b5b6201000082106113865760109190911c9060081b5b610100821061139a5760089190911c9060041b5b601082106113ad5760049190911c9060021b5b600482106113b95760011b5b600302600190811c908185816113d1576113d16121ab565b048201901c905060018185816113e9576113e96121ab565b048201901c90506001818581611401576114016121ab565b048201901c90506001818581611419576114196121ab565b048201901c90506001818581611431576114316121ab565b048201901c90506001818581611449576114496121ab565b048201901c9050611468818581611462576114626121ab565b04821190565b90039392505050565b60005b818310156114cb57600061148884846119e1565b60008781526020902090915065ffffffffffff86169082015465ffffffffffff1611156114b7578092506114c5565b6114c2816001612198565b93505b50611474565b509392505050565b60065460ff166114f657604051638dfc202b60e01b815260040160405180910390fd5b565b6001600160a01b0381166000908152602081905260408120546105eb565b816001600160a01b0316836001600160a01b0316141580156115385750600081115b1561072c576001600160a01b038316156115e0576001600160a01b0383166000908152600b60205260408120819061157b906119fc61157686611a08565b611a3c565b6001600160d01b031691506001600160d01b03169150846001600160a01b03167fdec2bacdd2f05b59de34da9b523dff8be42e5e38e818c82fdb0bae774387a72483836040516115d5929190918252602082015260400190565b60405180910390a250505b6001600160a01b0382161561072c576001600160a01b0382166000908152600b60205260408120819061161990611a7561157686611a08565b6001600160d01b031691506001600160d01b03169150836001600160a01b03167fdec2bacdd2f05b59de34da9b523dff8be42e5e38e818c82fdb0bae774387a7248383604051611673929190918252602082015260400190565b60405180910390a25050505050565b600063ffffffff821115610e6a576040516306dfcc6560e41b81526020600482015260248101839052604401610773565b60065460ff16156114f65760405163d93c066560e01b815260040160405180910390fd5b606060ff83146116f1576116ea83611a81565b90506105eb565b8180546116fd90612135565b80601f016020809104026020016040519081016040528092919081815260200182805461172990612135565b80156117765780601f1061174b57610100808354040283529160200191611776565b820191906000526020600020905b81548152906001019060200180831161175957829003601f168201915b505050505090506105eb565b600080807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08411156117bd5750600091506003905082611847565b604080516000808252602082018084528a905260ff891692820192909252606081018790526080810186905260019060a0016020604051602081039080840390855afa158015611811573d6000803e3d6000fd5b5050604051601f1901519150506001600160a01b03811661183d57506000925060019150829050611847565b9250600091508190505b9450945094915050565b6000826003811115611865576118656121c1565b0361186e575050565b6001826003811115611882576118826121c1565b036118a05760405163f645eedf60e01b815260040160405180910390fd5b60028260038111156118b4576118b46121c1565b036118d55760405163fce698f760e01b815260048101829052602401610773565b60038260038111156118e9576118e96121c1565b036108a3576040516335e2f38360e21b815260048101829052602401610773565b6040805180820190915260008082526020820152826000018263ffffffff1681548110611939576119396121d7565b60009182526020918290206040805180820190915291015465ffffffffffff81168252600160301b90046001600160d01b0316918101919091529392505050565b611985838383611ac0565b6001600160a01b0383166119d657600061199e60025490565b90506001600160d01b03808211156119d357604051630e58ae9360e11b81526004810183905260248101829052604401610773565b50505b61072c838383611b30565b60006119f060028484186121ed565b610b1690848416612198565b6000610b16828461220f565b60006001600160d01b03821115610e6a576040516306dfcc6560e41b815260d0600482015260248101839052604401610773565b600080611a68611a4a6109bf565b611a60611a56886110e2565b868863ffffffff16565b879190611ba6565b915091505b935093915050565b6000610b16828461222e565b60606000611a8e83611bb4565b604080516020808252818301909252919250600091906020820181803683375050509182525060208101929092525090565b611acb838383611bdc565b6001600160a01b03831661072c576002547f00000000000000000000000000000000000000000000000000000000000000009081811115611b295760405163279e7e1560e21b81526004810182905260248101839052604401610773565b5050505050565b6001600160a01b038316611b5257611b4f600c611a7561157684611a08565b50505b6001600160a01b038216611b7457611b71600c6119fc61157684611a08565b50505b6001600160a01b038381166000908152600a602052604080822054858416835291205461072c92918216911683611516565b600080611a68858585611bef565b600060ff8216601f8111156105eb57604051632cd44ac360e21b815260040160405180910390fd5b611be46116b3565b61072c838383611d43565b825460009081908015611ce8576000611c0d87610ef2600185612185565b805490915065ffffffffffff80821691600160301b90046001600160d01b0316908816821115611c5057604051632520601d60e01b815260040160405180910390fd5b8765ffffffffffff168265ffffffffffff1603611c8957825465ffffffffffff16600160301b6001600160d01b03891602178355611cda565b6040805180820190915265ffffffffffff808a1682526001600160d01b03808a1660208085019182528d54600181018f5560008f81529190912094519151909216600160301b029216919091179101555b9450859350611a6d92505050565b50506040805180820190915265ffffffffffff80851682526001600160d01b0380851660208085019182528854600181018a5560008a815291822095519251909316600160301b029190931617920191909155905081611a6d565b6001600160a01b038316611d6e578060026000828254611d639190612198565b90915550611de09050565b6001600160a01b03831660009081526020819052604090205481811015611dc15760405163391434e360e21b81526001600160a01b03851660048201526024810182905260448101839052606401610773565b6001600160a01b03841660009081526020819052604090209082900390555b6001600160a01b038216611dfc57600280548290039055611e1b565b6001600160a01b03821660009081526020819052604090208054820190555b816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef83604051611e6091815260200190565b60405180910390a3505050565b600060208284031215611e7f57600080fd5b81356001600160e01b031981168114610b1657600080fd5b6000815180845260005b81811015611ebd57602081850181015186830182015201611ea1565b506000602082860101526020601f19601f83011685010191505092915050565b602081526000610b166020830184611e97565b80356001600160a01b0381168114611f0757600080fd5b919050565b60008060408385031215611f1f57600080fd5b611f2883611ef0565b946020939093013593505050565b600080600060608486031215611f4b57600080fd5b611f5484611ef0565b9250611f6260208501611ef0565b929592945050506040919091013590565b600060208284031215611f8557600080fd5b5035919050565b60008060408385031215611f9f57600080fd5b82359150611faf60208401611ef0565b90509250929050565b600060208284031215611fca57600080fd5b610b1682611ef0565b60ff60f81b8816815260e060208201526000611ff260e0830189611e97565b82810360408401526120048189611e97565b606084018890526001600160a01b038716608085015260a0840186905283810360c08501528451808252602080870193509091019060005b8181101561205a57835183526020938401939092019160010161203c565b50909b9a5050505050505050505050565b60008060008060008060c0878903121561208457600080fd5b61208d87611ef0565b95506020870135945060408701359350606087013560ff811681146120b157600080fd5b9598949750929560808101359460a0909101359350915050565b600080604083850312156120de57600080fd5b6120e783611ef0565b9150611faf60208401611ef0565b6000806040838503121561210857600080fd5b61211183611ef0565b9150602083013563ffffffff8116811461212a57600080fd5b809150509250929050565b600181811c9082168061214957607f821691505b60208210810361216957634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b818103818111156105eb576105eb61216f565b808201808211156105eb576105eb61216f565b634e487b7160e01b600052601260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b60008261220a57634e487b7160e01b600052601260045260246000fd5b500490565b6001600160d01b0382811682821603908111156105eb576105eb61216f565b6001600160d01b0381811683821601908111156105eb576105eb61216f56fea2646970667358221220b396c8a18224a182680e43c4c1347adf2eaeb433485d9bd21134be7172537c5764736f6c634300081e0033",
+}
+
+// ERC20ExtendedABI is the input ABI used to generate the binding from.
+// Deprecated: Use ERC20ExtendedMetaData.ABI instead.
+var ERC20ExtendedABI = ERC20ExtendedMetaData.ABI
+
+// ERC20ExtendedBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ERC20ExtendedMetaData.Bin instead.
+var ERC20ExtendedBin = ERC20ExtendedMetaData.Bin
+
+// DeployERC20Extended deploys a new Ethereum contract, binding an instance of ERC20Extended to it.
+func DeployERC20Extended(auth *bind.TransactOpts, backend bind.ContractBackend, cap_ *big.Int) (common.Address, *types.Transaction, *ERC20Extended, error) {
+	parsed, err := ERC20ExtendedMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ERC20ExtendedBin), backend, cap_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC20Extended{ERC20ExtendedCaller: ERC20ExtendedCaller{contract: contract}, ERC20ExtendedTransactor: ERC20ExtendedTransactor{contract: contract}, ERC20ExtendedFilterer: ERC20ExtendedFilterer{contract: contract}}, nil
+}
+
+// ERC20Extended is an auto generated Go binding around an Ethereum contract.
+type ERC20Extended struct {
+	ERC20ExtendedCaller     // Read-only binding to the contract
+	ERC20ExtendedTransactor // Write-only binding to the contract
+	ERC20ExtendedFilterer   // Log filterer for contract events
+}
+
+// ERC20ExtendedCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC20ExtendedCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC20ExtendedTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC20ExtendedTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC20ExtendedFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC20ExtendedFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC20ExtendedSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC20ExtendedSession struct {
+	Contract     *ERC20Extended    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC20ExtendedCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC20ExtendedCallerSession struct {
+	Contract *ERC20ExtendedCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// ERC20ExtendedTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC20ExtendedTransactorSession struct {
+	Contract     *ERC20ExtendedTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// ERC20ExtendedRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC20ExtendedRaw struct {
+	Contract *ERC20Extended // Generic contract binding to access the raw methods on
+}
+
+// ERC20ExtendedCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC20ExtendedCallerRaw struct {
+	Contract *ERC20ExtendedCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC20ExtendedTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC20ExtendedTransactorRaw struct {
+	Contract *ERC20ExtendedTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC20Extended creates a new instance of ERC20Extended, bound to a specific deployed contract.
+func NewERC20Extended(address common.Address, backend bind.ContractBackend) (*ERC20Extended, error) {
+	contract, err := bindERC20Extended(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20Extended{ERC20ExtendedCaller: ERC20ExtendedCaller{contract: contract}, ERC20ExtendedTransactor: ERC20ExtendedTransactor{contract: contract}, ERC20ExtendedFilterer: ERC20ExtendedFilterer{contract: contract}}, nil
+}
+
+// NewERC20ExtendedCaller creates a new read-only instance of ERC20Extended, bound to a specific deployed contract.
+func NewERC20ExtendedCaller(address common.Address, caller bind.ContractCaller) (*ERC20ExtendedCaller, error) {
+	contract, err := bindERC20Extended(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20ExtendedCaller{contract: contract}, nil
+}
+
+// NewERC20ExtendedTransactor creates a new write-only instance of ERC20Extended, bound to a specific deployed contract.
+func NewERC20ExtendedTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC20ExtendedTransactor, error) {
+	contract, err := bindERC20Extended(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20ExtendedTransactor{contract: contract}, nil
+}
+
+// NewERC20ExtendedFilterer creates a new log filterer instance of ERC20Extended, bound to a specific deployed contract.
+func NewERC20ExtendedFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC20ExtendedFilterer, error) {
+	contract, err := bindERC20Extended(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20ExtendedFilterer{contract: contract}, nil
+}
+
+// bindERC20Extended binds a generic wrapper to an already deployed contract.
+func bindERC20Extended(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ERC20ExtendedMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC20Extended *ERC20ExtendedRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC20Extended.Contract.ERC20ExtendedCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC20Extended *ERC20ExtendedRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.ERC20ExtendedTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC20Extended *ERC20ExtendedRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.ERC20ExtendedTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC20Extended *ERC20ExtendedCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC20Extended.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC20Extended *ERC20ExtendedTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC20Extended *ERC20ExtendedTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.contract.Transact(opts, method, params...)
+}
+
+// CLOCKMODE is a free data retrieval call binding the contract method 0x4bf5d7e9.
+//
+// Solidity: function CLOCK_MODE() view returns(string)
+func (_ERC20Extended *ERC20ExtendedCaller) CLOCKMODE(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "CLOCK_MODE")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// CLOCKMODE is a free data retrieval call binding the contract method 0x4bf5d7e9.
+//
+// Solidity: function CLOCK_MODE() view returns(string)
+func (_ERC20Extended *ERC20ExtendedSession) CLOCKMODE() (string, error) {
+	return _ERC20Extended.Contract.CLOCKMODE(&_ERC20Extended.CallOpts)
+}
+
+// CLOCKMODE is a free data retrieval call binding the contract method 0x4bf5d7e9.
+//
+// Solidity: function CLOCK_MODE() view returns(string)
+func (_ERC20Extended *ERC20ExtendedCallerSession) CLOCKMODE() (string, error) {
+	return _ERC20Extended.Contract.CLOCKMODE(&_ERC20Extended.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _ERC20Extended.Contract.DEFAULTADMINROLE(&_ERC20Extended.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _ERC20Extended.Contract.DEFAULTADMINROLE(&_ERC20Extended.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedCaller) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "DOMAIN_SEPARATOR")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _ERC20Extended.Contract.DOMAINSEPARATOR(&_ERC20Extended.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedCallerSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _ERC20Extended.Contract.DOMAINSEPARATOR(&_ERC20Extended.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedCaller) MINTERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "MINTER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedSession) MINTERROLE() ([32]byte, error) {
+	return _ERC20Extended.Contract.MINTERROLE(&_ERC20Extended.CallOpts)
+}
+
+// MINTERROLE is a free data retrieval call binding the contract method 0xd5391393.
+//
+// Solidity: function MINTER_ROLE() view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedCallerSession) MINTERROLE() ([32]byte, error) {
+	return _ERC20Extended.Contract.MINTERROLE(&_ERC20Extended.CallOpts)
+}
+
+// PAUSERROLE is a free data retrieval call binding the contract method 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedCaller) PAUSERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "PAUSER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// PAUSERROLE is a free data retrieval call binding the contract method 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedSession) PAUSERROLE() ([32]byte, error) {
+	return _ERC20Extended.Contract.PAUSERROLE(&_ERC20Extended.CallOpts)
+}
+
+// PAUSERROLE is a free data retrieval call binding the contract method 0xe63ab1e9.
+//
+// Solidity: function PAUSER_ROLE() view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedCallerSession) PAUSERROLE() ([32]byte, error) {
+	return _ERC20Extended.Contract.PAUSERROLE(&_ERC20Extended.CallOpts)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ERC20Extended.Contract.Allowance(&_ERC20Extended.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ERC20Extended.Contract.Allowance(&_ERC20Extended.CallOpts, owner, spender)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ERC20Extended.Contract.BalanceOf(&_ERC20Extended.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ERC20Extended.Contract.BalanceOf(&_ERC20Extended.CallOpts, account)
+}
+
+// Cap is a free data retrieval call binding the contract method 0x355274ea.
+//
+// Solidity: function cap() view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCaller) Cap(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "cap")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Cap is a free data retrieval call binding the contract method 0x355274ea.
+//
+// Solidity: function cap() view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedSession) Cap() (*big.Int, error) {
+	return _ERC20Extended.Contract.Cap(&_ERC20Extended.CallOpts)
+}
+
+// Cap is a free data retrieval call binding the contract method 0x355274ea.
+//
+// Solidity: function cap() view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCallerSession) Cap() (*big.Int, error) {
+	return _ERC20Extended.Contract.Cap(&_ERC20Extended.CallOpts)
+}
+
+// Checkpoints is a free data retrieval call binding the contract method 0xf1127ed8.
+//
+// Solidity: function checkpoints(address account, uint32 pos) view returns((uint48,uint208))
+func (_ERC20Extended *ERC20ExtendedCaller) Checkpoints(opts *bind.CallOpts, account common.Address, pos uint32) (CheckpointsCheckpoint208, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "checkpoints", account, pos)
+
+	if err != nil {
+		return *new(CheckpointsCheckpoint208), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(CheckpointsCheckpoint208)).(*CheckpointsCheckpoint208)
+
+	return out0, err
+
+}
+
+// Checkpoints is a free data retrieval call binding the contract method 0xf1127ed8.
+//
+// Solidity: function checkpoints(address account, uint32 pos) view returns((uint48,uint208))
+func (_ERC20Extended *ERC20ExtendedSession) Checkpoints(account common.Address, pos uint32) (CheckpointsCheckpoint208, error) {
+	return _ERC20Extended.Contract.Checkpoints(&_ERC20Extended.CallOpts, account, pos)
+}
+
+// Checkpoints is a free data retrieval call binding the contract method 0xf1127ed8.
+//
+// Solidity: function checkpoints(address account, uint32 pos) view returns((uint48,uint208))
+func (_ERC20Extended *ERC20ExtendedCallerSession) Checkpoints(account common.Address, pos uint32) (CheckpointsCheckpoint208, error) {
+	return _ERC20Extended.Contract.Checkpoints(&_ERC20Extended.CallOpts, account, pos)
+}
+
+// Clock is a free data retrieval call binding the contract method 0x91ddadf4.
+//
+// Solidity: function clock() view returns(uint48)
+func (_ERC20Extended *ERC20ExtendedCaller) Clock(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "clock")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Clock is a free data retrieval call binding the contract method 0x91ddadf4.
+//
+// Solidity: function clock() view returns(uint48)
+func (_ERC20Extended *ERC20ExtendedSession) Clock() (*big.Int, error) {
+	return _ERC20Extended.Contract.Clock(&_ERC20Extended.CallOpts)
+}
+
+// Clock is a free data retrieval call binding the contract method 0x91ddadf4.
+//
+// Solidity: function clock() view returns(uint48)
+func (_ERC20Extended *ERC20ExtendedCallerSession) Clock() (*big.Int, error) {
+	return _ERC20Extended.Contract.Clock(&_ERC20Extended.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC20Extended *ERC20ExtendedCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC20Extended *ERC20ExtendedSession) Decimals() (uint8, error) {
+	return _ERC20Extended.Contract.Decimals(&_ERC20Extended.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC20Extended *ERC20ExtendedCallerSession) Decimals() (uint8, error) {
+	return _ERC20Extended.Contract.Decimals(&_ERC20Extended.CallOpts)
+}
+
+// Delegates is a free data retrieval call binding the contract method 0x587cde1e.
+//
+// Solidity: function delegates(address account) view returns(address)
+func (_ERC20Extended *ERC20ExtendedCaller) Delegates(opts *bind.CallOpts, account common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "delegates", account)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Delegates is a free data retrieval call binding the contract method 0x587cde1e.
+//
+// Solidity: function delegates(address account) view returns(address)
+func (_ERC20Extended *ERC20ExtendedSession) Delegates(account common.Address) (common.Address, error) {
+	return _ERC20Extended.Contract.Delegates(&_ERC20Extended.CallOpts, account)
+}
+
+// Delegates is a free data retrieval call binding the contract method 0x587cde1e.
+//
+// Solidity: function delegates(address account) view returns(address)
+func (_ERC20Extended *ERC20ExtendedCallerSession) Delegates(account common.Address) (common.Address, error) {
+	return _ERC20Extended.Contract.Delegates(&_ERC20Extended.CallOpts, account)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_ERC20Extended *ERC20ExtendedCaller) Eip712Domain(opts *bind.CallOpts) (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "eip712Domain")
+
+	outstruct := new(struct {
+		Fields            [1]byte
+		Name              string
+		Version           string
+		ChainId           *big.Int
+		VerifyingContract common.Address
+		Salt              [32]byte
+		Extensions        []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Fields = *abi.ConvertType(out[0], new([1]byte)).(*[1]byte)
+	outstruct.Name = *abi.ConvertType(out[1], new(string)).(*string)
+	outstruct.Version = *abi.ConvertType(out[2], new(string)).(*string)
+	outstruct.ChainId = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.VerifyingContract = *abi.ConvertType(out[4], new(common.Address)).(*common.Address)
+	outstruct.Salt = *abi.ConvertType(out[5], new([32]byte)).(*[32]byte)
+	outstruct.Extensions = *abi.ConvertType(out[6], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_ERC20Extended *ERC20ExtendedSession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _ERC20Extended.Contract.Eip712Domain(&_ERC20Extended.CallOpts)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_ERC20Extended *ERC20ExtendedCallerSession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _ERC20Extended.Contract.Eip712Domain(&_ERC20Extended.CallOpts)
+}
+
+// GetPastTotalSupply is a free data retrieval call binding the contract method 0x8e539e8c.
+//
+// Solidity: function getPastTotalSupply(uint256 timepoint) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCaller) GetPastTotalSupply(opts *bind.CallOpts, timepoint *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "getPastTotalSupply", timepoint)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetPastTotalSupply is a free data retrieval call binding the contract method 0x8e539e8c.
+//
+// Solidity: function getPastTotalSupply(uint256 timepoint) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedSession) GetPastTotalSupply(timepoint *big.Int) (*big.Int, error) {
+	return _ERC20Extended.Contract.GetPastTotalSupply(&_ERC20Extended.CallOpts, timepoint)
+}
+
+// GetPastTotalSupply is a free data retrieval call binding the contract method 0x8e539e8c.
+//
+// Solidity: function getPastTotalSupply(uint256 timepoint) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCallerSession) GetPastTotalSupply(timepoint *big.Int) (*big.Int, error) {
+	return _ERC20Extended.Contract.GetPastTotalSupply(&_ERC20Extended.CallOpts, timepoint)
+}
+
+// GetPastVotes is a free data retrieval call binding the contract method 0x3a46b1a8.
+//
+// Solidity: function getPastVotes(address account, uint256 timepoint) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCaller) GetPastVotes(opts *bind.CallOpts, account common.Address, timepoint *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "getPastVotes", account, timepoint)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetPastVotes is a free data retrieval call binding the contract method 0x3a46b1a8.
+//
+// Solidity: function getPastVotes(address account, uint256 timepoint) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedSession) GetPastVotes(account common.Address, timepoint *big.Int) (*big.Int, error) {
+	return _ERC20Extended.Contract.GetPastVotes(&_ERC20Extended.CallOpts, account, timepoint)
+}
+
+// GetPastVotes is a free data retrieval call binding the contract method 0x3a46b1a8.
+//
+// Solidity: function getPastVotes(address account, uint256 timepoint) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCallerSession) GetPastVotes(account common.Address, timepoint *big.Int) (*big.Int, error) {
+	return _ERC20Extended.Contract.GetPastVotes(&_ERC20Extended.CallOpts, account, timepoint)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _ERC20Extended.Contract.GetRoleAdmin(&_ERC20Extended.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_ERC20Extended *ERC20ExtendedCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _ERC20Extended.Contract.GetRoleAdmin(&_ERC20Extended.CallOpts, role)
+}
+
+// GetVotes is a free data retrieval call binding the contract method 0x9ab24eb0.
+//
+// Solidity: function getVotes(address account) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCaller) GetVotes(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "getVotes", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetVotes is a free data retrieval call binding the contract method 0x9ab24eb0.
+//
+// Solidity: function getVotes(address account) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedSession) GetVotes(account common.Address) (*big.Int, error) {
+	return _ERC20Extended.Contract.GetVotes(&_ERC20Extended.CallOpts, account)
+}
+
+// GetVotes is a free data retrieval call binding the contract method 0x9ab24eb0.
+//
+// Solidity: function getVotes(address account) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCallerSession) GetVotes(account common.Address) (*big.Int, error) {
+	return _ERC20Extended.Contract.GetVotes(&_ERC20Extended.CallOpts, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_ERC20Extended *ERC20ExtendedCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_ERC20Extended *ERC20ExtendedSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _ERC20Extended.Contract.HasRole(&_ERC20Extended.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_ERC20Extended *ERC20ExtendedCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _ERC20Extended.Contract.HasRole(&_ERC20Extended.CallOpts, role, account)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC20Extended *ERC20ExtendedCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC20Extended *ERC20ExtendedSession) Name() (string, error) {
+	return _ERC20Extended.Contract.Name(&_ERC20Extended.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC20Extended *ERC20ExtendedCallerSession) Name() (string, error) {
+	return _ERC20Extended.Contract.Name(&_ERC20Extended.CallOpts)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCaller) Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "nonces", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _ERC20Extended.Contract.Nonces(&_ERC20Extended.CallOpts, owner)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCallerSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _ERC20Extended.Contract.Nonces(&_ERC20Extended.CallOpts, owner)
+}
+
+// NumCheckpoints is a free data retrieval call binding the contract method 0x6fcfff45.
+//
+// Solidity: function numCheckpoints(address account) view returns(uint32)
+func (_ERC20Extended *ERC20ExtendedCaller) NumCheckpoints(opts *bind.CallOpts, account common.Address) (uint32, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "numCheckpoints", account)
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// NumCheckpoints is a free data retrieval call binding the contract method 0x6fcfff45.
+//
+// Solidity: function numCheckpoints(address account) view returns(uint32)
+func (_ERC20Extended *ERC20ExtendedSession) NumCheckpoints(account common.Address) (uint32, error) {
+	return _ERC20Extended.Contract.NumCheckpoints(&_ERC20Extended.CallOpts, account)
+}
+
+// NumCheckpoints is a free data retrieval call binding the contract method 0x6fcfff45.
+//
+// Solidity: function numCheckpoints(address account) view returns(uint32)
+func (_ERC20Extended *ERC20ExtendedCallerSession) NumCheckpoints(account common.Address) (uint32, error) {
+	return _ERC20Extended.Contract.NumCheckpoints(&_ERC20Extended.CallOpts, account)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_ERC20Extended *ERC20ExtendedCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_ERC20Extended *ERC20ExtendedSession) Paused() (bool, error) {
+	return _ERC20Extended.Contract.Paused(&_ERC20Extended.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_ERC20Extended *ERC20ExtendedCallerSession) Paused() (bool, error) {
+	return _ERC20Extended.Contract.Paused(&_ERC20Extended.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC20Extended *ERC20ExtendedCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC20Extended *ERC20ExtendedSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC20Extended.Contract.SupportsInterface(&_ERC20Extended.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC20Extended *ERC20ExtendedCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC20Extended.Contract.SupportsInterface(&_ERC20Extended.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC20Extended *ERC20ExtendedCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC20Extended *ERC20ExtendedSession) Symbol() (string, error) {
+	return _ERC20Extended.Contract.Symbol(&_ERC20Extended.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC20Extended *ERC20ExtendedCallerSession) Symbol() (string, error) {
+	return _ERC20Extended.Contract.Symbol(&_ERC20Extended.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Extended.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedSession) TotalSupply() (*big.Int, error) {
+	return _ERC20Extended.Contract.TotalSupply(&_ERC20Extended.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC20Extended *ERC20ExtendedCallerSession) TotalSupply() (*big.Int, error) {
+	return _ERC20Extended.Contract.TotalSupply(&_ERC20Extended.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC20Extended *ERC20ExtendedTransactor) Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Extended.contract.Transact(opts, "approve", spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC20Extended *ERC20ExtendedSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.Approve(&_ERC20Extended.TransactOpts, spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC20Extended *ERC20ExtendedTransactorSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.Approve(&_ERC20Extended.TransactOpts, spender, value)
+}
+
+// Delegate is a paid mutator transaction binding the contract method 0x5c19a95c.
+//
+// Solidity: function delegate(address delegatee) returns()
+func (_ERC20Extended *ERC20ExtendedTransactor) Delegate(opts *bind.TransactOpts, delegatee common.Address) (*types.Transaction, error) {
+	return _ERC20Extended.contract.Transact(opts, "delegate", delegatee)
+}
+
+// Delegate is a paid mutator transaction binding the contract method 0x5c19a95c.
+//
+// Solidity: function delegate(address delegatee) returns()
+func (_ERC20Extended *ERC20ExtendedSession) Delegate(delegatee common.Address) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.Delegate(&_ERC20Extended.TransactOpts, delegatee)
+}
+
+// Delegate is a paid mutator transaction binding the contract method 0x5c19a95c.
+//
+// Solidity: function delegate(address delegatee) returns()
+func (_ERC20Extended *ERC20ExtendedTransactorSession) Delegate(delegatee common.Address) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.Delegate(&_ERC20Extended.TransactOpts, delegatee)
+}
+
+// DelegateBySig is a paid mutator transaction binding the contract method 0xc3cda520.
+//
+// Solidity: function delegateBySig(address delegatee, uint256 nonce, uint256 expiry, uint8 v, bytes32 r, bytes32 s) returns()
+func (_ERC20Extended *ERC20ExtendedTransactor) DelegateBySig(opts *bind.TransactOpts, delegatee common.Address, nonce *big.Int, expiry *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _ERC20Extended.contract.Transact(opts, "delegateBySig", delegatee, nonce, expiry, v, r, s)
+}
+
+// DelegateBySig is a paid mutator transaction binding the contract method 0xc3cda520.
+//
+// Solidity: function delegateBySig(address delegatee, uint256 nonce, uint256 expiry, uint8 v, bytes32 r, bytes32 s) returns()
+func (_ERC20Extended *ERC20ExtendedSession) DelegateBySig(delegatee common.Address, nonce *big.Int, expiry *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.DelegateBySig(&_ERC20Extended.TransactOpts, delegatee, nonce, expiry, v, r, s)
+}
+
+// DelegateBySig is a paid mutator transaction binding the contract method 0xc3cda520.
+//
+// Solidity: function delegateBySig(address delegatee, uint256 nonce, uint256 expiry, uint8 v, bytes32 r, bytes32 s) returns()
+func (_ERC20Extended *ERC20ExtendedTransactorSession) DelegateBySig(delegatee common.Address, nonce *big.Int, expiry *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.DelegateBySig(&_ERC20Extended.TransactOpts, delegatee, nonce, expiry, v, r, s)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_ERC20Extended *ERC20ExtendedTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ERC20Extended.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_ERC20Extended *ERC20ExtendedSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.GrantRole(&_ERC20Extended.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_ERC20Extended *ERC20ExtendedTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.GrantRole(&_ERC20Extended.TransactOpts, role, account)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 value) returns()
+func (_ERC20Extended *ERC20ExtendedTransactor) Mint(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Extended.contract.Transact(opts, "mint", to, value)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 value) returns()
+func (_ERC20Extended *ERC20ExtendedSession) Mint(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.Mint(&_ERC20Extended.TransactOpts, to, value)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 value) returns()
+func (_ERC20Extended *ERC20ExtendedTransactorSession) Mint(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.Mint(&_ERC20Extended.TransactOpts, to, value)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_ERC20Extended *ERC20ExtendedTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC20Extended.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_ERC20Extended *ERC20ExtendedSession) Pause() (*types.Transaction, error) {
+	return _ERC20Extended.Contract.Pause(&_ERC20Extended.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_ERC20Extended *ERC20ExtendedTransactorSession) Pause() (*types.Transaction, error) {
+	return _ERC20Extended.Contract.Pause(&_ERC20Extended.TransactOpts)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_ERC20Extended *ERC20ExtendedTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _ERC20Extended.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_ERC20Extended *ERC20ExtendedSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.RenounceRole(&_ERC20Extended.TransactOpts, role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_ERC20Extended *ERC20ExtendedTransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.RenounceRole(&_ERC20Extended.TransactOpts, role, callerConfirmation)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_ERC20Extended *ERC20ExtendedTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ERC20Extended.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_ERC20Extended *ERC20ExtendedSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.RevokeRole(&_ERC20Extended.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_ERC20Extended *ERC20ExtendedTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.RevokeRole(&_ERC20Extended.TransactOpts, role, account)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC20Extended *ERC20ExtendedTransactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Extended.contract.Transact(opts, "transfer", to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC20Extended *ERC20ExtendedSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.Transfer(&_ERC20Extended.TransactOpts, to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC20Extended *ERC20ExtendedTransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.Transfer(&_ERC20Extended.TransactOpts, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC20Extended *ERC20ExtendedTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Extended.contract.Transact(opts, "transferFrom", from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC20Extended *ERC20ExtendedSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.TransferFrom(&_ERC20Extended.TransactOpts, from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC20Extended *ERC20ExtendedTransactorSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC20Extended.Contract.TransferFrom(&_ERC20Extended.TransactOpts, from, to, value)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_ERC20Extended *ERC20ExtendedTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC20Extended.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_ERC20Extended *ERC20ExtendedSession) Unpause() (*types.Transaction, error) {
+	return _ERC20Extended.Contract.Unpause(&_ERC20Extended.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_ERC20Extended *ERC20ExtendedTransactorSession) Unpause() (*types.Transaction, error) {
+	return _ERC20Extended.Contract.Unpause(&_ERC20Extended.TransactOpts)
+}
+
+// ERC20ExtendedApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the ERC20Extended contract.
+type ERC20ExtendedApprovalIterator struct {
+	Event *ERC20ExtendedApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20ExtendedApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20ExtendedApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20ExtendedApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20ExtendedApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20ExtendedApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20ExtendedApproval represents a Approval event raised by the ERC20Extended contract.
+type ERC20ExtendedApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC20Extended *ERC20ExtendedFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*ERC20ExtendedApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20ExtendedApprovalIterator{contract: _ERC20Extended.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC20Extended *ERC20ExtendedFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *ERC20ExtendedApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20ExtendedApproval)
+				if err := _ERC20Extended.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC20Extended *ERC20ExtendedFilterer) ParseApproval(log types.Log) (*ERC20ExtendedApproval, error) {
+	event := new(ERC20ExtendedApproval)
+	if err := _ERC20Extended.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20ExtendedDelegateChangedIterator is returned from FilterDelegateChanged and is used to iterate over the raw logs and unpacked data for DelegateChanged events raised by the ERC20Extended contract.
+type ERC20ExtendedDelegateChangedIterator struct {
+	Event *ERC20ExtendedDelegateChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20ExtendedDelegateChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20ExtendedDelegateChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20ExtendedDelegateChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20ExtendedDelegateChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20ExtendedDelegateChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20ExtendedDelegateChanged represents a DelegateChanged event raised by the ERC20Extended contract.
+type ERC20ExtendedDelegateChanged struct {
+	Delegator    common.Address
+	FromDelegate common.Address
+	ToDelegate   common.Address
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterDelegateChanged is a free log retrieval operation binding the contract event 0x3134e8a2e6d97e929a7e54011ea5485d7d196dd5f0ba4d4ef95803e8e3fc257f.
+//
+// Solidity: event DelegateChanged(address indexed delegator, address indexed fromDelegate, address indexed toDelegate)
+func (_ERC20Extended *ERC20ExtendedFilterer) FilterDelegateChanged(opts *bind.FilterOpts, delegator []common.Address, fromDelegate []common.Address, toDelegate []common.Address) (*ERC20ExtendedDelegateChangedIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var fromDelegateRule []interface{}
+	for _, fromDelegateItem := range fromDelegate {
+		fromDelegateRule = append(fromDelegateRule, fromDelegateItem)
+	}
+	var toDelegateRule []interface{}
+	for _, toDelegateItem := range toDelegate {
+		toDelegateRule = append(toDelegateRule, toDelegateItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.FilterLogs(opts, "DelegateChanged", delegatorRule, fromDelegateRule, toDelegateRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20ExtendedDelegateChangedIterator{contract: _ERC20Extended.contract, event: "DelegateChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchDelegateChanged is a free log subscription operation binding the contract event 0x3134e8a2e6d97e929a7e54011ea5485d7d196dd5f0ba4d4ef95803e8e3fc257f.
+//
+// Solidity: event DelegateChanged(address indexed delegator, address indexed fromDelegate, address indexed toDelegate)
+func (_ERC20Extended *ERC20ExtendedFilterer) WatchDelegateChanged(opts *bind.WatchOpts, sink chan<- *ERC20ExtendedDelegateChanged, delegator []common.Address, fromDelegate []common.Address, toDelegate []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var fromDelegateRule []interface{}
+	for _, fromDelegateItem := range fromDelegate {
+		fromDelegateRule = append(fromDelegateRule, fromDelegateItem)
+	}
+	var toDelegateRule []interface{}
+	for _, toDelegateItem := range toDelegate {
+		toDelegateRule = append(toDelegateRule, toDelegateItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.WatchLogs(opts, "DelegateChanged", delegatorRule, fromDelegateRule, toDelegateRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20ExtendedDelegateChanged)
+				if err := _ERC20Extended.contract.UnpackLog(event, "DelegateChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDelegateChanged is a log parse operation binding the contract event 0x3134e8a2e6d97e929a7e54011ea5485d7d196dd5f0ba4d4ef95803e8e3fc257f.
+//
+// Solidity: event DelegateChanged(address indexed delegator, address indexed fromDelegate, address indexed toDelegate)
+func (_ERC20Extended *ERC20ExtendedFilterer) ParseDelegateChanged(log types.Log) (*ERC20ExtendedDelegateChanged, error) {
+	event := new(ERC20ExtendedDelegateChanged)
+	if err := _ERC20Extended.contract.UnpackLog(event, "DelegateChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20ExtendedDelegateVotesChangedIterator is returned from FilterDelegateVotesChanged and is used to iterate over the raw logs and unpacked data for DelegateVotesChanged events raised by the ERC20Extended contract.
+type ERC20ExtendedDelegateVotesChangedIterator struct {
+	Event *ERC20ExtendedDelegateVotesChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20ExtendedDelegateVotesChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20ExtendedDelegateVotesChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20ExtendedDelegateVotesChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20ExtendedDelegateVotesChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20ExtendedDelegateVotesChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20ExtendedDelegateVotesChanged represents a DelegateVotesChanged event raised by the ERC20Extended contract.
+type ERC20ExtendedDelegateVotesChanged struct {
+	Delegate      common.Address
+	PreviousVotes *big.Int
+	NewVotes      *big.Int
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterDelegateVotesChanged is a free log retrieval operation binding the contract event 0xdec2bacdd2f05b59de34da9b523dff8be42e5e38e818c82fdb0bae774387a724.
+//
+// Solidity: event DelegateVotesChanged(address indexed delegate, uint256 previousVotes, uint256 newVotes)
+func (_ERC20Extended *ERC20ExtendedFilterer) FilterDelegateVotesChanged(opts *bind.FilterOpts, delegate []common.Address) (*ERC20ExtendedDelegateVotesChangedIterator, error) {
+
+	var delegateRule []interface{}
+	for _, delegateItem := range delegate {
+		delegateRule = append(delegateRule, delegateItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.FilterLogs(opts, "DelegateVotesChanged", delegateRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20ExtendedDelegateVotesChangedIterator{contract: _ERC20Extended.contract, event: "DelegateVotesChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchDelegateVotesChanged is a free log subscription operation binding the contract event 0xdec2bacdd2f05b59de34da9b523dff8be42e5e38e818c82fdb0bae774387a724.
+//
+// Solidity: event DelegateVotesChanged(address indexed delegate, uint256 previousVotes, uint256 newVotes)
+func (_ERC20Extended *ERC20ExtendedFilterer) WatchDelegateVotesChanged(opts *bind.WatchOpts, sink chan<- *ERC20ExtendedDelegateVotesChanged, delegate []common.Address) (event.Subscription, error) {
+
+	var delegateRule []interface{}
+	for _, delegateItem := range delegate {
+		delegateRule = append(delegateRule, delegateItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.WatchLogs(opts, "DelegateVotesChanged", delegateRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20ExtendedDelegateVotesChanged)
+				if err := _ERC20Extended.contract.UnpackLog(event, "DelegateVotesChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDelegateVotesChanged is a log parse operation binding the contract event 0xdec2bacdd2f05b59de34da9b523dff8be42e5e38e818c82fdb0bae774387a724.
+//
+// Solidity: event DelegateVotesChanged(address indexed delegate, uint256 previousVotes, uint256 newVotes)
+func (_ERC20Extended *ERC20ExtendedFilterer) ParseDelegateVotesChanged(log types.Log) (*ERC20ExtendedDelegateVotesChanged, error) {
+	event := new(ERC20ExtendedDelegateVotesChanged)
+	if err := _ERC20Extended.contract.UnpackLog(event, "DelegateVotesChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20ExtendedEIP712DomainChangedIterator is returned from FilterEIP712DomainChanged and is used to iterate over the raw logs and unpacked data for EIP712DomainChanged events raised by the ERC20Extended contract.
+type ERC20ExtendedEIP712DomainChangedIterator struct {
+	Event *ERC20ExtendedEIP712DomainChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20ExtendedEIP712DomainChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20ExtendedEIP712DomainChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20ExtendedEIP712DomainChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20ExtendedEIP712DomainChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20ExtendedEIP712DomainChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20ExtendedEIP712DomainChanged represents a EIP712DomainChanged event raised by the ERC20Extended contract.
+type ERC20ExtendedEIP712DomainChanged struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterEIP712DomainChanged is a free log retrieval operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_ERC20Extended *ERC20ExtendedFilterer) FilterEIP712DomainChanged(opts *bind.FilterOpts) (*ERC20ExtendedEIP712DomainChangedIterator, error) {
+
+	logs, sub, err := _ERC20Extended.contract.FilterLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20ExtendedEIP712DomainChangedIterator{contract: _ERC20Extended.contract, event: "EIP712DomainChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchEIP712DomainChanged is a free log subscription operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_ERC20Extended *ERC20ExtendedFilterer) WatchEIP712DomainChanged(opts *bind.WatchOpts, sink chan<- *ERC20ExtendedEIP712DomainChanged) (event.Subscription, error) {
+
+	logs, sub, err := _ERC20Extended.contract.WatchLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20ExtendedEIP712DomainChanged)
+				if err := _ERC20Extended.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEIP712DomainChanged is a log parse operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_ERC20Extended *ERC20ExtendedFilterer) ParseEIP712DomainChanged(log types.Log) (*ERC20ExtendedEIP712DomainChanged, error) {
+	event := new(ERC20ExtendedEIP712DomainChanged)
+	if err := _ERC20Extended.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20ExtendedPausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the ERC20Extended contract.
+type ERC20ExtendedPausedIterator struct {
+	Event *ERC20ExtendedPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20ExtendedPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20ExtendedPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20ExtendedPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20ExtendedPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20ExtendedPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20ExtendedPaused represents a Paused event raised by the ERC20Extended contract.
+type ERC20ExtendedPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_ERC20Extended *ERC20ExtendedFilterer) FilterPaused(opts *bind.FilterOpts) (*ERC20ExtendedPausedIterator, error) {
+
+	logs, sub, err := _ERC20Extended.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20ExtendedPausedIterator{contract: _ERC20Extended.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_ERC20Extended *ERC20ExtendedFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *ERC20ExtendedPaused) (event.Subscription, error) {
+
+	logs, sub, err := _ERC20Extended.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20ExtendedPaused)
+				if err := _ERC20Extended.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_ERC20Extended *ERC20ExtendedFilterer) ParsePaused(log types.Log) (*ERC20ExtendedPaused, error) {
+	event := new(ERC20ExtendedPaused)
+	if err := _ERC20Extended.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20ExtendedRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the ERC20Extended contract.
+type ERC20ExtendedRoleAdminChangedIterator struct {
+	Event *ERC20ExtendedRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20ExtendedRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20ExtendedRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20ExtendedRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20ExtendedRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20ExtendedRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20ExtendedRoleAdminChanged represents a RoleAdminChanged event raised by the ERC20Extended contract.
+type ERC20ExtendedRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_ERC20Extended *ERC20ExtendedFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*ERC20ExtendedRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20ExtendedRoleAdminChangedIterator{contract: _ERC20Extended.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_ERC20Extended *ERC20ExtendedFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *ERC20ExtendedRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20ExtendedRoleAdminChanged)
+				if err := _ERC20Extended.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_ERC20Extended *ERC20ExtendedFilterer) ParseRoleAdminChanged(log types.Log) (*ERC20ExtendedRoleAdminChanged, error) {
+	event := new(ERC20ExtendedRoleAdminChanged)
+	if err := _ERC20Extended.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20ExtendedRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the ERC20Extended contract.
+type ERC20ExtendedRoleGrantedIterator struct {
+	Event *ERC20ExtendedRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20ExtendedRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20ExtendedRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20ExtendedRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20ExtendedRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20ExtendedRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20ExtendedRoleGranted represents a RoleGranted event raised by the ERC20Extended contract.
+type ERC20ExtendedRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ERC20Extended *ERC20ExtendedFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*ERC20ExtendedRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20ExtendedRoleGrantedIterator{contract: _ERC20Extended.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ERC20Extended *ERC20ExtendedFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *ERC20ExtendedRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20ExtendedRoleGranted)
+				if err := _ERC20Extended.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ERC20Extended *ERC20ExtendedFilterer) ParseRoleGranted(log types.Log) (*ERC20ExtendedRoleGranted, error) {
+	event := new(ERC20ExtendedRoleGranted)
+	if err := _ERC20Extended.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20ExtendedRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the ERC20Extended contract.
+type ERC20ExtendedRoleRevokedIterator struct {
+	Event *ERC20ExtendedRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20ExtendedRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20ExtendedRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20ExtendedRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20ExtendedRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20ExtendedRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20ExtendedRoleRevoked represents a RoleRevoked event raised by the ERC20Extended contract.
+type ERC20ExtendedRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ERC20Extended *ERC20ExtendedFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*ERC20ExtendedRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20ExtendedRoleRevokedIterator{contract: _ERC20Extended.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ERC20Extended *ERC20ExtendedFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *ERC20ExtendedRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20ExtendedRoleRevoked)
+				if err := _ERC20Extended.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_ERC20Extended *ERC20ExtendedFilterer) ParseRoleRevoked(log types.Log) (*ERC20ExtendedRoleRevoked, error) {
+	event := new(ERC20ExtendedRoleRevoked)
+	if err := _ERC20Extended.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20ExtendedTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the ERC20Extended contract.
+type ERC20ExtendedTransferIterator struct {
+	Event *ERC20ExtendedTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20ExtendedTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20ExtendedTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20ExtendedTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20ExtendedTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20ExtendedTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20ExtendedTransfer represents a Transfer event raised by the ERC20Extended contract.
+type ERC20ExtendedTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC20Extended *ERC20ExtendedFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*ERC20ExtendedTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20ExtendedTransferIterator{contract: _ERC20Extended.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC20Extended *ERC20ExtendedFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ERC20ExtendedTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC20Extended.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20ExtendedTransfer)
+				if err := _ERC20Extended.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC20Extended *ERC20ExtendedFilterer) ParseTransfer(log types.Log) (*ERC20ExtendedTransfer, error) {
+	event := new(ERC20ExtendedTransfer)
+	if err := _ERC20Extended.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20ExtendedUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the ERC20Extended contract.
+type ERC20ExtendedUnpausedIterator struct {
+	Event *ERC20ExtendedUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20ExtendedUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20ExtendedUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20ExtendedUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20ExtendedUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20ExtendedUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20ExtendedUnpaused represents a Unpaused event raised by the ERC20Extended contract.
+type ERC20ExtendedUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_ERC20Extended *ERC20ExtendedFilterer) FilterUnpaused(opts *bind.FilterOpts) (*ERC20ExtendedUnpausedIterator, error) {
+
+	logs, sub, err := _ERC20Extended.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20ExtendedUnpausedIterator{contract: _ERC20Extended.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_ERC20Extended *ERC20ExtendedFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *ERC20ExtendedUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _ERC20Extended.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20ExtendedUnpaused)
+				if err := _ERC20Extended.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_ERC20Extended *ERC20ExtendedFilterer) ParseUnpaused(log types.Log) (*ERC20ExtendedUnpaused, error) {
+	event := new(ERC20ExtendedUnpaused)
+	if err := _ERC20Extended.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,1535 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ERC4626Vault
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ERC4626VaultMetaData contains all meta data concerning the ERC4626Vault contract.
+var ERC4626VaultMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"contractIERC20\",\"name\":\"asset_\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"allowance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientAllowance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientBalance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidApprover\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSpender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"max\",\"type\":\"uint256\"}],\"name\":\"ERC4626ExceededMaxDeposit\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"max\",\"type\":\"uint256\"}],\"name\":\"ERC4626ExceededMaxMint\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"max\",\"type\":\"uint256\"}],\"name\":\"ERC4626ExceededMaxRedeem\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"max\",\"type\":\"uint256\"}],\"name\":\"ERC4626ExceededMaxWithdraw\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"}],\"name\":\"SafeERC20FailedOperation\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"Deposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"Withdraw\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"asset\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"convertToAssets\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"name\":\"convertToShares\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"deposit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"maxDeposit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"maxMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"maxRedeem\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"maxWithdraw\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"name\":\"previewDeposit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"previewMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"previewRedeem\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"name\":\"previewWithdraw\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"redeem\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalAssets\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"withdraw\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60c060405234801561001057600080fd5b5060405161155938038061155983398101604081905261002f916101a3565b806040518060400160405280600581526020016415985d5b1d60da1b8152506040518060400160405280600381526020016215931560ea1b81525081600390816100799190610272565b5060046100868282610272565b50505060008061009b836100c760201b60201c565b91509150816100ab5760126100ad565b805b60ff1660a05250506001600160a01b031660805250610378565b60408051600481526024810182526020810180516001600160e01b031663313ce56760e01b17905290516000918291829182916001600160a01b0387169161010e91610330565b600060405180830381855afa9150503d8060008114610149576040519150601f19603f3d011682016040523d82523d6000602084013e61014e565b606091505b509150915081801561016257506020815110155b156101965760008180602001905181019061017d919061035f565b905060ff8111610194576001969095509350505050565b505b5060009485945092505050565b6000602082840312156101b557600080fd5b81516001600160a01b03811681146101cc57600080fd5b9392505050565b634e487b7160e01b600052604160045260246000fd5b600181811c908216806101fd57607f821691505b60208210810361021d57634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561026d57806000526020600020601f840160051c8101602085101561024a5750805b601f840160051c820191505b8181101561026a5760008155600101610256565b50505b505050565b81516001600160401b0381111561028b5761028b6101d3565b61029f8161029984546101e9565b84610223565b6020601f8211600181146102d357600083156102bb5750848201515b600019600385901b1c1916600184901b17845561026a565b600084815260208120601f198516915b8281101561030357878501518255602094850194600190920191016102e3565b50848210156103215786840151600019600387901b60f8161c191681555b50505050600190811b01905550565b6000825160005b818110156103515760208186018101518583015201610337565b506000920191825250919050565b60006020828403121561037157600080fd5b5051919050565b60805160a0516111a76103b2600039600061050f0152600081816102330152818161039f015281816107f001526108b001526111a76000f3fe608060405234801561001057600080fd5b50600436106101735760003560e01c806370a08231116100de578063ba08765211610097578063ce96cb7711610071578063ce96cb7714610328578063d905777e1461033b578063dd62ed3e1461034e578063ef8b30f71461031557600080fd5b8063ba08765214610302578063c63d75b61461025d578063c6e6f5921461031557600080fd5b806370a082311461028557806394bf804d146102ae57806395d89b41146102c1578063a9059cbb146102c9578063b3d7f6b9146102dc578063b460af94146102ef57600080fd5b806323b872dd1161013057806323b872dd146101f9578063313ce5671461020c57806338d52e0f14610226578063402d267d1461025d5780634cdad506146101a85780636e553f651461027257600080fd5b806301e1d1141461017857806306fdde031461019357806307a2d13a146101a8578063095ea7b3146101bb5780630a28a477146101de57806318160ddd146101f1575b600080fd5b610180610387565b6040519081526020015b60405180910390f35b61019b610417565b60405161018a9190610dd2565b6101806101b6366004610e20565b6104a9565b6101ce6101c9366004610e55565b6104bc565b604051901515815260200161018a565b6101806101ec366004610e20565b6104d4565b600254610180565b6101ce610207366004610e7f565b6104e1565b610214610507565b60405160ff909116815260200161018a565b6040516001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016815260200161018a565b61018061026b366004610ebc565b5060001990565b610180610280366004610ed7565b610533565b610180610293366004610ebc565b6001600160a01b031660009081526020819052604090205490565b6101806102bc366004610ed7565b610567565b61019b610585565b6101ce6102d7366004610e55565b610594565b6101806102ea366004610e20565b6105a2565b6101806102fd366004610f03565b6105af565b610180610310366004610f03565b610607565b610180610323366004610e20565b610656565b610180610336366004610ebc565b610663565b610180610349366004610ebc565b610687565b61018061035c366004610f3f565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205490565b6040516370a0823160e01b81523060048201526000907f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316906370a0823190602401602060405180830381865afa1580156103ee573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906104129190610f69565b905090565b60606003805461042690610f82565b80601f016020809104026020016040519081016040528092919081815260200182805461045290610f82565b801561049f5780601f106104745761010080835404028352916020019161049f565b820191906000526020600020905b81548152906001019060200180831161048257829003601f168201915b5050505050905090565b60006104b68260006106a5565b92915050565b6000336104ca8185856106df565b5060019392505050565b60006104b68260016106f1565b6000336104ef858285610721565b6104fa85858561078c565b60019150505b9392505050565b6000610412817f0000000000000000000000000000000000000000000000000000000000000000610fd2565b6000600019610546565b60405180910390fd5b600061055185610656565b905061055f338587846107eb565b949350505050565b60006000196000610577856105a2565b905061055f338583886107eb565b60606004805461042690610f82565b6000336104ca81858561078c565b60006104b68260016106a5565b6000806105bb83610663565b9050808511156105e457828582604051633fa733bb60e21b815260040161053d93929190610feb565b60006105ef866104d4565b90506105fe338686898561087d565b95945050505050565b60008061061383610687565b90508085111561063c57828582604051632e52afbb60e21b815260040161053d93929190610feb565b6000610647866104a9565b90506105fe338686848a61087d565b60006104b68260006106f1565b6001600160a01b0381166000908152602081905260408120546104b69060006106a5565b6001600160a01b0381166000908152602081905260408120546104b6565b60006105006106b2610387565b6106bd90600161100c565b6106c96000600a611106565b6002546106d6919061100c565b8591908561093d565b6106ec8383836001610981565b505050565b600061050061070182600a611106565b60025461070e919061100c565b610716610387565b6106d690600161100c565b6001600160a01b038381166000908152600160209081526040808320938616835292905220546000198114610786578181101561077757828183604051637dc7a0d960e11b815260040161053d93929190610feb565b61078684848484036000610981565b50505050565b6001600160a01b0383166107b657604051634b637e8f60e11b81526000600482015260240161053d565b6001600160a01b0382166107e05760405163ec442f0560e01b81526000600482015260240161053d565b6106ec838383610a48565b6108177f0000000000000000000000000000000000000000000000000000000000000000853085610b5f565b6108218382610bc6565b826001600160a01b0316846001600160a01b03167fdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7848460405161086f929190918252602082015260400190565b60405180910390a350505050565b826001600160a01b0316856001600160a01b0316146108a1576108a1838683610721565b6108ab8382610c00565b6108d67f00000000000000000000000000000000000000000000000000000000000000008584610c36565b826001600160a01b0316846001600160a01b0316866001600160a01b03167ffbde797d201c681b91056529119e0b02407c7bb96a4a2c75c01fc9667232c8db858560405161092e929190918252602082015260400190565b60405180910390a45050505050565b600061096c61094b83610c67565b801561096757506000848061096257610962611115565b868809115b151590565b610977868686610c94565b6105fe919061100c565b6001600160a01b0384166109ab5760405163e602df0560e01b81526000600482015260240161053d565b6001600160a01b0383166109d557604051634a1406b160e11b81526000600482015260240161053d565b6001600160a01b038085166000908152600160209081526040808320938716835292905220829055801561078657826001600160a01b0316846001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b9258460405161086f91815260200190565b6001600160a01b038316610a73578060026000828254610a68919061100c565b90915550610ad29050565b6001600160a01b03831660009081526020819052604090205481811015610ab35783818360405163391434e360e21b815260040161053d93929190610feb565b6001600160a01b03841660009081526020819052604090209082900390555b6001600160a01b038216610aee57600280548290039055610b0d565b6001600160a01b03821660009081526020819052604090208054820190555b816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef83604051610b5291815260200190565b60405180910390a3505050565b6040516001600160a01b0384811660248301528381166044830152606482018390526107869186918216906323b872dd906084015b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050610d4f565b6001600160a01b038216610bf05760405163ec442f0560e01b81526000600482015260240161053d565b610bfc60008383610a48565b5050565b6001600160a01b038216610c2a57604051634b637e8f60e11b81526000600482015260240161053d565b610bfc82600083610a48565b6040516001600160a01b038381166024830152604482018390526106ec91859182169063a9059cbb90606401610b94565b60006002826003811115610c7d57610c7d61112b565b610c879190611141565b60ff166001149050919050565b6000838302816000198587098281108382030391505080600003610ccb57838281610cc157610cc1611115565b0492505050610500565b808411610ce257610ce26003851502601118610dc0565b6000848688096000868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010186841190950394909402919094039290920491909117919091029150509392505050565b600080602060008451602086016000885af180610d72576040513d6000823e3d81fd5b50506000513d91508115610d8a578060011415610d97565b6001600160a01b0384163b155b1561078657604051635274afe760e01b81526001600160a01b038516600482015260240161053d565b634e487b71600052806020526024601cfd5b602081526000825180602084015260005b81811015610e005760208186018101516040868401015201610de3565b506000604082850101526040601f19601f83011684010191505092915050565b600060208284031215610e3257600080fd5b5035919050565b80356001600160a01b0381168114610e5057600080fd5b919050565b60008060408385031215610e6857600080fd5b610e7183610e39565b946020939093013593505050565b600080600060608486031215610e9457600080fd5b610e9d84610e39565b9250610eab60208501610e39565b929592945050506040919091013590565b600060208284031215610ece57600080fd5b61050082610e39565b60008060408385031215610eea57600080fd5b82359150610efa60208401610e39565b90509250929050565b600080600060608486031215610f1857600080fd5b83359250610f2860208501610e39565b9150610f3660408501610e39565b90509250925092565b60008060408385031215610f5257600080fd5b610f5b83610e39565b9150610efa60208401610e39565b600060208284031215610f7b57600080fd5b5051919050565b600181811c90821680610f9657607f821691505b602082108103610fb657634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b60ff81811683821601908111156104b6576104b6610fbc565b6001600160a01b039390931683526020830191909152604082015260600190565b808201808211156104b6576104b6610fbc565b6001815b600184111561105a5780850481111561103e5761103e610fbc565b600184161561104c57908102905b60019390931c928002611023565b935093915050565b600082611071575060016104b6565b8161107e575060006104b6565b8160018114611094576002811461109e576110ba565b60019150506104b6565b60ff8411156110af576110af610fbc565b50506001821b6104b6565b5060208310610133831016604e8410600b84101617156110dd575081810a6104b6565b6110ea600019848461101f565b80600019048211156110fe576110fe610fbc565b029392505050565b600061050060ff841683611062565b634e487b7160e01b600052601260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b600060ff83168061116257634e487b7160e01b600052601260045260246000fd5b8060ff8416069150509291505056fea26469706673582212206bac755135e344dce7f14b7627e9ac034ffff91160dd7eda692dd6f8279f740564736f6c634300081e0033",
+}
+
+// ERC4626VaultABI is the input ABI used to generate the binding from.
+// Deprecated: Use ERC4626VaultMetaData.ABI instead.
+var ERC4626VaultABI = ERC4626VaultMetaData.ABI
+
+// ERC4626VaultBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ERC4626VaultMetaData.Bin instead.
+var ERC4626VaultBin = ERC4626VaultMetaData.Bin
+
+// DeployERC4626Vault deploys a new Ethereum contract, binding an instance of ERC4626Vault to it.
+func DeployERC4626Vault(auth *bind.TransactOpts, backend bind.ContractBackend, asset_ common.Address) (common.Address, *types.Transaction, *ERC4626Vault, error) {
+	parsed, err := ERC4626VaultMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ERC4626VaultBin), backend, asset_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC4626Vault{ERC4626VaultCaller: ERC4626VaultCaller{contract: contract}, ERC4626VaultTransactor: ERC4626VaultTransactor{contract: contract}, ERC4626VaultFilterer: ERC4626VaultFilterer{contract: contract}}, nil
+}
+
+// ERC4626Vault is an auto generated Go binding around an Ethereum contract.
+type ERC4626Vault struct {
+	ERC4626VaultCaller     // Read-only binding to the contract
+	ERC4626VaultTransactor // Write-only binding to the contract
+	ERC4626VaultFilterer   // Log filterer for contract events
+}
+
+// ERC4626VaultCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC4626VaultCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC4626VaultTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC4626VaultTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC4626VaultFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC4626VaultFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC4626VaultSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC4626VaultSession struct {
+	Contract     *ERC4626Vault     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC4626VaultCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC4626VaultCallerSession struct {
+	Contract *ERC4626VaultCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// ERC4626VaultTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC4626VaultTransactorSession struct {
+	Contract     *ERC4626VaultTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// ERC4626VaultRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC4626VaultRaw struct {
+	Contract *ERC4626Vault // Generic contract binding to access the raw methods on
+}
+
+// ERC4626VaultCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC4626VaultCallerRaw struct {
+	Contract *ERC4626VaultCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC4626VaultTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC4626VaultTransactorRaw struct {
+	Contract *ERC4626VaultTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC4626Vault creates a new instance of ERC4626Vault, bound to a specific deployed contract.
+func NewERC4626Vault(address common.Address, backend bind.ContractBackend) (*ERC4626Vault, error) {
+	contract, err := bindERC4626Vault(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4626Vault{ERC4626VaultCaller: ERC4626VaultCaller{contract: contract}, ERC4626VaultTransactor: ERC4626VaultTransactor{contract: contract}, ERC4626VaultFilterer: ERC4626VaultFilterer{contract: contract}}, nil
+}
+
+// NewERC4626VaultCaller creates a new read-only instance of ERC4626Vault, bound to a specific deployed contract.
+func NewERC4626VaultCaller(address common.Address, caller bind.ContractCaller) (*ERC4626VaultCaller, error) {
+	contract, err := bindERC4626Vault(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4626VaultCaller{contract: contract}, nil
+}
+
+// NewERC4626VaultTransactor creates a new write-only instance of ERC4626Vault, bound to a specific deployed contract.
+func NewERC4626VaultTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC4626VaultTransactor, error) {
+	contract, err := bindERC4626Vault(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4626VaultTransactor{contract: contract}, nil
+}
+
+// NewERC4626VaultFilterer creates a new log filterer instance of ERC4626Vault, bound to a specific deployed contract.
+func NewERC4626VaultFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC4626VaultFilterer, error) {
+	contract, err := bindERC4626Vault(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4626VaultFilterer{contract: contract}, nil
+}
+
+// bindERC4626Vault binds a generic wrapper to an already deployed contract.
+func bindERC4626Vault(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ERC4626VaultMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC4626Vault *ERC4626VaultRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC4626Vault.Contract.ERC4626VaultCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC4626Vault *ERC4626VaultRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.ERC4626VaultTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC4626Vault *ERC4626VaultRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.ERC4626VaultTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC4626Vault *ERC4626VaultCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC4626Vault.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC4626Vault *ERC4626VaultTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC4626Vault *ERC4626VaultTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.contract.Transact(opts, method, params...)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ERC4626Vault.Contract.Allowance(&_ERC4626Vault.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ERC4626Vault.Contract.Allowance(&_ERC4626Vault.CallOpts, owner, spender)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ERC4626Vault *ERC4626VaultCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ERC4626Vault *ERC4626VaultSession) Asset() (common.Address, error) {
+	return _ERC4626Vault.Contract.Asset(&_ERC4626Vault.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ERC4626Vault *ERC4626VaultCallerSession) Asset() (common.Address, error) {
+	return _ERC4626Vault.Contract.Asset(&_ERC4626Vault.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ERC4626Vault.Contract.BalanceOf(&_ERC4626Vault.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ERC4626Vault.Contract.BalanceOf(&_ERC4626Vault.CallOpts, account)
+}
+
+// ConvertToAssets is a free data retrieval call binding the contract method 0x07a2d13a.
+//
+// Solidity: function convertToAssets(uint256 shares) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) ConvertToAssets(opts *bind.CallOpts, shares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "convertToAssets", shares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ConvertToAssets is a free data retrieval call binding the contract method 0x07a2d13a.
+//
+// Solidity: function convertToAssets(uint256 shares) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) ConvertToAssets(shares *big.Int) (*big.Int, error) {
+	return _ERC4626Vault.Contract.ConvertToAssets(&_ERC4626Vault.CallOpts, shares)
+}
+
+// ConvertToAssets is a free data retrieval call binding the contract method 0x07a2d13a.
+//
+// Solidity: function convertToAssets(uint256 shares) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) ConvertToAssets(shares *big.Int) (*big.Int, error) {
+	return _ERC4626Vault.Contract.ConvertToAssets(&_ERC4626Vault.CallOpts, shares)
+}
+
+// ConvertToShares is a free data retrieval call binding the contract method 0xc6e6f592.
+//
+// Solidity: function convertToShares(uint256 assets) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) ConvertToShares(opts *bind.CallOpts, assets *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "convertToShares", assets)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ConvertToShares is a free data retrieval call binding the contract method 0xc6e6f592.
+//
+// Solidity: function convertToShares(uint256 assets) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) ConvertToShares(assets *big.Int) (*big.Int, error) {
+	return _ERC4626Vault.Contract.ConvertToShares(&_ERC4626Vault.CallOpts, assets)
+}
+
+// ConvertToShares is a free data retrieval call binding the contract method 0xc6e6f592.
+//
+// Solidity: function convertToShares(uint256 assets) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) ConvertToShares(assets *big.Int) (*big.Int, error) {
+	return _ERC4626Vault.Contract.ConvertToShares(&_ERC4626Vault.CallOpts, assets)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC4626Vault *ERC4626VaultCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC4626Vault *ERC4626VaultSession) Decimals() (uint8, error) {
+	return _ERC4626Vault.Contract.Decimals(&_ERC4626Vault.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC4626Vault *ERC4626VaultCallerSession) Decimals() (uint8, error) {
+	return _ERC4626Vault.Contract.Decimals(&_ERC4626Vault.CallOpts)
+}
+
+// MaxDeposit is a free data retrieval call binding the contract method 0x402d267d.
+//
+// Solidity: function maxDeposit(address ) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) MaxDeposit(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "maxDeposit", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxDeposit is a free data retrieval call binding the contract method 0x402d267d.
+//
+// Solidity: function maxDeposit(address ) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) MaxDeposit(arg0 common.Address) (*big.Int, error) {
+	return _ERC4626Vault.Contract.MaxDeposit(&_ERC4626Vault.CallOpts, arg0)
+}
+
+// MaxDeposit is a free data retrieval call binding the contract method 0x402d267d.
+//
+// Solidity: function maxDeposit(address ) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) MaxDeposit(arg0 common.Address) (*big.Int, error) {
+	return _ERC4626Vault.Contract.MaxDeposit(&_ERC4626Vault.CallOpts, arg0)
+}
+
+// MaxMint is a free data retrieval call binding the contract method 0xc63d75b6.
+//
+// Solidity: function maxMint(address ) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) MaxMint(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "maxMint", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxMint is a free data retrieval call binding the contract method 0xc63d75b6.
+//
+// Solidity: function maxMint(address ) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) MaxMint(arg0 common.Address) (*big.Int, error) {
+	return _ERC4626Vault.Contract.MaxMint(&_ERC4626Vault.CallOpts, arg0)
+}
+
+// MaxMint is a free data retrieval call binding the contract method 0xc63d75b6.
+//
+// Solidity: function maxMint(address ) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) MaxMint(arg0 common.Address) (*big.Int, error) {
+	return _ERC4626Vault.Contract.MaxMint(&_ERC4626Vault.CallOpts, arg0)
+}
+
+// MaxRedeem is a free data retrieval call binding the contract method 0xd905777e.
+//
+// Solidity: function maxRedeem(address owner) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) MaxRedeem(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "maxRedeem", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxRedeem is a free data retrieval call binding the contract method 0xd905777e.
+//
+// Solidity: function maxRedeem(address owner) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) MaxRedeem(owner common.Address) (*big.Int, error) {
+	return _ERC4626Vault.Contract.MaxRedeem(&_ERC4626Vault.CallOpts, owner)
+}
+
+// MaxRedeem is a free data retrieval call binding the contract method 0xd905777e.
+//
+// Solidity: function maxRedeem(address owner) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) MaxRedeem(owner common.Address) (*big.Int, error) {
+	return _ERC4626Vault.Contract.MaxRedeem(&_ERC4626Vault.CallOpts, owner)
+}
+
+// MaxWithdraw is a free data retrieval call binding the contract method 0xce96cb77.
+//
+// Solidity: function maxWithdraw(address owner) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) MaxWithdraw(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "maxWithdraw", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxWithdraw is a free data retrieval call binding the contract method 0xce96cb77.
+//
+// Solidity: function maxWithdraw(address owner) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) MaxWithdraw(owner common.Address) (*big.Int, error) {
+	return _ERC4626Vault.Contract.MaxWithdraw(&_ERC4626Vault.CallOpts, owner)
+}
+
+// MaxWithdraw is a free data retrieval call binding the contract method 0xce96cb77.
+//
+// Solidity: function maxWithdraw(address owner) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) MaxWithdraw(owner common.Address) (*big.Int, error) {
+	return _ERC4626Vault.Contract.MaxWithdraw(&_ERC4626Vault.CallOpts, owner)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC4626Vault *ERC4626VaultCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC4626Vault *ERC4626VaultSession) Name() (string, error) {
+	return _ERC4626Vault.Contract.Name(&_ERC4626Vault.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC4626Vault *ERC4626VaultCallerSession) Name() (string, error) {
+	return _ERC4626Vault.Contract.Name(&_ERC4626Vault.CallOpts)
+}
+
+// PreviewDeposit is a free data retrieval call binding the contract method 0xef8b30f7.
+//
+// Solidity: function previewDeposit(uint256 assets) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) PreviewDeposit(opts *bind.CallOpts, assets *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "previewDeposit", assets)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewDeposit is a free data retrieval call binding the contract method 0xef8b30f7.
+//
+// Solidity: function previewDeposit(uint256 assets) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) PreviewDeposit(assets *big.Int) (*big.Int, error) {
+	return _ERC4626Vault.Contract.PreviewDeposit(&_ERC4626Vault.CallOpts, assets)
+}
+
+// PreviewDeposit is a free data retrieval call binding the contract method 0xef8b30f7.
+//
+// Solidity: function previewDeposit(uint256 assets) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) PreviewDeposit(assets *big.Int) (*big.Int, error) {
+	return _ERC4626Vault.Contract.PreviewDeposit(&_ERC4626Vault.CallOpts, assets)
+}
+
+// PreviewMint is a free data retrieval call binding the contract method 0xb3d7f6b9.
+//
+// Solidity: function previewMint(uint256 shares) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) PreviewMint(opts *bind.CallOpts, shares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "previewMint", shares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewMint is a free data retrieval call binding the contract method 0xb3d7f6b9.
+//
+// Solidity: function previewMint(uint256 shares) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) PreviewMint(shares *big.Int) (*big.Int, error) {
+	return _ERC4626Vault.Contract.PreviewMint(&_ERC4626Vault.CallOpts, shares)
+}
+
+// PreviewMint is a free data retrieval call binding the contract method 0xb3d7f6b9.
+//
+// Solidity: function previewMint(uint256 shares) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) PreviewMint(shares *big.Int) (*big.Int, error) {
+	return _ERC4626Vault.Contract.PreviewMint(&_ERC4626Vault.CallOpts, shares)
+}
+
+// PreviewRedeem is a free data retrieval call binding the contract method 0x4cdad506.
+//
+// Solidity: function previewRedeem(uint256 shares) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) PreviewRedeem(opts *bind.CallOpts, shares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "previewRedeem", shares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewRedeem is a free data retrieval call binding the contract method 0x4cdad506.
+//
+// Solidity: function previewRedeem(uint256 shares) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) PreviewRedeem(shares *big.Int) (*big.Int, error) {
+	return _ERC4626Vault.Contract.PreviewRedeem(&_ERC4626Vault.CallOpts, shares)
+}
+
+// PreviewRedeem is a free data retrieval call binding the contract method 0x4cdad506.
+//
+// Solidity: function previewRedeem(uint256 shares) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) PreviewRedeem(shares *big.Int) (*big.Int, error) {
+	return _ERC4626Vault.Contract.PreviewRedeem(&_ERC4626Vault.CallOpts, shares)
+}
+
+// PreviewWithdraw is a free data retrieval call binding the contract method 0x0a28a477.
+//
+// Solidity: function previewWithdraw(uint256 assets) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) PreviewWithdraw(opts *bind.CallOpts, assets *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "previewWithdraw", assets)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewWithdraw is a free data retrieval call binding the contract method 0x0a28a477.
+//
+// Solidity: function previewWithdraw(uint256 assets) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) PreviewWithdraw(assets *big.Int) (*big.Int, error) {
+	return _ERC4626Vault.Contract.PreviewWithdraw(&_ERC4626Vault.CallOpts, assets)
+}
+
+// PreviewWithdraw is a free data retrieval call binding the contract method 0x0a28a477.
+//
+// Solidity: function previewWithdraw(uint256 assets) view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) PreviewWithdraw(assets *big.Int) (*big.Int, error) {
+	return _ERC4626Vault.Contract.PreviewWithdraw(&_ERC4626Vault.CallOpts, assets)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC4626Vault *ERC4626VaultCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC4626Vault *ERC4626VaultSession) Symbol() (string, error) {
+	return _ERC4626Vault.Contract.Symbol(&_ERC4626Vault.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC4626Vault *ERC4626VaultCallerSession) Symbol() (string, error) {
+	return _ERC4626Vault.Contract.Symbol(&_ERC4626Vault.CallOpts)
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) TotalAssets(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "totalAssets")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) TotalAssets() (*big.Int, error) {
+	return _ERC4626Vault.Contract.TotalAssets(&_ERC4626Vault.CallOpts)
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) TotalAssets() (*big.Int, error) {
+	return _ERC4626Vault.Contract.TotalAssets(&_ERC4626Vault.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626Vault.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) TotalSupply() (*big.Int, error) {
+	return _ERC4626Vault.Contract.TotalSupply(&_ERC4626Vault.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC4626Vault *ERC4626VaultCallerSession) TotalSupply() (*big.Int, error) {
+	return _ERC4626Vault.Contract.TotalSupply(&_ERC4626Vault.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC4626Vault *ERC4626VaultTransactor) Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC4626Vault.contract.Transact(opts, "approve", spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC4626Vault *ERC4626VaultSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.Approve(&_ERC4626Vault.TransactOpts, spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC4626Vault *ERC4626VaultTransactorSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.Approve(&_ERC4626Vault.TransactOpts, spender, value)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x6e553f65.
+//
+// Solidity: function deposit(uint256 assets, address receiver) returns(uint256)
+func (_ERC4626Vault *ERC4626VaultTransactor) Deposit(opts *bind.TransactOpts, assets *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _ERC4626Vault.contract.Transact(opts, "deposit", assets, receiver)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x6e553f65.
+//
+// Solidity: function deposit(uint256 assets, address receiver) returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) Deposit(assets *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.Deposit(&_ERC4626Vault.TransactOpts, assets, receiver)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x6e553f65.
+//
+// Solidity: function deposit(uint256 assets, address receiver) returns(uint256)
+func (_ERC4626Vault *ERC4626VaultTransactorSession) Deposit(assets *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.Deposit(&_ERC4626Vault.TransactOpts, assets, receiver)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x94bf804d.
+//
+// Solidity: function mint(uint256 shares, address receiver) returns(uint256)
+func (_ERC4626Vault *ERC4626VaultTransactor) Mint(opts *bind.TransactOpts, shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _ERC4626Vault.contract.Transact(opts, "mint", shares, receiver)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x94bf804d.
+//
+// Solidity: function mint(uint256 shares, address receiver) returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) Mint(shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.Mint(&_ERC4626Vault.TransactOpts, shares, receiver)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x94bf804d.
+//
+// Solidity: function mint(uint256 shares, address receiver) returns(uint256)
+func (_ERC4626Vault *ERC4626VaultTransactorSession) Mint(shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.Mint(&_ERC4626Vault.TransactOpts, shares, receiver)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xba087652.
+//
+// Solidity: function redeem(uint256 shares, address receiver, address owner) returns(uint256)
+func (_ERC4626Vault *ERC4626VaultTransactor) Redeem(opts *bind.TransactOpts, shares *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _ERC4626Vault.contract.Transact(opts, "redeem", shares, receiver, owner)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xba087652.
+//
+// Solidity: function redeem(uint256 shares, address receiver, address owner) returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) Redeem(shares *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.Redeem(&_ERC4626Vault.TransactOpts, shares, receiver, owner)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xba087652.
+//
+// Solidity: function redeem(uint256 shares, address receiver, address owner) returns(uint256)
+func (_ERC4626Vault *ERC4626VaultTransactorSession) Redeem(shares *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.Redeem(&_ERC4626Vault.TransactOpts, shares, receiver, owner)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC4626Vault *ERC4626VaultTransactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC4626Vault.contract.Transact(opts, "transfer", to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC4626Vault *ERC4626VaultSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.Transfer(&_ERC4626Vault.TransactOpts, to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC4626Vault *ERC4626VaultTransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.Transfer(&_ERC4626Vault.TransactOpts, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC4626Vault *ERC4626VaultTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC4626Vault.contract.Transact(opts, "transferFrom", from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC4626Vault *ERC4626VaultSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.TransferFrom(&_ERC4626Vault.TransactOpts, from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC4626Vault *ERC4626VaultTransactorSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.TransferFrom(&_ERC4626Vault.TransactOpts, from, to, value)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xb460af94.
+//
+// Solidity: function withdraw(uint256 assets, address receiver, address owner) returns(uint256)
+func (_ERC4626Vault *ERC4626VaultTransactor) Withdraw(opts *bind.TransactOpts, assets *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _ERC4626Vault.contract.Transact(opts, "withdraw", assets, receiver, owner)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xb460af94.
+//
+// Solidity: function withdraw(uint256 assets, address receiver, address owner) returns(uint256)
+func (_ERC4626Vault *ERC4626VaultSession) Withdraw(assets *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.Withdraw(&_ERC4626Vault.TransactOpts, assets, receiver, owner)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xb460af94.
+//
+// Solidity: function withdraw(uint256 assets, address receiver, address owner) returns(uint256)
+func (_ERC4626Vault *ERC4626VaultTransactorSession) Withdraw(assets *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _ERC4626Vault.Contract.Withdraw(&_ERC4626Vault.TransactOpts, assets, receiver, owner)
+}
+
+// ERC4626VaultApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the ERC4626Vault contract.
+type ERC4626VaultApprovalIterator struct {
+	Event *ERC4626VaultApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC4626VaultApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC4626VaultApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC4626VaultApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC4626VaultApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC4626VaultApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC4626VaultApproval represents a Approval event raised by the ERC4626Vault contract.
+type ERC4626VaultApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC4626Vault *ERC4626VaultFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*ERC4626VaultApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ERC4626Vault.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4626VaultApprovalIterator{contract: _ERC4626Vault.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC4626Vault *ERC4626VaultFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *ERC4626VaultApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ERC4626Vault.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC4626VaultApproval)
+				if err := _ERC4626Vault.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC4626Vault *ERC4626VaultFilterer) ParseApproval(log types.Log) (*ERC4626VaultApproval, error) {
+	event := new(ERC4626VaultApproval)
+	if err := _ERC4626Vault.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC4626VaultDepositIterator is returned from FilterDeposit and is used to iterate over the raw logs and unpacked data for Deposit events raised by the ERC4626Vault contract.
+type ERC4626VaultDepositIterator struct {
+	Event *ERC4626VaultDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC4626VaultDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC4626VaultDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC4626VaultDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC4626VaultDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC4626VaultDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC4626VaultDeposit represents a Deposit event raised by the ERC4626Vault contract.
+type ERC4626VaultDeposit struct {
+	Sender common.Address
+	Owner  common.Address
+	Assets *big.Int
+	Shares *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeposit is a free log retrieval operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed sender, address indexed owner, uint256 assets, uint256 shares)
+func (_ERC4626Vault *ERC4626VaultFilterer) FilterDeposit(opts *bind.FilterOpts, sender []common.Address, owner []common.Address) (*ERC4626VaultDepositIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _ERC4626Vault.contract.FilterLogs(opts, "Deposit", senderRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4626VaultDepositIterator{contract: _ERC4626Vault.contract, event: "Deposit", logs: logs, sub: sub}, nil
+}
+
+// WatchDeposit is a free log subscription operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed sender, address indexed owner, uint256 assets, uint256 shares)
+func (_ERC4626Vault *ERC4626VaultFilterer) WatchDeposit(opts *bind.WatchOpts, sink chan<- *ERC4626VaultDeposit, sender []common.Address, owner []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _ERC4626Vault.contract.WatchLogs(opts, "Deposit", senderRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC4626VaultDeposit)
+				if err := _ERC4626Vault.contract.UnpackLog(event, "Deposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeposit is a log parse operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed sender, address indexed owner, uint256 assets, uint256 shares)
+func (_ERC4626Vault *ERC4626VaultFilterer) ParseDeposit(log types.Log) (*ERC4626VaultDeposit, error) {
+	event := new(ERC4626VaultDeposit)
+	if err := _ERC4626Vault.contract.UnpackLog(event, "Deposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC4626VaultTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the ERC4626Vault contract.
+type ERC4626VaultTransferIterator struct {
+	Event *ERC4626VaultTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC4626VaultTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC4626VaultTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC4626VaultTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC4626VaultTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC4626VaultTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC4626VaultTransfer represents a Transfer event raised by the ERC4626Vault contract.
+type ERC4626VaultTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC4626Vault *ERC4626VaultFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*ERC4626VaultTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC4626Vault.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4626VaultTransferIterator{contract: _ERC4626Vault.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC4626Vault *ERC4626VaultFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ERC4626VaultTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC4626Vault.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC4626VaultTransfer)
+				if err := _ERC4626Vault.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC4626Vault *ERC4626VaultFilterer) ParseTransfer(log types.Log) (*ERC4626VaultTransfer, error) {
+	event := new(ERC4626VaultTransfer)
+	if err := _ERC4626Vault.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC4626VaultWithdrawIterator is returned from FilterWithdraw and is used to iterate over the raw logs and unpacked data for Withdraw events raised by the ERC4626Vault contract.
+type ERC4626VaultWithdrawIterator struct {
+	Event *ERC4626VaultWithdraw // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC4626VaultWithdrawIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC4626VaultWithdraw)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC4626VaultWithdraw)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC4626VaultWithdrawIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC4626VaultWithdrawIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC4626VaultWithdraw represents a Withdraw event raised by the ERC4626Vault contract.
+type ERC4626VaultWithdraw struct {
+	Sender   common.Address
+	Receiver common.Address
+	Owner    common.Address
+	Assets   *big.Int
+	Shares   *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdraw is a free log retrieval operation binding the contract event 0xfbde797d201c681b91056529119e0b02407c7bb96a4a2c75c01fc9667232c8db.
+//
+// Solidity: event Withdraw(address indexed sender, address indexed receiver, address indexed owner, uint256 assets, uint256 shares)
+func (_ERC4626Vault *ERC4626VaultFilterer) FilterWithdraw(opts *bind.FilterOpts, sender []common.Address, receiver []common.Address, owner []common.Address) (*ERC4626VaultWithdrawIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var receiverRule []interface{}
+	for _, receiverItem := range receiver {
+		receiverRule = append(receiverRule, receiverItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _ERC4626Vault.contract.FilterLogs(opts, "Withdraw", senderRule, receiverRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4626VaultWithdrawIterator{contract: _ERC4626Vault.contract, event: "Withdraw", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdraw is a free log subscription operation binding the contract event 0xfbde797d201c681b91056529119e0b02407c7bb96a4a2c75c01fc9667232c8db.
+//
+// Solidity: event Withdraw(address indexed sender, address indexed receiver, address indexed owner, uint256 assets, uint256 shares)
+func (_ERC4626Vault *ERC4626VaultFilterer) WatchWithdraw(opts *bind.WatchOpts, sink chan<- *ERC4626VaultWithdraw, sender []common.Address, receiver []common.Address, owner []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var receiverRule []interface{}
+	for _, receiverItem := range receiver {
+		receiverRule = append(receiverRule, receiverItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _ERC4626Vault.contract.WatchLogs(opts, "Withdraw", senderRule, receiverRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC4626VaultWithdraw)
+				if err := _ERC4626Vault.contract.UnpackLog(event, "Withdraw", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdraw is a log parse operation binding the contract event 0xfbde797d201c681b91056529119e0b02407c7bb96a4a2c75c01fc9667232c8db.
+//
+// Solidity: event Withdraw(address indexed sender, address indexed receiver, address indexed owner, uint256 assets, uint256 shares)
+func (_ERC4626Vault *ERC4626VaultFilterer) ParseWithdraw(log types.Log) (*ERC4626VaultWithdraw, error) {
+	event := new(ERC4626VaultWithdraw)
+	if err := _ERC4626Vault.contract.UnpackLog(event, "Withdraw", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,1345 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ERC4906Metadata
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ERC4906MetadataMetaData contains all meta data concerning the ERC4906Metadata contract.
+var ERC4906MetadataMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"ERC721IncorrectOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ERC721InsufficientApproval\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"}],\"name\":\"ERC721InvalidApprover\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"ERC721InvalidOperator\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"ERC721InvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC721InvalidReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"ERC721InvalidSender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ERC721NonexistentToken\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_fromTokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_toTokenId\",\"type\":\"uint256\"}],\"name\":\"BatchMetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_tokenId\",\"type\":\"uint256\"}],\"name\":\"MetadataUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"fromTokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"toTokenId\",\"type\":\"uint256\"}],\"name\":\"updateAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"updateToken\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x608060405234801561001057600080fd5b50604051806040016040528060088152602001674d6574616461746160c01b815250604051806040016040528060048152602001634d45544160e01b815250816000908161005e9190610112565b50600161006b8282610112565b5050506101d0565b634e487b7160e01b600052604160045260246000fd5b600181811c9082168061009d57607f821691505b6020821081036100bd57634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561010d57806000526020600020601f840160051c810160208510156100ea5750805b601f840160051c820191505b8181101561010a57600081556001016100f6565b50505b505050565b81516001600160401b0381111561012b5761012b610073565b61013f816101398454610089565b846100c3565b6020601f821160018114610173576000831561015b5750848201515b600019600385901b1c1916600184901b17845561010a565b600084815260208120601f198516915b828110156101a35787850151825560209485019460019092019101610183565b50848210156101c15786840151600019600387901b60f8161c191681555b50505050600190811b01905550565b611074806101df6000396000f3fe608060405234801561001057600080fd5b50600436106100f55760003560e01c806370a0823111610097578063a89c5cb911610066578063a89c5cb9146101ff578063b88d4fde14610212578063c87b56dd14610225578063e985e9c51461023857600080fd5b806370a08231146101b05780638d5b98d5146101d157806395d89b41146101e4578063a22cb465146101ec57600080fd5b8063095ea7b3116100d3578063095ea7b31461016257806323b872dd1461017757806342842e0e1461018a5780636352211e1461019d57600080fd5b806301ffc9a7146100fa57806306fdde0314610122578063081812fc14610137575b600080fd5b61010d610108366004610c51565b61024b565b60405190151581526020015b60405180910390f35b61012a610276565b6040516101199190610cc5565b61014a610145366004610cd8565b610308565b6040516001600160a01b039091168152602001610119565b610175610170366004610d0d565b610331565b005b610175610185366004610d37565b610340565b610175610198366004610d37565b6103d0565b61014a6101ab366004610cd8565b6103f0565b6101c36101be366004610d74565b6103fb565b604051908152602001610119565b6101756101df366004610cd8565b610443565b61012a610498565b6101756101fa366004610d8f565b6104a7565b61017561020d366004610dcb565b6104b2565b610175610220366004610e03565b610504565b61012a610233366004610cd8565b61051c565b61010d610246366004610ee7565b610576565b60006001600160e01b03198216632483248360e11b14806102705750610270826105a4565b92915050565b60606000805461028590610f1a565b80601f01602080910402602001604051908101604052809291908181526020018280546102b190610f1a565b80156102fe5780601f106102d3576101008083540402835291602001916102fe565b820191906000526020600020905b8154815290600101906020018083116102e157829003601f168201915b5050505050905090565b6000610313826105f4565b506000828152600460205260409020546001600160a01b0316610270565b61033c82823361062d565b5050565b6001600160a01b03821661036f57604051633250574960e11b8152600060048201526024015b60405180910390fd5b600061037c83833361063a565b9050836001600160a01b0316816001600160a01b0316146103ca576040516364283d7b60e01b81526001600160a01b0380861660048301526024820184905282166044820152606401610366565b50505050565b6103eb83838360405180602001604052806000815250610504565b505050565b6000610270826105f4565b60006001600160a01b038216610427576040516322718ad960e21b815260006004820152602401610366565b506001600160a01b031660009081526003602052604090205490565b600081815260076020526040812080549161045d83610f6a565b90915550506040518181527ff8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce79060200160405180910390a150565b60606001805461028590610f1a565b61033c338383610733565b600680549060006104c283610f6a565b909155505060408051838152602081018390527f6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c910160405180910390a15050565b61050f848484610340565b6103ca33858585856107d2565b60008181526007602052604090205460065460609161055091600a9161054191610f83565b61054b9190610f96565b6108fd565b6040516020016105609190610fb8565b6040516020818303038152906040529050919050565b6001600160a01b03918216600090815260056020908152604080832093909416825291909152205460ff1690565b60006001600160e01b031982166380ac58cd60e01b14806105d557506001600160e01b03198216635b5e139f60e01b145b8061027057506301ffc9a760e01b6001600160e01b0319831614610270565b6000818152600260205260408120546001600160a01b03168061027057604051637e27328960e01b815260048101849052602401610366565b6103eb8383836001610990565b6000828152600260205260408120546001600160a01b039081169083161561066757610667818486610a96565b6001600160a01b038116156106a557610684600085600080610990565b6001600160a01b038116600090815260036020526040902080546000190190555b6001600160a01b038516156106d4576001600160a01b0385166000908152600360205260409020805460010190555b60008481526002602052604080822080546001600160a01b0319166001600160a01b0389811691821790925591518793918516917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4949350505050565b6001600160a01b03821661076557604051630b61174360e31b81526001600160a01b0383166004820152602401610366565b6001600160a01b03838116600081815260056020908152604080832094871680845294825291829020805460ff191686151590811790915591519182527f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a3505050565b6001600160a01b0383163b156108f657604051630a85bd0160e11b81526001600160a01b0384169063150b7a0290610814908890889087908790600401610fe4565b6020604051808303816000875af192505050801561084f575060408051601f3d908101601f1916820190925261084c91810190611021565b60015b6108b8573d80801561087d576040519150601f19603f3d011682016040523d82523d6000602084013e610882565b606091505b5080516000036108b057604051633250574960e11b81526001600160a01b0385166004820152602401610366565b805181602001fd5b6001600160e01b03198116630a85bd0160e11b146108f457604051633250574960e11b81526001600160a01b0385166004820152602401610366565b505b5050505050565b6060600061090a83610afa565b600101905060008167ffffffffffffffff81111561092a5761092a610ded565b6040519080825280601f01601f191660200182016040528015610954576020820181803683370190505b5090508181016020015b600019016f181899199a1a9b1b9c1cb0b131b232b360811b600a86061a8153600a850494508461095e57509392505050565b80806109a457506001600160a01b03821615155b15610a665760006109b4846105f4565b90506001600160a01b038316158015906109e05750826001600160a01b0316816001600160a01b031614155b80156109f357506109f18184610576565b155b15610a1c5760405163a9fbf51f60e01b81526001600160a01b0384166004820152602401610366565b8115610a645783856001600160a01b0316826001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45b505b5050600090815260046020526040902080546001600160a01b0319166001600160a01b0392909216919091179055565b610aa1838383610bd2565b6103eb576001600160a01b038316610acf57604051637e27328960e01b815260048101829052602401610366565b60405163177e802f60e01b81526001600160a01b038316600482015260248101829052604401610366565b60008072184f03e93ff9f4daa797ed6e38ed64bf6a1f0160401b8310610b395772184f03e93ff9f4daa797ed6e38ed64bf6a1f0160401b830492506040015b6d04ee2d6d415b85acef81000000008310610b65576d04ee2d6d415b85acef8100000000830492506020015b662386f26fc100008310610b8357662386f26fc10000830492506010015b6305f5e1008310610b9b576305f5e100830492506008015b6127108310610baf57612710830492506004015b60648310610bc1576064830492506002015b600a83106102705760010192915050565b60006001600160a01b03831615801590610c305750826001600160a01b0316846001600160a01b03161480610c0c5750610c0c8484610576565b80610c3057506000828152600460205260409020546001600160a01b038481169116145b949350505050565b6001600160e01b031981168114610c4e57600080fd5b50565b600060208284031215610c6357600080fd5b8135610c6e81610c38565b9392505050565b60005b83811015610c90578181015183820152602001610c78565b50506000910152565b60008151808452610cb1816020860160208601610c75565b601f01601f19169290920160200192915050565b602081526000610c6e6020830184610c99565b600060208284031215610cea57600080fd5b5035919050565b80356001600160a01b0381168114610d0857600080fd5b919050565b60008060408385031215610d2057600080fd5b610d2983610cf1565b946020939093013593505050565b600080600060608486031215610d4c57600080fd5b610d5584610cf1565b9250610d6360208501610cf1565b929592945050506040919091013590565b600060208284031215610d8657600080fd5b610c6e82610cf1565b60008060408385031215610da257600080fd5b610dab83610cf1565b915060208301358015158114610dc057600080fd5b809150509250929050565b60008060408385031215610dde57600080fd5b50508035926020909101359150565b634e487b7160e01b600052604160045260246000fd5b60008060008060808587031215610e1957600080fd5b610e2285610cf1565b9350610e3060208601610cf1565b925060408501359150606085013567ffffffffffffffff811115610e5357600080fd5b8501601f81018713610e6457600080fd5b803567ffffffffffffffff811115610e7e57610e7e610ded565b604051601f8201601f19908116603f0116810167ffffffffffffffff81118282101715610ead57610ead610ded565b604052818152828201602001891015610ec557600080fd5b8160208401602083013760006020838301015280935050505092959194509250565b60008060408385031215610efa57600080fd5b610f0383610cf1565b9150610f1160208401610cf1565b90509250929050565b600181811c90821680610f2e57607f821691505b602082108103610f4e57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b600060018201610f7c57610f7c610f54565b5060010190565b8082018082111561027057610270610f54565b600082610fb357634e487b7160e01b600052601260045260246000fd5b500690565b637572692d60e01b815260008251610fd7816004850160208701610c75565b9190910160040192915050565b6001600160a01b038581168252841660208201526040810183905260806060820181905260009061101790830184610c99565b9695505050505050565b60006020828403121561103357600080fd5b8151610c6e81610c3856fea2646970667358221220a3b5cdb5a88043e02b57c7108a10ae96fe97e0b62fa04656e083850d3145a8ee64736f6c634300081e0033",
+}
+
+// ERC4906MetadataABI is the input ABI used to generate the binding from.
+// Deprecated: Use ERC4906MetadataMetaData.ABI instead.
+var ERC4906MetadataABI = ERC4906MetadataMetaData.ABI
+
+// ERC4906MetadataBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ERC4906MetadataMetaData.Bin instead.
+var ERC4906MetadataBin = ERC4906MetadataMetaData.Bin
+
+// DeployERC4906Metadata deploys a new Ethereum contract, binding an instance of ERC4906Metadata to it.
+func DeployERC4906Metadata(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ERC4906Metadata, error) {
+	parsed, err := ERC4906MetadataMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ERC4906MetadataBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC4906Metadata{ERC4906MetadataCaller: ERC4906MetadataCaller{contract: contract}, ERC4906MetadataTransactor: ERC4906MetadataTransactor{contract: contract}, ERC4906MetadataFilterer: ERC4906MetadataFilterer{contract: contract}}, nil
+}
+
+// ERC4906Metadata is an auto generated Go binding around an Ethereum contract.
+type ERC4906Metadata struct {
+	ERC4906MetadataCaller     // Read-only binding to the contract
+	ERC4906MetadataTransactor // Write-only binding to the contract
+	ERC4906MetadataFilterer   // Log filterer for contract events
+}
+
+// ERC4906MetadataCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC4906MetadataCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC4906MetadataTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC4906MetadataTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC4906MetadataFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC4906MetadataFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC4906MetadataSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC4906MetadataSession struct {
+	Contract     *ERC4906Metadata  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC4906MetadataCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC4906MetadataCallerSession struct {
+	Contract *ERC4906MetadataCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// ERC4906MetadataTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC4906MetadataTransactorSession struct {
+	Contract     *ERC4906MetadataTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// ERC4906MetadataRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC4906MetadataRaw struct {
+	Contract *ERC4906Metadata // Generic contract binding to access the raw methods on
+}
+
+// ERC4906MetadataCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC4906MetadataCallerRaw struct {
+	Contract *ERC4906MetadataCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC4906MetadataTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC4906MetadataTransactorRaw struct {
+	Contract *ERC4906MetadataTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC4906Metadata creates a new instance of ERC4906Metadata, bound to a specific deployed contract.
+func NewERC4906Metadata(address common.Address, backend bind.ContractBackend) (*ERC4906Metadata, error) {
+	contract, err := bindERC4906Metadata(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4906Metadata{ERC4906MetadataCaller: ERC4906MetadataCaller{contract: contract}, ERC4906MetadataTransactor: ERC4906MetadataTransactor{contract: contract}, ERC4906MetadataFilterer: ERC4906MetadataFilterer{contract: contract}}, nil
+}
+
+// NewERC4906MetadataCaller creates a new read-only instance of ERC4906Metadata, bound to a specific deployed contract.
+func NewERC4906MetadataCaller(address common.Address, caller bind.ContractCaller) (*ERC4906MetadataCaller, error) {
+	contract, err := bindERC4906Metadata(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4906MetadataCaller{contract: contract}, nil
+}
+
+// NewERC4906MetadataTransactor creates a new write-only instance of ERC4906Metadata, bound to a specific deployed contract.
+func NewERC4906MetadataTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC4906MetadataTransactor, error) {
+	contract, err := bindERC4906Metadata(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4906MetadataTransactor{contract: contract}, nil
+}
+
+// NewERC4906MetadataFilterer creates a new log filterer instance of ERC4906Metadata, bound to a specific deployed contract.
+func NewERC4906MetadataFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC4906MetadataFilterer, error) {
+	contract, err := bindERC4906Metadata(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4906MetadataFilterer{contract: contract}, nil
+}
+
+// bindERC4906Metadata binds a generic wrapper to an already deployed contract.
+func bindERC4906Metadata(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ERC4906MetadataMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC4906Metadata *ERC4906MetadataRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC4906Metadata.Contract.ERC4906MetadataCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC4906Metadata *ERC4906MetadataRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.ERC4906MetadataTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC4906Metadata *ERC4906MetadataRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.ERC4906MetadataTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC4906Metadata *ERC4906MetadataCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC4906Metadata.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC4906Metadata *ERC4906MetadataTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC4906Metadata *ERC4906MetadataTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC4906Metadata *ERC4906MetadataCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4906Metadata.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC4906Metadata *ERC4906MetadataSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ERC4906Metadata.Contract.BalanceOf(&_ERC4906Metadata.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC4906Metadata *ERC4906MetadataCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ERC4906Metadata.Contract.BalanceOf(&_ERC4906Metadata.CallOpts, owner)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC4906Metadata *ERC4906MetadataCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _ERC4906Metadata.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC4906Metadata *ERC4906MetadataSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ERC4906Metadata.Contract.GetApproved(&_ERC4906Metadata.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC4906Metadata *ERC4906MetadataCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ERC4906Metadata.Contract.GetApproved(&_ERC4906Metadata.CallOpts, tokenId)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC4906Metadata *ERC4906MetadataCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _ERC4906Metadata.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC4906Metadata *ERC4906MetadataSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ERC4906Metadata.Contract.IsApprovedForAll(&_ERC4906Metadata.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC4906Metadata *ERC4906MetadataCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ERC4906Metadata.Contract.IsApprovedForAll(&_ERC4906Metadata.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC4906Metadata *ERC4906MetadataCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC4906Metadata.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC4906Metadata *ERC4906MetadataSession) Name() (string, error) {
+	return _ERC4906Metadata.Contract.Name(&_ERC4906Metadata.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC4906Metadata *ERC4906MetadataCallerSession) Name() (string, error) {
+	return _ERC4906Metadata.Contract.Name(&_ERC4906Metadata.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC4906Metadata *ERC4906MetadataCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _ERC4906Metadata.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC4906Metadata *ERC4906MetadataSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ERC4906Metadata.Contract.OwnerOf(&_ERC4906Metadata.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC4906Metadata *ERC4906MetadataCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ERC4906Metadata.Contract.OwnerOf(&_ERC4906Metadata.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC4906Metadata *ERC4906MetadataCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _ERC4906Metadata.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC4906Metadata *ERC4906MetadataSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC4906Metadata.Contract.SupportsInterface(&_ERC4906Metadata.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC4906Metadata *ERC4906MetadataCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC4906Metadata.Contract.SupportsInterface(&_ERC4906Metadata.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC4906Metadata *ERC4906MetadataCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC4906Metadata.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC4906Metadata *ERC4906MetadataSession) Symbol() (string, error) {
+	return _ERC4906Metadata.Contract.Symbol(&_ERC4906Metadata.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC4906Metadata *ERC4906MetadataCallerSession) Symbol() (string, error) {
+	return _ERC4906Metadata.Contract.Symbol(&_ERC4906Metadata.CallOpts)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC4906Metadata *ERC4906MetadataCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _ERC4906Metadata.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC4906Metadata *ERC4906MetadataSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _ERC4906Metadata.Contract.TokenURI(&_ERC4906Metadata.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC4906Metadata *ERC4906MetadataCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _ERC4906Metadata.Contract.TokenURI(&_ERC4906Metadata.CallOpts, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.Approve(&_ERC4906Metadata.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.Approve(&_ERC4906Metadata.TransactOpts, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.SafeTransferFrom(&_ERC4906Metadata.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.SafeTransferFrom(&_ERC4906Metadata.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC4906Metadata.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_ERC4906Metadata *ERC4906MetadataSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.SafeTransferFrom0(&_ERC4906Metadata.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.SafeTransferFrom0(&_ERC4906Metadata.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC4906Metadata.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC4906Metadata *ERC4906MetadataSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.SetApprovalForAll(&_ERC4906Metadata.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.SetApprovalForAll(&_ERC4906Metadata.TransactOpts, operator, approved)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.TransferFrom(&_ERC4906Metadata.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.TransferFrom(&_ERC4906Metadata.TransactOpts, from, to, tokenId)
+}
+
+// UpdateAll is a paid mutator transaction binding the contract method 0xa89c5cb9.
+//
+// Solidity: function updateAll(uint256 fromTokenId, uint256 toTokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactor) UpdateAll(opts *bind.TransactOpts, fromTokenId *big.Int, toTokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.contract.Transact(opts, "updateAll", fromTokenId, toTokenId)
+}
+
+// UpdateAll is a paid mutator transaction binding the contract method 0xa89c5cb9.
+//
+// Solidity: function updateAll(uint256 fromTokenId, uint256 toTokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataSession) UpdateAll(fromTokenId *big.Int, toTokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.UpdateAll(&_ERC4906Metadata.TransactOpts, fromTokenId, toTokenId)
+}
+
+// UpdateAll is a paid mutator transaction binding the contract method 0xa89c5cb9.
+//
+// Solidity: function updateAll(uint256 fromTokenId, uint256 toTokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactorSession) UpdateAll(fromTokenId *big.Int, toTokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.UpdateAll(&_ERC4906Metadata.TransactOpts, fromTokenId, toTokenId)
+}
+
+// UpdateToken is a paid mutator transaction binding the contract method 0x8d5b98d5.
+//
+// Solidity: function updateToken(uint256 tokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactor) UpdateToken(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.contract.Transact(opts, "updateToken", tokenId)
+}
+
+// UpdateToken is a paid mutator transaction binding the contract method 0x8d5b98d5.
+//
+// Solidity: function updateToken(uint256 tokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataSession) UpdateToken(tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.UpdateToken(&_ERC4906Metadata.TransactOpts, tokenId)
+}
+
+// UpdateToken is a paid mutator transaction binding the contract method 0x8d5b98d5.
+//
+// Solidity: function updateToken(uint256 tokenId) returns()
+func (_ERC4906Metadata *ERC4906MetadataTransactorSession) UpdateToken(tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC4906Metadata.Contract.UpdateToken(&_ERC4906Metadata.TransactOpts, tokenId)
+}
+
+// ERC4906MetadataApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the ERC4906Metadata contract.
+type ERC4906MetadataApprovalIterator struct {
+	Event *ERC4906MetadataApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC4906MetadataApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC4906MetadataApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC4906MetadataApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC4906MetadataApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC4906MetadataApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC4906MetadataApproval represents a Approval event raised by the ERC4906Metadata contract.
+type ERC4906MetadataApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*ERC4906MetadataApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC4906Metadata.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4906MetadataApprovalIterator{contract: _ERC4906Metadata.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *ERC4906MetadataApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC4906Metadata.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC4906MetadataApproval)
+				if err := _ERC4906Metadata.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) ParseApproval(log types.Log) (*ERC4906MetadataApproval, error) {
+	event := new(ERC4906MetadataApproval)
+	if err := _ERC4906Metadata.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC4906MetadataApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the ERC4906Metadata contract.
+type ERC4906MetadataApprovalForAllIterator struct {
+	Event *ERC4906MetadataApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC4906MetadataApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC4906MetadataApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC4906MetadataApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC4906MetadataApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC4906MetadataApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC4906MetadataApprovalForAll represents a ApprovalForAll event raised by the ERC4906Metadata contract.
+type ERC4906MetadataApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*ERC4906MetadataApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC4906Metadata.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4906MetadataApprovalForAllIterator{contract: _ERC4906Metadata.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *ERC4906MetadataApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC4906Metadata.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC4906MetadataApprovalForAll)
+				if err := _ERC4906Metadata.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) ParseApprovalForAll(log types.Log) (*ERC4906MetadataApprovalForAll, error) {
+	event := new(ERC4906MetadataApprovalForAll)
+	if err := _ERC4906Metadata.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC4906MetadataBatchMetadataUpdateIterator is returned from FilterBatchMetadataUpdate and is used to iterate over the raw logs and unpacked data for BatchMetadataUpdate events raised by the ERC4906Metadata contract.
+type ERC4906MetadataBatchMetadataUpdateIterator struct {
+	Event *ERC4906MetadataBatchMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC4906MetadataBatchMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC4906MetadataBatchMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC4906MetadataBatchMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC4906MetadataBatchMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC4906MetadataBatchMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC4906MetadataBatchMetadataUpdate represents a BatchMetadataUpdate event raised by the ERC4906Metadata contract.
+type ERC4906MetadataBatchMetadataUpdate struct {
+	FromTokenId *big.Int
+	ToTokenId   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchMetadataUpdate is a free log retrieval operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) FilterBatchMetadataUpdate(opts *bind.FilterOpts) (*ERC4906MetadataBatchMetadataUpdateIterator, error) {
+
+	logs, sub, err := _ERC4906Metadata.contract.FilterLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4906MetadataBatchMetadataUpdateIterator{contract: _ERC4906Metadata.contract, event: "BatchMetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchMetadataUpdate is a free log subscription operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) WatchBatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *ERC4906MetadataBatchMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _ERC4906Metadata.contract.WatchLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC4906MetadataBatchMetadataUpdate)
+				if err := _ERC4906Metadata.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchMetadataUpdate is a log parse operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) ParseBatchMetadataUpdate(log types.Log) (*ERC4906MetadataBatchMetadataUpdate, error) {
+	event := new(ERC4906MetadataBatchMetadataUpdate)
+	if err := _ERC4906Metadata.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC4906MetadataMetadataUpdateIterator is returned from FilterMetadataUpdate and is used to iterate over the raw logs and unpacked data for MetadataUpdate events raised by the ERC4906Metadata contract.
+type ERC4906MetadataMetadataUpdateIterator struct {
+	Event *ERC4906MetadataMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC4906MetadataMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC4906MetadataMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC4906MetadataMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC4906MetadataMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC4906MetadataMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC4906MetadataMetadataUpdate represents a MetadataUpdate event raised by the ERC4906Metadata contract.
+type ERC4906MetadataMetadataUpdate struct {
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMetadataUpdate is a free log retrieval operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) FilterMetadataUpdate(opts *bind.FilterOpts) (*ERC4906MetadataMetadataUpdateIterator, error) {
+
+	logs, sub, err := _ERC4906Metadata.contract.FilterLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4906MetadataMetadataUpdateIterator{contract: _ERC4906Metadata.contract, event: "MetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchMetadataUpdate is a free log subscription operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) WatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *ERC4906MetadataMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _ERC4906Metadata.contract.WatchLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC4906MetadataMetadataUpdate)
+				if err := _ERC4906Metadata.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMetadataUpdate is a log parse operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) ParseMetadataUpdate(log types.Log) (*ERC4906MetadataMetadataUpdate, error) {
+	event := new(ERC4906MetadataMetadataUpdate)
+	if err := _ERC4906Metadata.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC4906MetadataTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the ERC4906Metadata contract.
+type ERC4906MetadataTransferIterator struct {
+	Event *ERC4906MetadataTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC4906MetadataTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC4906MetadataTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC4906MetadataTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC4906MetadataTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC4906MetadataTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC4906MetadataTransfer represents a Transfer event raised by the ERC4906Metadata contract.
+type ERC4906MetadataTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*ERC4906MetadataTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC4906Metadata.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC4906MetadataTransferIterator{contract: _ERC4906Metadata.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ERC4906MetadataTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC4906Metadata.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC4906MetadataTransfer)
+				if err := _ERC4906Metadata.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC4906Metadata *ERC4906MetadataFilterer) ParseTransfer(log types.Log) (*ERC4906MetadataTransfer, error) {
+	event := new(ERC4906MetadataTransfer)
+	if err := _ERC4906Metadata.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
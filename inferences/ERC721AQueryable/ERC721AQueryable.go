@@ -0,0 +1,1362 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ERC721AQueryable
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IERC721ATokenOwnership is an auto generated low-level Go binding around an user-defined struct.
+type IERC721ATokenOwnership struct {
+	Addr           common.Address
+	StartTimestamp uint64
+	Burned         bool
+	ExtraData      *big.Int
+}
+
+// ERC721AQueryableMetaData contains all meta data concerning the ERC721AQueryable contract.
+var ERC721AQueryableMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"ApprovalCallerNotOwnerNorApproved\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ApprovalQueryForNonexistentToken\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"BalanceQueryForZeroAddress\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidQueryRange\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MintERC2309QuantityExceedsLimit\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MintToZeroAddress\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MintZeroQuantity\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OwnerQueryForNonexistentToken\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OwnershipNotInitializedForExtraData\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"TransferCallerNotOwnerNorApproved\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"TransferFromIncorrectOwner\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"TransferToNonERC721ReceiverImplementer\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"TransferToZeroAddress\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"URIQueryForNonexistentToken\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"fromTokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"toTokenId\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"}],\"name\":\"ConsecutiveTransfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"explicitOwnershipOf\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"startTimestamp\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"burned\",\"type\":\"bool\"},{\"internalType\":\"uint24\",\"name\":\"extraData\",\"type\":\"uint24\"}],\"internalType\":\"structIERC721A.TokenOwnership\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256[]\",\"name\":\"tokenIds\",\"type\":\"uint256[]\"}],\"name\":\"explicitOwnershipsOf\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"startTimestamp\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"burned\",\"type\":\"bool\"},{\"internalType\":\"uint24\",\"name\":\"extraData\",\"type\":\"uint24\"}],\"internalType\":\"structIERC721A.TokenOwnership[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"tokensOfOwner\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"stop\",\"type\":\"uint256\"}],\"name\":\"tokensOfOwnerIn\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+	Bin: "0x608060405234801561001057600080fd5b5060405180604001604052806009815260200168517565727961626c6560b81b8152506040518060400160405280600381526020016251525960e81b815250816002908161005e9190610216565b50600361006b8282610216565b5050600080555061007d33600a610082565b6102d4565b6000546001600160a01b0383166100ab57604051622e076360e81b815260040160405180910390fd5b816000036100cc5760405163b562e8dd60e01b815260040160405180910390fd5b6113888211156100ef57604051633db1f9af60e01b815260040160405180910390fd5b6001600160a01b03831660008181526005602090815260408083208054680100000000000000018802019055848352600482528083206001871460e11b4260a01b17851790558051600019868801018152905185927fdeaa91b6123d068f5821d0fb0678463d1a8a6079fe8af5de3ce5e896dcf9133d928290030190a40160005550565b505050565b634e487b7160e01b600052604160045260246000fd5b600181811c908216806101a257607f821691505b6020821081036101c257634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561017357806000526020600020601f840160051c810160208510156101ef5750805b601f840160051c820191505b8181101561020f57600081556001016101fb565b5050505050565b81516001600160401b0381111561022f5761022f610178565b6102438161023d845461018e565b846101c8565b6020601f821160018114610277576000831561025f5750848201515b600019600385901b1c1916600184901b17845561020f565b600084815260208120601f198516915b828110156102a75787850151825560209485019460019092019101610287565b50848210156102c55786840151600019600387901b60f8161c191681555b50505050600190811b01905550565b61147e806102e36000396000f3fe6080604052600436106101095760003560e01c806370a0823111610095578063a22cb46511610064578063a22cb465146102ca578063b88d4fde146102ea578063c23dc68f146102fd578063c87b56dd1461032a578063e985e9c51461034a57600080fd5b806370a08231146102485780638462151c1461026857806395d89b411461029557806399a2557a146102aa57600080fd5b806318160ddd116100dc57806318160ddd146101b257806323b872dd146101d557806342842e0e146101e85780635bbb2177146101fb5780636352211e1461022857600080fd5b806301ffc9a71461010e57806306fdde0314610143578063081812fc14610165578063095ea7b31461019d575b600080fd5b34801561011a57600080fd5b5061012e610129366004610ef8565b61036a565b60405190151581526020015b60405180910390f35b34801561014f57600080fd5b506101586103bc565b60405161013a9190610f65565b34801561017157600080fd5b50610185610180366004610f78565b61044e565b6040516001600160a01b03909116815260200161013a565b6101b06101ab366004610fad565b610492565b005b3480156101be57600080fd5b50600154600054035b60405190815260200161013a565b6101b06101e3366004610fd7565b610532565b6101b06101f6366004610fd7565b6106a3565b34801561020757600080fd5b5061021b61021636600461105b565b6106c3565b60405161013a9190611149565b34801561023457600080fd5b50610185610243366004610f78565b610791565b34801561025457600080fd5b506101c7610263366004611197565b61079c565b34801561027457600080fd5b50610288610283366004611197565b6107eb565b60405161013a91906111b2565b3480156102a157600080fd5b506101586108f4565b3480156102b657600080fd5b506102886102c53660046111ea565b610903565b3480156102d657600080fd5b506101b06102e536600461121d565b610a7d565b6101b06102f8366004611259565b610ae9565b34801561030957600080fd5b5061031d610318366004610f78565b610b33565b60405161013a919061131e565b34801561033657600080fd5b50610158610345366004610f78565b610bab565b34801561035657600080fd5b5061012e61036536600461132c565b610c3b565b60006301ffc9a760e01b6001600160e01b03198316148061039b57506380ac58cd60e01b6001600160e01b03198316145b806103b65750635b5e139f60e01b6001600160e01b03198316145b92915050565b6060600280546103cb9061135f565b80601f01602080910402602001604051908101604052809291908181526020018280546103f79061135f565b80156104445780601f1061041957610100808354040283529160200191610444565b820191906000526020600020905b81548152906001019060200180831161042757829003601f168201915b5050505050905090565b600061045982610c69565b610476576040516333d1c03960e21b815260040160405180910390fd5b506000908152600660205260409020546001600160a01b031690565b600061049d82610791565b9050336001600160a01b038216146104d6576104b98133610c3b565b6104d6576040516367d9dca160e11b815260040160405180910390fd5b60008281526006602052604080822080546001600160a01b0319166001600160a01b0387811691821790925591518593918516917f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591a4505050565b600061053d82610c90565b9050836001600160a01b0316816001600160a01b0316146105705760405162a1148160e81b815260040160405180910390fd5b60008281526006602052604090208054338082146001600160a01b038816909114176105bd576105a08633610c3b565b6105bd57604051632ce44b5f60e11b815260040160405180910390fd5b80156105c857600082555b6001600160a01b038681166000908152600560205260408082208054600019019055918716808252919020805460010190554260a01b17600160e11b17600085815260046020526040812091909155600160e11b8416900361065a576001840160008181526004602052604081205490036106585760005481146106585760008181526004602052604090208490555b505b83856001600160a01b0316876001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef60405160405180910390a4505050505050565b6106be83838360405180602001604052806000815250610ae9565b505050565b805160609060008167ffffffffffffffff8111156106e3576106e3611014565b60405190808252806020026020018201604052801561073557816020015b6040805160808101825260008082526020808301829052928201819052606082015282526000199092019101816107015790505b50905060005b8281146107895761076485828151811061075757610757611399565b6020026020010151610b33565b82828151811061077657610776611399565b602090810291909101015260010161073b565b509392505050565b60006103b682610c90565b60006001600160a01b0382166107c5576040516323d3ad8160e21b815260040160405180910390fd5b506001600160a01b031660009081526005602052604090205467ffffffffffffffff1690565b606060008060006107fb8561079c565b905060008167ffffffffffffffff81111561081857610818611014565b604051908082528060200260200182016040528015610841578160200160208202803683370190505b50905061086e60408051608081018252600080825260208201819052918101829052606081019190915290565b60005b8386146108e85761088181610cf7565b915081604001516108e05781516001600160a01b0316156108a157815194505b876001600160a01b0316856001600160a01b0316036108e057808387806001019850815181106108d3576108d3611399565b6020026020010181815250505b600101610871565b50909695505050505050565b6060600380546103cb9061135f565b606081831061092557604051631960ccad60e11b815260040160405180910390fd5b60008061093160005490565b90508084111561093f578093505b600061094a8761079c565b9050848610156109695785850381811015610963578091505b5061096d565b5060005b60008167ffffffffffffffff81111561098857610988611014565b6040519080825280602002602001820160405280156109b1578160200160208202803683370190505b509050816000036109c7579350610a7692505050565b60006109d288610b33565b9050600081604001516109e3575080515b885b8881141580156109f55750848714155b15610a6a57610a0381610cf7565b92508260400151610a625782516001600160a01b031615610a2357825191505b8a6001600160a01b0316826001600160a01b031603610a625780848880600101995081518110610a5557610a55611399565b6020026020010181815250505b6001016109e5565b50505092835250909150505b9392505050565b3360008181526007602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915590519081529192917f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a35050565b610af4848484610532565b6001600160a01b0383163b15610b2d57610b1084848484610d33565b610b2d576040516368d2bf6b60e11b815260040160405180910390fd5b50505050565b6040805160808082018352600080835260208084018290528385018290526060808501839052855193840186528284529083018290529382018190529281018390529091506000548310610b875792915050565b610b9083610cf7565b9050806040015115610ba25792915050565b610a7683610e1e565b6060610bb682610c69565b610bd357604051630a14c4b560e41b815260040160405180910390fd5b6000610bea60408051602081019091526000815290565b90508051600003610c0a5760405180602001604052806000815250610a76565b80610c1484610e53565b604051602001610c259291906113af565b6040516020818303038152906040529392505050565b6001600160a01b03918216600090815260076020908152604080832093909416825291909152205460ff1690565b60008054821080156103b6575050600090815260046020526040902054600160e01b161590565b600081600054811015610cde5760008181526004602052604081205490600160e01b82169003610cdc575b80600003610a76575060001901600081815260046020526040902054610cbb565b505b604051636f96cda160e11b815260040160405180910390fd5b6040805160808101825260008082526020820181905291810182905260608101919091526000828152600460205260409020546103b690610e97565b604051630a85bd0160e11b81526000906001600160a01b0385169063150b7a0290610d689033908990889088906004016113ee565b6020604051808303816000875af1925050508015610da3575060408051601f3d908101601f19168201909252610da09181019061142b565b60015b610e01573d808015610dd1576040519150601f19603f3d011682016040523d82523d6000602084013e610dd6565b606091505b508051600003610df9576040516368d2bf6b60e11b815260040160405180910390fd5b805181602001fd5b6001600160e01b031916630a85bd0160e11b149050949350505050565b6040805160808101825260008082526020820181905291810182905260608101919091526103b6610e4e83610c90565b610e97565b606060a06040510180604052602081039150506000815280825b600183039250600a81066030018353600a900480610e6d5750819003601f19909101908152919050565b604080516080810182526001600160a01b038316815260a083901c67ffffffffffffffff166020820152600160e01b831615159181019190915260e89190911c606082015290565b6001600160e01b031981168114610ef557600080fd5b50565b600060208284031215610f0a57600080fd5b8135610a7681610edf565b60005b83811015610f30578181015183820152602001610f18565b50506000910152565b60008151808452610f51816020860160208601610f15565b601f01601f19169290920160200192915050565b602081526000610a766020830184610f39565b600060208284031215610f8a57600080fd5b5035919050565b80356001600160a01b0381168114610fa857600080fd5b919050565b60008060408385031215610fc057600080fd5b610fc983610f91565b946020939093013593505050565b600080600060608486031215610fec57600080fd5b610ff584610f91565b925061100360208501610f91565b929592945050506040919091013590565b634e487b7160e01b600052604160045260246000fd5b604051601f8201601f1916810167ffffffffffffffff8111828210171561105357611053611014565b604052919050565b60006020828403121561106d57600080fd5b813567ffffffffffffffff81111561108457600080fd5b8201601f8101841361109557600080fd5b803567ffffffffffffffff8111156110af576110af611014565b8060051b6110bf6020820161102a565b918252602081840181019290810190878411156110db57600080fd5b6020850194505b83851015611101578435808352602095860195909350909101906110e2565b979650505050505050565b80516001600160a01b0316825260208082015167ffffffffffffffff169083015260408082015115159083015260609081015162ffffff16910152565b602080825282518282018190526000918401906040840190835b8181101561118c5761117683855161110c565b6020939093019260809290920191600101611163565b509095945050505050565b6000602082840312156111a957600080fd5b610a7682610f91565b602080825282518282018190526000918401906040840190835b8181101561118c5783518352602093840193909201916001016111cc565b6000806000606084860312156111ff57600080fd5b61120884610f91565b95602085013595506040909401359392505050565b6000806040838503121561123057600080fd5b61123983610f91565b91506020830135801515811461124e57600080fd5b809150509250929050565b6000806000806080858703121561126f57600080fd5b61127885610f91565b935061128660208601610f91565b925060408501359150606085013567ffffffffffffffff8111156112a957600080fd5b8501601f810187136112ba57600080fd5b803567ffffffffffffffff8111156112d4576112d4611014565b6112e7601f8201601f191660200161102a565b8181528860208385010111156112fc57600080fd5b8160208401602083013760006020838301015280935050505092959194509250565b608081016103b6828461110c565b6000806040838503121561133f57600080fd5b61134883610f91565b915061135660208401610f91565b90509250929050565b600181811c9082168061137357607f821691505b60208210810361139357634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052603260045260246000fd5b600083516113c1818460208801610f15565b8351908301906113d5818360208801610f15565b64173539b7b760d91b9101908152600501949350505050565b6001600160a01b038581168252841660208201526040810183905260806060820181905260009061142190830184610f39565b9695505050505050565b60006020828403121561143d57600080fd5b8151610a7681610edf56fea26469706673582212209c1703480e80529d577639b665251722a14d3b58a32a2065ca831a5dd7981b1d64736f6c634300081e0033",
+}
+
+// ERC721AQueryableABI is the input ABI used to generate the binding from.
+// Deprecated: Use ERC721AQueryableMetaData.ABI instead.
+var ERC721AQueryableABI = ERC721AQueryableMetaData.ABI
+
+// ERC721AQueryableBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ERC721AQueryableMetaData.Bin instead.
+var ERC721AQueryableBin = ERC721AQueryableMetaData.Bin
+
+// DeployERC721AQueryable deploys a new Ethereum contract, binding an instance of ERC721AQueryable to it.
+func DeployERC721AQueryable(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ERC721AQueryable, error) {
+	parsed, err := ERC721AQueryableMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ERC721AQueryableBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC721AQueryable{ERC721AQueryableCaller: ERC721AQueryableCaller{contract: contract}, ERC721AQueryableTransactor: ERC721AQueryableTransactor{contract: contract}, ERC721AQueryableFilterer: ERC721AQueryableFilterer{contract: contract}}, nil
+}
+
+// ERC721AQueryable is an auto generated Go binding around an Ethereum contract.
+type ERC721AQueryable struct {
+	ERC721AQueryableCaller     // Read-only binding to the contract
+	ERC721AQueryableTransactor // Write-only binding to the contract
+	ERC721AQueryableFilterer   // Log filterer for contract events
+}
+
+// ERC721AQueryableCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC721AQueryableCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC721AQueryableTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC721AQueryableTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC721AQueryableFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC721AQueryableFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC721AQueryableSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC721AQueryableSession struct {
+	Contract     *ERC721AQueryable // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC721AQueryableCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC721AQueryableCallerSession struct {
+	Contract *ERC721AQueryableCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// ERC721AQueryableTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC721AQueryableTransactorSession struct {
+	Contract     *ERC721AQueryableTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// ERC721AQueryableRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC721AQueryableRaw struct {
+	Contract *ERC721AQueryable // Generic contract binding to access the raw methods on
+}
+
+// ERC721AQueryableCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC721AQueryableCallerRaw struct {
+	Contract *ERC721AQueryableCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC721AQueryableTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC721AQueryableTransactorRaw struct {
+	Contract *ERC721AQueryableTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC721AQueryable creates a new instance of ERC721AQueryable, bound to a specific deployed contract.
+func NewERC721AQueryable(address common.Address, backend bind.ContractBackend) (*ERC721AQueryable, error) {
+	contract, err := bindERC721AQueryable(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721AQueryable{ERC721AQueryableCaller: ERC721AQueryableCaller{contract: contract}, ERC721AQueryableTransactor: ERC721AQueryableTransactor{contract: contract}, ERC721AQueryableFilterer: ERC721AQueryableFilterer{contract: contract}}, nil
+}
+
+// NewERC721AQueryableCaller creates a new read-only instance of ERC721AQueryable, bound to a specific deployed contract.
+func NewERC721AQueryableCaller(address common.Address, caller bind.ContractCaller) (*ERC721AQueryableCaller, error) {
+	contract, err := bindERC721AQueryable(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721AQueryableCaller{contract: contract}, nil
+}
+
+// NewERC721AQueryableTransactor creates a new write-only instance of ERC721AQueryable, bound to a specific deployed contract.
+func NewERC721AQueryableTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC721AQueryableTransactor, error) {
+	contract, err := bindERC721AQueryable(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721AQueryableTransactor{contract: contract}, nil
+}
+
+// NewERC721AQueryableFilterer creates a new log filterer instance of ERC721AQueryable, bound to a specific deployed contract.
+func NewERC721AQueryableFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC721AQueryableFilterer, error) {
+	contract, err := bindERC721AQueryable(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721AQueryableFilterer{contract: contract}, nil
+}
+
+// bindERC721AQueryable binds a generic wrapper to an already deployed contract.
+func bindERC721AQueryable(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ERC721AQueryableMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC721AQueryable *ERC721AQueryableRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC721AQueryable.Contract.ERC721AQueryableCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC721AQueryable *ERC721AQueryableRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.ERC721AQueryableTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC721AQueryable *ERC721AQueryableRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.ERC721AQueryableTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC721AQueryable *ERC721AQueryableCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC721AQueryable.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC721AQueryable *ERC721AQueryableTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC721AQueryable *ERC721AQueryableTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC721AQueryable *ERC721AQueryableCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC721AQueryable.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC721AQueryable *ERC721AQueryableSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ERC721AQueryable.Contract.BalanceOf(&_ERC721AQueryable.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC721AQueryable *ERC721AQueryableCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ERC721AQueryable.Contract.BalanceOf(&_ERC721AQueryable.CallOpts, owner)
+}
+
+// ExplicitOwnershipOf is a free data retrieval call binding the contract method 0xc23dc68f.
+//
+// Solidity: function explicitOwnershipOf(uint256 tokenId) view returns((address,uint64,bool,uint24))
+func (_ERC721AQueryable *ERC721AQueryableCaller) ExplicitOwnershipOf(opts *bind.CallOpts, tokenId *big.Int) (IERC721ATokenOwnership, error) {
+	var out []interface{}
+	err := _ERC721AQueryable.contract.Call(opts, &out, "explicitOwnershipOf", tokenId)
+
+	if err != nil {
+		return *new(IERC721ATokenOwnership), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IERC721ATokenOwnership)).(*IERC721ATokenOwnership)
+
+	return out0, err
+
+}
+
+// ExplicitOwnershipOf is a free data retrieval call binding the contract method 0xc23dc68f.
+//
+// Solidity: function explicitOwnershipOf(uint256 tokenId) view returns((address,uint64,bool,uint24))
+func (_ERC721AQueryable *ERC721AQueryableSession) ExplicitOwnershipOf(tokenId *big.Int) (IERC721ATokenOwnership, error) {
+	return _ERC721AQueryable.Contract.ExplicitOwnershipOf(&_ERC721AQueryable.CallOpts, tokenId)
+}
+
+// ExplicitOwnershipOf is a free data retrieval call binding the contract method 0xc23dc68f.
+//
+// Solidity: function explicitOwnershipOf(uint256 tokenId) view returns((address,uint64,bool,uint24))
+func (_ERC721AQueryable *ERC721AQueryableCallerSession) ExplicitOwnershipOf(tokenId *big.Int) (IERC721ATokenOwnership, error) {
+	return _ERC721AQueryable.Contract.ExplicitOwnershipOf(&_ERC721AQueryable.CallOpts, tokenId)
+}
+
+// ExplicitOwnershipsOf is a free data retrieval call binding the contract method 0x5bbb2177.
+//
+// Solidity: function explicitOwnershipsOf(uint256[] tokenIds) view returns((address,uint64,bool,uint24)[])
+func (_ERC721AQueryable *ERC721AQueryableCaller) ExplicitOwnershipsOf(opts *bind.CallOpts, tokenIds []*big.Int) ([]IERC721ATokenOwnership, error) {
+	var out []interface{}
+	err := _ERC721AQueryable.contract.Call(opts, &out, "explicitOwnershipsOf", tokenIds)
+
+	if err != nil {
+		return *new([]IERC721ATokenOwnership), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IERC721ATokenOwnership)).(*[]IERC721ATokenOwnership)
+
+	return out0, err
+
+}
+
+// ExplicitOwnershipsOf is a free data retrieval call binding the contract method 0x5bbb2177.
+//
+// Solidity: function explicitOwnershipsOf(uint256[] tokenIds) view returns((address,uint64,bool,uint24)[])
+func (_ERC721AQueryable *ERC721AQueryableSession) ExplicitOwnershipsOf(tokenIds []*big.Int) ([]IERC721ATokenOwnership, error) {
+	return _ERC721AQueryable.Contract.ExplicitOwnershipsOf(&_ERC721AQueryable.CallOpts, tokenIds)
+}
+
+// ExplicitOwnershipsOf is a free data retrieval call binding the contract method 0x5bbb2177.
+//
+// Solidity: function explicitOwnershipsOf(uint256[] tokenIds) view returns((address,uint64,bool,uint24)[])
+func (_ERC721AQueryable *ERC721AQueryableCallerSession) ExplicitOwnershipsOf(tokenIds []*big.Int) ([]IERC721ATokenOwnership, error) {
+	return _ERC721AQueryable.Contract.ExplicitOwnershipsOf(&_ERC721AQueryable.CallOpts, tokenIds)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC721AQueryable *ERC721AQueryableCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _ERC721AQueryable.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC721AQueryable *ERC721AQueryableSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ERC721AQueryable.Contract.GetApproved(&_ERC721AQueryable.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC721AQueryable *ERC721AQueryableCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ERC721AQueryable.Contract.GetApproved(&_ERC721AQueryable.CallOpts, tokenId)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC721AQueryable *ERC721AQueryableCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _ERC721AQueryable.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC721AQueryable *ERC721AQueryableSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ERC721AQueryable.Contract.IsApprovedForAll(&_ERC721AQueryable.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC721AQueryable *ERC721AQueryableCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ERC721AQueryable.Contract.IsApprovedForAll(&_ERC721AQueryable.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC721AQueryable *ERC721AQueryableCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC721AQueryable.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC721AQueryable *ERC721AQueryableSession) Name() (string, error) {
+	return _ERC721AQueryable.Contract.Name(&_ERC721AQueryable.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC721AQueryable *ERC721AQueryableCallerSession) Name() (string, error) {
+	return _ERC721AQueryable.Contract.Name(&_ERC721AQueryable.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC721AQueryable *ERC721AQueryableCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _ERC721AQueryable.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC721AQueryable *ERC721AQueryableSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ERC721AQueryable.Contract.OwnerOf(&_ERC721AQueryable.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC721AQueryable *ERC721AQueryableCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ERC721AQueryable.Contract.OwnerOf(&_ERC721AQueryable.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC721AQueryable *ERC721AQueryableCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _ERC721AQueryable.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC721AQueryable *ERC721AQueryableSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC721AQueryable.Contract.SupportsInterface(&_ERC721AQueryable.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC721AQueryable *ERC721AQueryableCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC721AQueryable.Contract.SupportsInterface(&_ERC721AQueryable.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC721AQueryable *ERC721AQueryableCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC721AQueryable.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC721AQueryable *ERC721AQueryableSession) Symbol() (string, error) {
+	return _ERC721AQueryable.Contract.Symbol(&_ERC721AQueryable.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC721AQueryable *ERC721AQueryableCallerSession) Symbol() (string, error) {
+	return _ERC721AQueryable.Contract.Symbol(&_ERC721AQueryable.CallOpts)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC721AQueryable *ERC721AQueryableCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _ERC721AQueryable.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC721AQueryable *ERC721AQueryableSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _ERC721AQueryable.Contract.TokenURI(&_ERC721AQueryable.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC721AQueryable *ERC721AQueryableCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _ERC721AQueryable.Contract.TokenURI(&_ERC721AQueryable.CallOpts, tokenId)
+}
+
+// TokensOfOwner is a free data retrieval call binding the contract method 0x8462151c.
+//
+// Solidity: function tokensOfOwner(address owner) view returns(uint256[])
+func (_ERC721AQueryable *ERC721AQueryableCaller) TokensOfOwner(opts *bind.CallOpts, owner common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _ERC721AQueryable.contract.Call(opts, &out, "tokensOfOwner", owner)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// TokensOfOwner is a free data retrieval call binding the contract method 0x8462151c.
+//
+// Solidity: function tokensOfOwner(address owner) view returns(uint256[])
+func (_ERC721AQueryable *ERC721AQueryableSession) TokensOfOwner(owner common.Address) ([]*big.Int, error) {
+	return _ERC721AQueryable.Contract.TokensOfOwner(&_ERC721AQueryable.CallOpts, owner)
+}
+
+// TokensOfOwner is a free data retrieval call binding the contract method 0x8462151c.
+//
+// Solidity: function tokensOfOwner(address owner) view returns(uint256[])
+func (_ERC721AQueryable *ERC721AQueryableCallerSession) TokensOfOwner(owner common.Address) ([]*big.Int, error) {
+	return _ERC721AQueryable.Contract.TokensOfOwner(&_ERC721AQueryable.CallOpts, owner)
+}
+
+// TokensOfOwnerIn is a free data retrieval call binding the contract method 0x99a2557a.
+//
+// Solidity: function tokensOfOwnerIn(address owner, uint256 start, uint256 stop) view returns(uint256[])
+func (_ERC721AQueryable *ERC721AQueryableCaller) TokensOfOwnerIn(opts *bind.CallOpts, owner common.Address, start *big.Int, stop *big.Int) ([]*big.Int, error) {
+	var out []interface{}
+	err := _ERC721AQueryable.contract.Call(opts, &out, "tokensOfOwnerIn", owner, start, stop)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// TokensOfOwnerIn is a free data retrieval call binding the contract method 0x99a2557a.
+//
+// Solidity: function tokensOfOwnerIn(address owner, uint256 start, uint256 stop) view returns(uint256[])
+func (_ERC721AQueryable *ERC721AQueryableSession) TokensOfOwnerIn(owner common.Address, start *big.Int, stop *big.Int) ([]*big.Int, error) {
+	return _ERC721AQueryable.Contract.TokensOfOwnerIn(&_ERC721AQueryable.CallOpts, owner, start, stop)
+}
+
+// TokensOfOwnerIn is a free data retrieval call binding the contract method 0x99a2557a.
+//
+// Solidity: function tokensOfOwnerIn(address owner, uint256 start, uint256 stop) view returns(uint256[])
+func (_ERC721AQueryable *ERC721AQueryableCallerSession) TokensOfOwnerIn(owner common.Address, start *big.Int, stop *big.Int) ([]*big.Int, error) {
+	return _ERC721AQueryable.Contract.TokensOfOwnerIn(&_ERC721AQueryable.CallOpts, owner, start, stop)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC721AQueryable *ERC721AQueryableCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC721AQueryable.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC721AQueryable *ERC721AQueryableSession) TotalSupply() (*big.Int, error) {
+	return _ERC721AQueryable.Contract.TotalSupply(&_ERC721AQueryable.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC721AQueryable *ERC721AQueryableCallerSession) TotalSupply() (*big.Int, error) {
+	return _ERC721AQueryable.Contract.TotalSupply(&_ERC721AQueryable.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) payable returns()
+func (_ERC721AQueryable *ERC721AQueryableTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721AQueryable.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) payable returns()
+func (_ERC721AQueryable *ERC721AQueryableSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.Approve(&_ERC721AQueryable.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) payable returns()
+func (_ERC721AQueryable *ERC721AQueryableTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.Approve(&_ERC721AQueryable.TransactOpts, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) payable returns()
+func (_ERC721AQueryable *ERC721AQueryableTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721AQueryable.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) payable returns()
+func (_ERC721AQueryable *ERC721AQueryableSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.SafeTransferFrom(&_ERC721AQueryable.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) payable returns()
+func (_ERC721AQueryable *ERC721AQueryableTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.SafeTransferFrom(&_ERC721AQueryable.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) payable returns()
+func (_ERC721AQueryable *ERC721AQueryableTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _ERC721AQueryable.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, _data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) payable returns()
+func (_ERC721AQueryable *ERC721AQueryableSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.SafeTransferFrom0(&_ERC721AQueryable.TransactOpts, from, to, tokenId, _data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) payable returns()
+func (_ERC721AQueryable *ERC721AQueryableTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.SafeTransferFrom0(&_ERC721AQueryable.TransactOpts, from, to, tokenId, _data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC721AQueryable *ERC721AQueryableTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721AQueryable.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC721AQueryable *ERC721AQueryableSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.SetApprovalForAll(&_ERC721AQueryable.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC721AQueryable *ERC721AQueryableTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.SetApprovalForAll(&_ERC721AQueryable.TransactOpts, operator, approved)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) payable returns()
+func (_ERC721AQueryable *ERC721AQueryableTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721AQueryable.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) payable returns()
+func (_ERC721AQueryable *ERC721AQueryableSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.TransferFrom(&_ERC721AQueryable.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) payable returns()
+func (_ERC721AQueryable *ERC721AQueryableTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721AQueryable.Contract.TransferFrom(&_ERC721AQueryable.TransactOpts, from, to, tokenId)
+}
+
+// ERC721AQueryableApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the ERC721AQueryable contract.
+type ERC721AQueryableApprovalIterator struct {
+	Event *ERC721AQueryableApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721AQueryableApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721AQueryableApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721AQueryableApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721AQueryableApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721AQueryableApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721AQueryableApproval represents a Approval event raised by the ERC721AQueryable contract.
+type ERC721AQueryableApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721AQueryable *ERC721AQueryableFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*ERC721AQueryableApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721AQueryable.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721AQueryableApprovalIterator{contract: _ERC721AQueryable.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721AQueryable *ERC721AQueryableFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *ERC721AQueryableApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721AQueryable.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721AQueryableApproval)
+				if err := _ERC721AQueryable.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721AQueryable *ERC721AQueryableFilterer) ParseApproval(log types.Log) (*ERC721AQueryableApproval, error) {
+	event := new(ERC721AQueryableApproval)
+	if err := _ERC721AQueryable.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721AQueryableApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the ERC721AQueryable contract.
+type ERC721AQueryableApprovalForAllIterator struct {
+	Event *ERC721AQueryableApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721AQueryableApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721AQueryableApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721AQueryableApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721AQueryableApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721AQueryableApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721AQueryableApprovalForAll represents a ApprovalForAll event raised by the ERC721AQueryable contract.
+type ERC721AQueryableApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721AQueryable *ERC721AQueryableFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*ERC721AQueryableApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC721AQueryable.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721AQueryableApprovalForAllIterator{contract: _ERC721AQueryable.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721AQueryable *ERC721AQueryableFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *ERC721AQueryableApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC721AQueryable.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721AQueryableApprovalForAll)
+				if err := _ERC721AQueryable.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721AQueryable *ERC721AQueryableFilterer) ParseApprovalForAll(log types.Log) (*ERC721AQueryableApprovalForAll, error) {
+	event := new(ERC721AQueryableApprovalForAll)
+	if err := _ERC721AQueryable.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721AQueryableConsecutiveTransferIterator is returned from FilterConsecutiveTransfer and is used to iterate over the raw logs and unpacked data for ConsecutiveTransfer events raised by the ERC721AQueryable contract.
+type ERC721AQueryableConsecutiveTransferIterator struct {
+	Event *ERC721AQueryableConsecutiveTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721AQueryableConsecutiveTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721AQueryableConsecutiveTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721AQueryableConsecutiveTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721AQueryableConsecutiveTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721AQueryableConsecutiveTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721AQueryableConsecutiveTransfer represents a ConsecutiveTransfer event raised by the ERC721AQueryable contract.
+type ERC721AQueryableConsecutiveTransfer struct {
+	FromTokenId *big.Int
+	ToTokenId   *big.Int
+	From        common.Address
+	To          common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterConsecutiveTransfer is a free log retrieval operation binding the contract event 0xdeaa91b6123d068f5821d0fb0678463d1a8a6079fe8af5de3ce5e896dcf9133d.
+//
+// Solidity: event ConsecutiveTransfer(uint256 indexed fromTokenId, uint256 toTokenId, address indexed from, address indexed to)
+func (_ERC721AQueryable *ERC721AQueryableFilterer) FilterConsecutiveTransfer(opts *bind.FilterOpts, fromTokenId []*big.Int, from []common.Address, to []common.Address) (*ERC721AQueryableConsecutiveTransferIterator, error) {
+
+	var fromTokenIdRule []interface{}
+	for _, fromTokenIdItem := range fromTokenId {
+		fromTokenIdRule = append(fromTokenIdRule, fromTokenIdItem)
+	}
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC721AQueryable.contract.FilterLogs(opts, "ConsecutiveTransfer", fromTokenIdRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721AQueryableConsecutiveTransferIterator{contract: _ERC721AQueryable.contract, event: "ConsecutiveTransfer", logs: logs, sub: sub}, nil
+}
+
+// WatchConsecutiveTransfer is a free log subscription operation binding the contract event 0xdeaa91b6123d068f5821d0fb0678463d1a8a6079fe8af5de3ce5e896dcf9133d.
+//
+// Solidity: event ConsecutiveTransfer(uint256 indexed fromTokenId, uint256 toTokenId, address indexed from, address indexed to)
+func (_ERC721AQueryable *ERC721AQueryableFilterer) WatchConsecutiveTransfer(opts *bind.WatchOpts, sink chan<- *ERC721AQueryableConsecutiveTransfer, fromTokenId []*big.Int, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromTokenIdRule []interface{}
+	for _, fromTokenIdItem := range fromTokenId {
+		fromTokenIdRule = append(fromTokenIdRule, fromTokenIdItem)
+	}
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC721AQueryable.contract.WatchLogs(opts, "ConsecutiveTransfer", fromTokenIdRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721AQueryableConsecutiveTransfer)
+				if err := _ERC721AQueryable.contract.UnpackLog(event, "ConsecutiveTransfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConsecutiveTransfer is a log parse operation binding the contract event 0xdeaa91b6123d068f5821d0fb0678463d1a8a6079fe8af5de3ce5e896dcf9133d.
+//
+// Solidity: event ConsecutiveTransfer(uint256 indexed fromTokenId, uint256 toTokenId, address indexed from, address indexed to)
+func (_ERC721AQueryable *ERC721AQueryableFilterer) ParseConsecutiveTransfer(log types.Log) (*ERC721AQueryableConsecutiveTransfer, error) {
+	event := new(ERC721AQueryableConsecutiveTransfer)
+	if err := _ERC721AQueryable.contract.UnpackLog(event, "ConsecutiveTransfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721AQueryableTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the ERC721AQueryable contract.
+type ERC721AQueryableTransferIterator struct {
+	Event *ERC721AQueryableTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721AQueryableTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721AQueryableTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721AQueryableTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721AQueryableTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721AQueryableTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721AQueryableTransfer represents a Transfer event raised by the ERC721AQueryable contract.
+type ERC721AQueryableTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721AQueryable *ERC721AQueryableFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*ERC721AQueryableTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721AQueryable.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721AQueryableTransferIterator{contract: _ERC721AQueryable.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721AQueryable *ERC721AQueryableFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ERC721AQueryableTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721AQueryable.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721AQueryableTransfer)
+				if err := _ERC721AQueryable.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721AQueryable *ERC721AQueryableFilterer) ParseTransfer(log types.Log) (*ERC721AQueryableTransfer, error) {
+	event := new(ERC721AQueryableTransfer)
+	if err := _ERC721AQueryable.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
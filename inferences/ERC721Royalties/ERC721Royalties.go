@@ -0,0 +1,1235 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ERC721Royalties
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ERC721RoyaltiesMetaData contains all meta data concerning the ERC721Royalties contract.
+var ERC721RoyaltiesMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"numerator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"denominator\",\"type\":\"uint256\"}],\"name\":\"ERC2981InvalidDefaultRoyalty\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC2981InvalidDefaultRoyaltyReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"numerator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"denominator\",\"type\":\"uint256\"}],\"name\":\"ERC2981InvalidTokenRoyalty\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC2981InvalidTokenRoyaltyReceiver\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ERC721EnumerableForbiddenBatchMint\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"ERC721IncorrectOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ERC721InsufficientApproval\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"}],\"name\":\"ERC721InvalidApprover\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"ERC721InvalidOperator\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"ERC721InvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC721InvalidReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"ERC721InvalidSender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ERC721NonexistentToken\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"ERC721OutOfBoundsIndex\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"salePrice\",\"type\":\"uint256\"}],\"name\":\"royaltyInfo\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint96\",\"name\":\"feeNumerator\",\"type\":\"uint96\"}],\"name\":\"setDefaultRoyalty\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint96\",\"name\":\"feeNumerator\",\"type\":\"uint96\"}],\"name\":\"setTokenRoyalty\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"tokenByIndex\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"tokenOfOwnerByIndex\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x608060405234801561001057600080fd5b5060405180604001604052806009815260200168526f79616c7469657360b81b81525060405180604001604052806003815260200162524f5960e81b815250816000908161005e9190610112565b50600161006b8282610112565b5050506101d0565b634e487b7160e01b600052604160045260246000fd5b600181811c9082168061009d57607f821691505b6020821081036100bd57634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561010d57806000526020600020601f840160051c810160208510156100ea5750805b601f840160051c820191505b8181101561010a57600081556001016100f6565b50505b505050565b81516001600160401b0381111561012b5761012b610073565b61013f816101398454610089565b846100c3565b6020601f821160018114610173576000831561015b5750848201515b600019600385901b1c1916600184901b17845561010a565b600084815260208120601f198516915b828110156101a35787850151825560209485019460019092019101610183565b50848210156101c15786840151600019600387901b60f8161c191681555b50505050600190811b01905550565b6116df806101df6000396000f3fe608060405234801561001057600080fd5b506004361061012c5760003560e01c806342842e0e116100ad57806395d89b411161007157806395d89b411461029d578063a22cb465146102a5578063b88d4fde146102b8578063c87b56dd146102cb578063e985e9c5146102de57600080fd5b806342842e0e1461023e5780634f6ccce7146102515780635944c753146102645780636352211e1461027757806370a082311461028a57600080fd5b806318160ddd116100f457806318160ddd146101c157806323b872dd146101d35780632a55205a146101e65780632f745c591461021857806340c10f191461022b57600080fd5b806301ffc9a71461013157806304634d8d1461015957806306fdde031461016e578063081812fc14610183578063095ea7b3146101ae575b600080fd5b61014461013f366004611219565b6102f1565b60405190151581526020015b60405180910390f35b61016c610167366004611269565b610302565b005b610176610310565b60405161015091906112ec565b6101966101913660046112ff565b6103a2565b6040516001600160a01b039091168152602001610150565b61016c6101bc366004611318565b6103cb565b6008545b604051908152602001610150565b61016c6101e1366004611342565b6103d6565b6101f96101f436600461137f565b610466565b604080516001600160a01b039093168352602083019190915201610150565b6101c5610226366004611318565b6104eb565b61016c610239366004611318565b610550565b61016c61024c366004611342565b61055a565b6101c561025f3660046112ff565b61057a565b61016c6102723660046113a1565b6105d3565b6101966102853660046112ff565b6105de565b6101c56102983660046113dd565b6105e9565b610176610631565b61016c6102b33660046113f8565b610640565b61016c6102c636600461144a565b61064b565b6101766102d93660046112ff565b610663565b6101446102ec36600461152e565b6106d8565b60006102fc82610706565b92915050565b61030c828261072b565b5050565b60606000805461031f90611558565b80601f016020809104026020016040519081016040528092919081815260200182805461034b90611558565b80156103985780601f1061036d57610100808354040283529160200191610398565b820191906000526020600020905b81548152906001019060200180831161037b57829003601f168201915b5050505050905090565b60006103ad826107ce565b506000828152600460205260409020546001600160a01b03166102fc565b61030c828233610807565b6001600160a01b03821661040557604051633250574960e11b8152600060048201526024015b60405180910390fd5b6000610412838333610814565b9050836001600160a01b0316816001600160a01b031614610460576040516364283d7b60e01b81526001600160a01b03808616600483015260248201849052821660448201526064016103fc565b50505050565b6000828152600b6020526040812080548291906001600160a01b03811690600160a01b90046001600160601b0316816104ba575050600a546001600160a01b03811690600160a01b90046001600160601b03165b60006127106104d26001600160601b038416896115a8565b6104dc91906115bf565b92989297509195505050505050565b60006104f6836105e9565b82106105275760405163295f44f760e21b81526001600160a01b0384166004820152602481018390526044016103fc565b506001600160a01b03919091166000908152600660209081526040808320938352929052205490565b61030c82826108e9565b6105758383836040518060200160405280600081525061064b565b505050565b600061058560085490565b82106105ae5760405163295f44f760e21b815260006004820152602481018390526044016103fc565b600882815481106105c1576105c16115e1565b90600052602060002001549050919050565b61057583838361094e565b60006102fc826107ce565b60006001600160a01b038216610615576040516322718ad960e21b8152600060048201526024016103fc565b506001600160a01b031660009081526003602052604090205490565b60606001805461031f90611558565b61030c338383610a10565b6106568484846103d6565b6104603385858585610aaf565b606061066e826107ce565b50600061068660408051602081019091526000815290565b905060008151116106a657604051806020016040528060008152506106d1565b806106b084610bda565b6040516020016106c19291906115f7565b6040516020818303038152906040525b9392505050565b6001600160a01b03918216600090815260056020908152604080832093909416825291909152205460ff1690565b60006001600160e01b0319821663152a902d60e11b14806102fc57506102fc82610c6d565b6127106001600160601b03821681101561076a57604051636f483d0960e01b81526001600160601b0383166004820152602481018290526044016103fc565b6001600160a01b03831661079457604051635b6cc80560e11b8152600060048201526024016103fc565b50604080518082019091526001600160a01b039092168083526001600160601b039091166020909201829052600160a01b90910217600a55565b6000818152600260205260408120546001600160a01b0316806102fc57604051637e27328960e01b8152600481018490526024016103fc565b6105758383836001610c92565b600080610822858585610d98565b90506001600160a01b03811661087f5761087a84600880546000838152600960205260408120829055600182018355919091527ff3f7a9fe364faab93b216da50a3214154f22a0a2b415b23a84c8169e8b636ee30155565b6108a2565b846001600160a01b0316816001600160a01b0316146108a2576108a28185610e91565b6001600160a01b0385166108be576108b984610f12565b6108e1565b846001600160a01b0316816001600160a01b0316146108e1576108e18585610fc1565b949350505050565b6001600160a01b03821661091357604051633250574960e11b8152600060048201526024016103fc565b600061092183836000610814565b90506001600160a01b03811615610575576040516339e3563760e11b8152600060048201526024016103fc565b6127106001600160601b0382168110156109945760405163dfd1fc1b60e01b8152600481018590526001600160601b0383166024820152604481018290526064016103fc565b6001600160a01b0383166109c557604051634b4f842960e11b815260048101859052600060248201526044016103fc565b506040805180820182526001600160a01b0393841681526001600160601b0392831660208083019182526000968752600b90529190942093519051909116600160a01b029116179055565b6001600160a01b038216610a4257604051630b61174360e31b81526001600160a01b03831660048201526024016103fc565b6001600160a01b03838116600081815260056020908152604080832094871680845294825291829020805460ff191686151590811790915591519182527f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a3505050565b6001600160a01b0383163b15610bd357604051630a85bd0160e11b81526001600160a01b0384169063150b7a0290610af1908890889087908790600401611626565b6020604051808303816000875af1925050508015610b2c575060408051601f3d908101601f19168201909252610b2991810190611663565b60015b610b95573d808015610b5a576040519150601f19603f3d011682016040523d82523d6000602084013e610b5f565b606091505b508051600003610b8d57604051633250574960e11b81526001600160a01b03851660048201526024016103fc565b805181602001fd5b6001600160e01b03198116630a85bd0160e11b14610bd157604051633250574960e11b81526001600160a01b03851660048201526024016103fc565b505b5050505050565b60606000610be783611011565b600101905060008167ffffffffffffffff811115610c0757610c07611434565b6040519080825280601f01601f191660200182016040528015610c31576020820181803683370190505b5090508181016020015b600019016f181899199a1a9b1b9c1cb0b131b232b360811b600a86061a8153600a8504945084610c3b57509392505050565b60006001600160e01b0319821663780e9d6360e01b14806102fc57506102fc826110e9565b8080610ca657506001600160a01b03821615155b15610d68576000610cb6846107ce565b90506001600160a01b03831615801590610ce25750826001600160a01b0316816001600160a01b031614155b8015610cf55750610cf381846106d8565b155b15610d1e5760405163a9fbf51f60e01b81526001600160a01b03841660048201526024016103fc565b8115610d665783856001600160a01b0316826001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45b505b5050600090815260046020526040902080546001600160a01b0319166001600160a01b0392909216919091179055565b6000828152600260205260408120546001600160a01b0390811690831615610dc557610dc5818486611139565b6001600160a01b03811615610e0357610de2600085600080610c92565b6001600160a01b038116600090815260036020526040902080546000190190555b6001600160a01b03851615610e32576001600160a01b0385166000908152600360205260409020805460010190555b60008481526002602052604080822080546001600160a01b0319166001600160a01b0389811691821790925591518793918516917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4949350505050565b6000610e9c836105e9565b6000838152600760209081526040808320546001600160a01b0388168452600690925290912091925090818314610ef357600083815260208281526040808320548584528184208190558352600790915290208290555b6000938452600760209081526040808620869055938552525081205550565b600854600090610f2490600190611680565b60008381526009602052604081205460088054939450909284908110610f4c57610f4c6115e1565b906000526020600020015490508060088381548110610f6d57610f6d6115e1565b6000918252602080832090910192909255828152600990915260408082208490558582528120556008805480610fa557610fa5611693565b6001900381819060005260206000200160009055905550505050565b60006001610fce846105e9565b610fd89190611680565b6001600160a01b039093166000908152600660209081526040808320868452825280832085905593825260079052919091209190915550565b60008072184f03e93ff9f4daa797ed6e38ed64bf6a1f0160401b83106110505772184f03e93ff9f4daa797ed6e38ed64bf6a1f0160401b830492506040015b6d04ee2d6d415b85acef8100000000831061107c576d04ee2d6d415b85acef8100000000830492506020015b662386f26fc10000831061109a57662386f26fc10000830492506010015b6305f5e10083106110b2576305f5e100830492506008015b61271083106110c657612710830492506004015b606483106110d8576064830492506002015b600a83106102fc5760010192915050565b60006001600160e01b031982166380ac58cd60e01b148061111a57506001600160e01b03198216635b5e139f60e01b145b806102fc57506301ffc9a760e01b6001600160e01b03198316146102fc565b61114483838361119d565b610575576001600160a01b03831661117257604051637e27328960e01b8152600481018290526024016103fc565b60405163177e802f60e01b81526001600160a01b0383166004820152602481018290526044016103fc565b60006001600160a01b038316158015906108e15750826001600160a01b0316846001600160a01b031614806111d757506111d784846106d8565b806108e15750506000908152600460205260409020546001600160a01b03908116911614919050565b6001600160e01b03198116811461121657600080fd5b50565b60006020828403121561122b57600080fd5b81356106d181611200565b80356001600160a01b038116811461124d57600080fd5b919050565b80356001600160601b038116811461124d57600080fd5b6000806040838503121561127c57600080fd5b61128583611236565b915061129360208401611252565b90509250929050565b60005b838110156112b757818101518382015260200161129f565b50506000910152565b600081518084526112d881602086016020860161129c565b601f01601f19169290920160200192915050565b6020815260006106d160208301846112c0565b60006020828403121561131157600080fd5b5035919050565b6000806040838503121561132b57600080fd5b61133483611236565b946020939093013593505050565b60008060006060848603121561135757600080fd5b61136084611236565b925061136e60208501611236565b929592945050506040919091013590565b6000806040838503121561139257600080fd5b50508035926020909101359150565b6000806000606084860312156113b657600080fd5b833592506113c660208501611236565b91506113d460408501611252565b90509250925092565b6000602082840312156113ef57600080fd5b6106d182611236565b6000806040838503121561140b57600080fd5b61141483611236565b91506020830135801515811461142957600080fd5b809150509250929050565b634e487b7160e01b600052604160045260246000fd5b6000806000806080858703121561146057600080fd5b61146985611236565b935061147760208601611236565b925060408501359150606085013567ffffffffffffffff81111561149a57600080fd5b8501601f810187136114ab57600080fd5b803567ffffffffffffffff8111156114c5576114c5611434565b604051601f8201601f19908116603f0116810167ffffffffffffffff811182821017156114f4576114f4611434565b60405281815282820160200189101561150c57600080fd5b8160208401602083013760006020838301015280935050505092959194509250565b6000806040838503121561154157600080fd5b61154a83611236565b915061129360208401611236565b600181811c9082168061156c57607f821691505b60208210810361158c57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b80820281158282048414176102fc576102fc611592565b6000826115dc57634e487b7160e01b600052601260045260246000fd5b500490565b634e487b7160e01b600052603260045260246000fd5b6000835161160981846020880161129c565b83519083019061161d81836020880161129c565b01949350505050565b6001600160a01b0385811682528416602082015260408101839052608060608201819052600090611659908301846112c0565b9695505050505050565b60006020828403121561167557600080fd5b81516106d181611200565b818103818111156102fc576102fc611592565b634e487b7160e01b600052603160045260246000fdfea264697066735822122073b3b61ac5d8eb7465bd51e5be5ba885857ca086276c8c1401b2288899c4c92d64736f6c634300081e0033",
+}
+
+// ERC721RoyaltiesABI is the input ABI used to generate the binding from.
+// Deprecated: Use ERC721RoyaltiesMetaData.ABI instead.
+var ERC721RoyaltiesABI = ERC721RoyaltiesMetaData.ABI
+
+// ERC721RoyaltiesBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ERC721RoyaltiesMetaData.Bin instead.
+var ERC721RoyaltiesBin = ERC721RoyaltiesMetaData.Bin
+
+// DeployERC721Royalties deploys a new Ethereum contract, binding an instance of ERC721Royalties to it.
+func DeployERC721Royalties(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ERC721Royalties, error) {
+	parsed, err := ERC721RoyaltiesMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ERC721RoyaltiesBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC721Royalties{ERC721RoyaltiesCaller: ERC721RoyaltiesCaller{contract: contract}, ERC721RoyaltiesTransactor: ERC721RoyaltiesTransactor{contract: contract}, ERC721RoyaltiesFilterer: ERC721RoyaltiesFilterer{contract: contract}}, nil
+}
+
+// ERC721Royalties is an auto generated Go binding around an Ethereum contract.
+type ERC721Royalties struct {
+	ERC721RoyaltiesCaller     // Read-only binding to the contract
+	ERC721RoyaltiesTransactor // Write-only binding to the contract
+	ERC721RoyaltiesFilterer   // Log filterer for contract events
+}
+
+// ERC721RoyaltiesCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC721RoyaltiesCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC721RoyaltiesTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC721RoyaltiesTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC721RoyaltiesFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC721RoyaltiesFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC721RoyaltiesSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC721RoyaltiesSession struct {
+	Contract     *ERC721Royalties  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC721RoyaltiesCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC721RoyaltiesCallerSession struct {
+	Contract *ERC721RoyaltiesCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// ERC721RoyaltiesTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC721RoyaltiesTransactorSession struct {
+	Contract     *ERC721RoyaltiesTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// ERC721RoyaltiesRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC721RoyaltiesRaw struct {
+	Contract *ERC721Royalties // Generic contract binding to access the raw methods on
+}
+
+// ERC721RoyaltiesCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC721RoyaltiesCallerRaw struct {
+	Contract *ERC721RoyaltiesCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC721RoyaltiesTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC721RoyaltiesTransactorRaw struct {
+	Contract *ERC721RoyaltiesTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC721Royalties creates a new instance of ERC721Royalties, bound to a specific deployed contract.
+func NewERC721Royalties(address common.Address, backend bind.ContractBackend) (*ERC721Royalties, error) {
+	contract, err := bindERC721Royalties(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721Royalties{ERC721RoyaltiesCaller: ERC721RoyaltiesCaller{contract: contract}, ERC721RoyaltiesTransactor: ERC721RoyaltiesTransactor{contract: contract}, ERC721RoyaltiesFilterer: ERC721RoyaltiesFilterer{contract: contract}}, nil
+}
+
+// NewERC721RoyaltiesCaller creates a new read-only instance of ERC721Royalties, bound to a specific deployed contract.
+func NewERC721RoyaltiesCaller(address common.Address, caller bind.ContractCaller) (*ERC721RoyaltiesCaller, error) {
+	contract, err := bindERC721Royalties(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721RoyaltiesCaller{contract: contract}, nil
+}
+
+// NewERC721RoyaltiesTransactor creates a new write-only instance of ERC721Royalties, bound to a specific deployed contract.
+func NewERC721RoyaltiesTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC721RoyaltiesTransactor, error) {
+	contract, err := bindERC721Royalties(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721RoyaltiesTransactor{contract: contract}, nil
+}
+
+// NewERC721RoyaltiesFilterer creates a new log filterer instance of ERC721Royalties, bound to a specific deployed contract.
+func NewERC721RoyaltiesFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC721RoyaltiesFilterer, error) {
+	contract, err := bindERC721Royalties(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721RoyaltiesFilterer{contract: contract}, nil
+}
+
+// bindERC721Royalties binds a generic wrapper to an already deployed contract.
+func bindERC721Royalties(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ERC721RoyaltiesMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC721Royalties *ERC721RoyaltiesRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC721Royalties.Contract.ERC721RoyaltiesCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC721Royalties *ERC721RoyaltiesRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.ERC721RoyaltiesTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC721Royalties *ERC721RoyaltiesRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.ERC721RoyaltiesTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC721Royalties *ERC721RoyaltiesCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC721Royalties.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC721Royalties *ERC721RoyaltiesTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC721Royalties *ERC721RoyaltiesTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC721Royalties *ERC721RoyaltiesCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC721Royalties.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC721Royalties *ERC721RoyaltiesSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ERC721Royalties.Contract.BalanceOf(&_ERC721Royalties.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC721Royalties *ERC721RoyaltiesCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ERC721Royalties.Contract.BalanceOf(&_ERC721Royalties.CallOpts, owner)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC721Royalties *ERC721RoyaltiesCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _ERC721Royalties.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC721Royalties *ERC721RoyaltiesSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ERC721Royalties.Contract.GetApproved(&_ERC721Royalties.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC721Royalties *ERC721RoyaltiesCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ERC721Royalties.Contract.GetApproved(&_ERC721Royalties.CallOpts, tokenId)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC721Royalties *ERC721RoyaltiesCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _ERC721Royalties.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC721Royalties *ERC721RoyaltiesSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ERC721Royalties.Contract.IsApprovedForAll(&_ERC721Royalties.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC721Royalties *ERC721RoyaltiesCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ERC721Royalties.Contract.IsApprovedForAll(&_ERC721Royalties.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC721Royalties *ERC721RoyaltiesCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC721Royalties.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC721Royalties *ERC721RoyaltiesSession) Name() (string, error) {
+	return _ERC721Royalties.Contract.Name(&_ERC721Royalties.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC721Royalties *ERC721RoyaltiesCallerSession) Name() (string, error) {
+	return _ERC721Royalties.Contract.Name(&_ERC721Royalties.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC721Royalties *ERC721RoyaltiesCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _ERC721Royalties.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC721Royalties *ERC721RoyaltiesSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ERC721Royalties.Contract.OwnerOf(&_ERC721Royalties.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC721Royalties *ERC721RoyaltiesCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ERC721Royalties.Contract.OwnerOf(&_ERC721Royalties.CallOpts, tokenId)
+}
+
+// RoyaltyInfo is a free data retrieval call binding the contract method 0x2a55205a.
+//
+// Solidity: function royaltyInfo(uint256 tokenId, uint256 salePrice) view returns(address receiver, uint256 amount)
+func (_ERC721Royalties *ERC721RoyaltiesCaller) RoyaltyInfo(opts *bind.CallOpts, tokenId *big.Int, salePrice *big.Int) (struct {
+	Receiver common.Address
+	Amount   *big.Int
+}, error) {
+	var out []interface{}
+	err := _ERC721Royalties.contract.Call(opts, &out, "royaltyInfo", tokenId, salePrice)
+
+	outstruct := new(struct {
+		Receiver common.Address
+		Amount   *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Receiver = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Amount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// RoyaltyInfo is a free data retrieval call binding the contract method 0x2a55205a.
+//
+// Solidity: function royaltyInfo(uint256 tokenId, uint256 salePrice) view returns(address receiver, uint256 amount)
+func (_ERC721Royalties *ERC721RoyaltiesSession) RoyaltyInfo(tokenId *big.Int, salePrice *big.Int) (struct {
+	Receiver common.Address
+	Amount   *big.Int
+}, error) {
+	return _ERC721Royalties.Contract.RoyaltyInfo(&_ERC721Royalties.CallOpts, tokenId, salePrice)
+}
+
+// RoyaltyInfo is a free data retrieval call binding the contract method 0x2a55205a.
+//
+// Solidity: function royaltyInfo(uint256 tokenId, uint256 salePrice) view returns(address receiver, uint256 amount)
+func (_ERC721Royalties *ERC721RoyaltiesCallerSession) RoyaltyInfo(tokenId *big.Int, salePrice *big.Int) (struct {
+	Receiver common.Address
+	Amount   *big.Int
+}, error) {
+	return _ERC721Royalties.Contract.RoyaltyInfo(&_ERC721Royalties.CallOpts, tokenId, salePrice)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC721Royalties *ERC721RoyaltiesCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _ERC721Royalties.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC721Royalties *ERC721RoyaltiesSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC721Royalties.Contract.SupportsInterface(&_ERC721Royalties.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC721Royalties *ERC721RoyaltiesCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC721Royalties.Contract.SupportsInterface(&_ERC721Royalties.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC721Royalties *ERC721RoyaltiesCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC721Royalties.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC721Royalties *ERC721RoyaltiesSession) Symbol() (string, error) {
+	return _ERC721Royalties.Contract.Symbol(&_ERC721Royalties.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC721Royalties *ERC721RoyaltiesCallerSession) Symbol() (string, error) {
+	return _ERC721Royalties.Contract.Symbol(&_ERC721Royalties.CallOpts)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_ERC721Royalties *ERC721RoyaltiesCaller) TokenByIndex(opts *bind.CallOpts, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC721Royalties.contract.Call(opts, &out, "tokenByIndex", index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_ERC721Royalties *ERC721RoyaltiesSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _ERC721Royalties.Contract.TokenByIndex(&_ERC721Royalties.CallOpts, index)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_ERC721Royalties *ERC721RoyaltiesCallerSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _ERC721Royalties.Contract.TokenByIndex(&_ERC721Royalties.CallOpts, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_ERC721Royalties *ERC721RoyaltiesCaller) TokenOfOwnerByIndex(opts *bind.CallOpts, owner common.Address, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC721Royalties.contract.Call(opts, &out, "tokenOfOwnerByIndex", owner, index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_ERC721Royalties *ERC721RoyaltiesSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _ERC721Royalties.Contract.TokenOfOwnerByIndex(&_ERC721Royalties.CallOpts, owner, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_ERC721Royalties *ERC721RoyaltiesCallerSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _ERC721Royalties.Contract.TokenOfOwnerByIndex(&_ERC721Royalties.CallOpts, owner, index)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC721Royalties *ERC721RoyaltiesCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _ERC721Royalties.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC721Royalties *ERC721RoyaltiesSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _ERC721Royalties.Contract.TokenURI(&_ERC721Royalties.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC721Royalties *ERC721RoyaltiesCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _ERC721Royalties.Contract.TokenURI(&_ERC721Royalties.CallOpts, tokenId)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC721Royalties *ERC721RoyaltiesCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC721Royalties.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC721Royalties *ERC721RoyaltiesSession) TotalSupply() (*big.Int, error) {
+	return _ERC721Royalties.Contract.TotalSupply(&_ERC721Royalties.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC721Royalties *ERC721RoyaltiesCallerSession) TotalSupply() (*big.Int, error) {
+	return _ERC721Royalties.Contract.TotalSupply(&_ERC721Royalties.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ERC721Royalties *ERC721RoyaltiesSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.Approve(&_ERC721Royalties.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.Approve(&_ERC721Royalties.TransactOpts, to, tokenId)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 tokenId) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactor) Mint(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.contract.Transact(opts, "mint", to, tokenId)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 tokenId) returns()
+func (_ERC721Royalties *ERC721RoyaltiesSession) Mint(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.Mint(&_ERC721Royalties.TransactOpts, to, tokenId)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 tokenId) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactorSession) Mint(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.Mint(&_ERC721Royalties.TransactOpts, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721Royalties *ERC721RoyaltiesSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.SafeTransferFrom(&_ERC721Royalties.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.SafeTransferFrom(&_ERC721Royalties.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC721Royalties.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_ERC721Royalties *ERC721RoyaltiesSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.SafeTransferFrom0(&_ERC721Royalties.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.SafeTransferFrom0(&_ERC721Royalties.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721Royalties.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC721Royalties *ERC721RoyaltiesSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.SetApprovalForAll(&_ERC721Royalties.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.SetApprovalForAll(&_ERC721Royalties.TransactOpts, operator, approved)
+}
+
+// SetDefaultRoyalty is a paid mutator transaction binding the contract method 0x04634d8d.
+//
+// Solidity: function setDefaultRoyalty(address receiver, uint96 feeNumerator) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactor) SetDefaultRoyalty(opts *bind.TransactOpts, receiver common.Address, feeNumerator *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.contract.Transact(opts, "setDefaultRoyalty", receiver, feeNumerator)
+}
+
+// SetDefaultRoyalty is a paid mutator transaction binding the contract method 0x04634d8d.
+//
+// Solidity: function setDefaultRoyalty(address receiver, uint96 feeNumerator) returns()
+func (_ERC721Royalties *ERC721RoyaltiesSession) SetDefaultRoyalty(receiver common.Address, feeNumerator *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.SetDefaultRoyalty(&_ERC721Royalties.TransactOpts, receiver, feeNumerator)
+}
+
+// SetDefaultRoyalty is a paid mutator transaction binding the contract method 0x04634d8d.
+//
+// Solidity: function setDefaultRoyalty(address receiver, uint96 feeNumerator) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactorSession) SetDefaultRoyalty(receiver common.Address, feeNumerator *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.SetDefaultRoyalty(&_ERC721Royalties.TransactOpts, receiver, feeNumerator)
+}
+
+// SetTokenRoyalty is a paid mutator transaction binding the contract method 0x5944c753.
+//
+// Solidity: function setTokenRoyalty(uint256 tokenId, address receiver, uint96 feeNumerator) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactor) SetTokenRoyalty(opts *bind.TransactOpts, tokenId *big.Int, receiver common.Address, feeNumerator *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.contract.Transact(opts, "setTokenRoyalty", tokenId, receiver, feeNumerator)
+}
+
+// SetTokenRoyalty is a paid mutator transaction binding the contract method 0x5944c753.
+//
+// Solidity: function setTokenRoyalty(uint256 tokenId, address receiver, uint96 feeNumerator) returns()
+func (_ERC721Royalties *ERC721RoyaltiesSession) SetTokenRoyalty(tokenId *big.Int, receiver common.Address, feeNumerator *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.SetTokenRoyalty(&_ERC721Royalties.TransactOpts, tokenId, receiver, feeNumerator)
+}
+
+// SetTokenRoyalty is a paid mutator transaction binding the contract method 0x5944c753.
+//
+// Solidity: function setTokenRoyalty(uint256 tokenId, address receiver, uint96 feeNumerator) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactorSession) SetTokenRoyalty(tokenId *big.Int, receiver common.Address, feeNumerator *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.SetTokenRoyalty(&_ERC721Royalties.TransactOpts, tokenId, receiver, feeNumerator)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721Royalties *ERC721RoyaltiesSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.TransferFrom(&_ERC721Royalties.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721Royalties *ERC721RoyaltiesTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Royalties.Contract.TransferFrom(&_ERC721Royalties.TransactOpts, from, to, tokenId)
+}
+
+// ERC721RoyaltiesApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the ERC721Royalties contract.
+type ERC721RoyaltiesApprovalIterator struct {
+	Event *ERC721RoyaltiesApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721RoyaltiesApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721RoyaltiesApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721RoyaltiesApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721RoyaltiesApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721RoyaltiesApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721RoyaltiesApproval represents a Approval event raised by the ERC721Royalties contract.
+type ERC721RoyaltiesApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721Royalties *ERC721RoyaltiesFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*ERC721RoyaltiesApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721Royalties.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721RoyaltiesApprovalIterator{contract: _ERC721Royalties.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721Royalties *ERC721RoyaltiesFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *ERC721RoyaltiesApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721Royalties.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721RoyaltiesApproval)
+				if err := _ERC721Royalties.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721Royalties *ERC721RoyaltiesFilterer) ParseApproval(log types.Log) (*ERC721RoyaltiesApproval, error) {
+	event := new(ERC721RoyaltiesApproval)
+	if err := _ERC721Royalties.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721RoyaltiesApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the ERC721Royalties contract.
+type ERC721RoyaltiesApprovalForAllIterator struct {
+	Event *ERC721RoyaltiesApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721RoyaltiesApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721RoyaltiesApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721RoyaltiesApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721RoyaltiesApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721RoyaltiesApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721RoyaltiesApprovalForAll represents a ApprovalForAll event raised by the ERC721Royalties contract.
+type ERC721RoyaltiesApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721Royalties *ERC721RoyaltiesFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*ERC721RoyaltiesApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC721Royalties.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721RoyaltiesApprovalForAllIterator{contract: _ERC721Royalties.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721Royalties *ERC721RoyaltiesFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *ERC721RoyaltiesApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC721Royalties.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721RoyaltiesApprovalForAll)
+				if err := _ERC721Royalties.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721Royalties *ERC721RoyaltiesFilterer) ParseApprovalForAll(log types.Log) (*ERC721RoyaltiesApprovalForAll, error) {
+	event := new(ERC721RoyaltiesApprovalForAll)
+	if err := _ERC721Royalties.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721RoyaltiesTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the ERC721Royalties contract.
+type ERC721RoyaltiesTransferIterator struct {
+	Event *ERC721RoyaltiesTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721RoyaltiesTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721RoyaltiesTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721RoyaltiesTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721RoyaltiesTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721RoyaltiesTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721RoyaltiesTransfer represents a Transfer event raised by the ERC721Royalties contract.
+type ERC721RoyaltiesTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721Royalties *ERC721RoyaltiesFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*ERC721RoyaltiesTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721Royalties.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721RoyaltiesTransferIterator{contract: _ERC721Royalties.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721Royalties *ERC721RoyaltiesFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ERC721RoyaltiesTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721Royalties.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721RoyaltiesTransfer)
+				if err := _ERC721Royalties.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721Royalties *ERC721RoyaltiesFilterer) ParseTransfer(log types.Log) (*ERC721RoyaltiesTransfer, error) {
+	event := new(ERC721RoyaltiesTransfer)
+	if err := _ERC721Royalties.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,760 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package QuirkyERC20
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// QuirkyERC20MetaData contains all meta data concerning the QuirkyERC20 contract.
+var QuirkyERC20MetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"flags\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"currentAllowance\",\"type\":\"uint256\"}],\"name\":\"ERC20ApproveFromNonZero\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"allowance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientAllowance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientBalance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidApprover\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSpender\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60c060405234801561001057600080fd5b50604051610ac0380380610ac083398101604081905261002f91610094565b60808190524360a05269d3c21bcecceda1000000600081815533808252600160209081526040808420859055518481529192917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef910160405180910390a350506100ad565b6000602082840312156100a657600080fd5b5051919050565b60805160a0516109c46100fc60003960006107830152600081816101b5015281816102f7015281816103e80152818161049a015281816104e301528181610617015261074b01526109c46000f3fe608060405234801561001057600080fd5b50600436106100935760003560e01c8063313ce56711610066578063313ce5671461010257806370a082311461011157806395d89b4114610124578063a9059cbb1461012c578063dd62ed3e1461013f57600080fd5b806306fdde0314610098578063095ea7b3146100b657806318160ddd146100d957806323b872dd146100ef575b600080fd5b6100a0610178565b6040516100ad91906107cb565b60405180910390f35b6100c96100c4366004610835565b61018f565b60405190151581526020016100ad565b6100e161028e565b6040519081526020016100ad565b6100c96100fd36600461085f565b6102a0565b604051601281526020016100ad565b6100e161011f36600461089c565b6103a9565b6100a06103d1565b6100c961013a366004610835565b6103e4565b6100e161014d3660046108b7565b6001600160a01b03918216600090815260026020908152604080832093909416825291909152205490565b606061018c65517569726b7960d01b61044e565b90565b3360009081526002602090815260408083206001600160a01b03861684529091528120547f0000000000000000000000000000000000000000000000000000000000000000600116151580156101e457508215155b80156101ef57508015155b156102245760405163b7fe8b4360e01b81526001600160a01b0385166004820152602481018290526044015b60405180910390fd5b3360008181526002602090815260408083206001600160a01b03891680855290835292819020879055518681529192917f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a36102876104df565b5092915050565b600061029b600054610518565b905090565b6001600160a01b0383166000908152600260209081526040808320338452909152812054828110156102f557604051637dc7a0d960e11b8152336004820152602481018290526044810184905260640161021b565b7f000000000000000000000000000000000000000000000000000000000000000060101615158015610346575061032b8361053f565b6001600160a01b038616600090815260016020526040902054105b156103555760009150506103a2565b600019811461038d576103688382610900565b6001600160a01b03861660009081526002602090815260408083203384529091529020555b61039885858561055b565b6103a06104df565b505b9392505050565b6001600160a01b0381166000908152600160205260408120546103cb90610518565b92915050565b606061018c64515549524b60d81b61044e565b60007f00000000000000000000000000000000000000000000000000000000000000006010161515801561042e575061041c8261053f565b33600090815260016020526040902054105b1561043b575060006103cb565b61044633848461055b565b6103cb6104df565b60005b60208110801561047f575081816020811061046e5761046e610913565b1a60f81b6001600160f81b03191615155b15610496578061048e81610929565b915050610451565b60027f000000000000000000000000000000000000000000000000000000000000000016158015906104cc578260005260206000f35b6020600052816020528260405260606000f35b60017f0000000000000000000000000000000000000000000000000000000000000000161580159061050d57005b600160005260206000f35b6000670de0b6b3a764000061052b610747565b6105359084610942565b6103cb9190610959565b6000610549610747565b61053583670de0b6b3a7640000610942565b6001600160a01b0382166105855760405163ec442f0560e01b81526000600482015260240161021b565b60006105908261053f565b6001600160a01b038516600090815260016020526040902054909150818110156105ef57846105be82610518565b60405163391434e360e21b81526001600160a01b03909216600483015260248201526044810184905260640161021b565b6105f98282610900565b6001600160a01b0386166000908152600160205260408120919091557f000000000000000000000000000000000000000000000000000000000000000060041661064457600061064f565b61064f606485610959565b9050600061065c8261053f565b90508060008082825461066f9190610900565b9091555061067f90508185610900565b6001600160a01b038716600090815260016020526040812080549091906106a790849061097b565b90915550506001600160a01b038087169088167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef6106e58589610900565b60405190815260200160405180910390a3811561073e576040518281526000906001600160a01b038916907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a35b50505050505050565b60007f000000000000000000000000000000000000000000000000000000000000000060081661077e5750670de0b6b3a764000090565b6107a87f000000000000000000000000000000000000000000000000000000000000000043610900565b6107b990662386f26fc10000610942565b61029b90670de0b6b3a764000061097b565b602081526000825180602084015260005b818110156107f957602081860181015160408684010152016107dc565b506000604082850101526040601f19601f83011684010191505092915050565b80356001600160a01b038116811461083057600080fd5b919050565b6000806040838503121561084857600080fd5b61085183610819565b946020939093013593505050565b60008060006060848603121561087457600080fd5b61087d84610819565b925061088b60208501610819565b929592945050506040919091013590565b6000602082840312156108ae57600080fd5b6103a282610819565b600080604083850312156108ca57600080fd5b6108d383610819565b91506108e160208401610819565b90509250929050565b634e487b7160e01b600052601160045260246000fd5b818103818111156103cb576103cb6108ea565b634e487b7160e01b600052603260045260246000fd5b60006001820161093b5761093b6108ea565b5060010190565b80820281158282048414176103cb576103cb6108ea565b60008261097657634e487b7160e01b600052601260045260246000fd5b500490565b808201808211156103cb576103cb6108ea56fea264697066735822122008d1236293db3f91339de47d64d0a865163ee2cdf31cfd01a8e2a4f9480dcbbd64736f6c634300081e0033",
+}
+
+// QuirkyERC20ABI is the input ABI used to generate the binding from.
+// Deprecated: Use QuirkyERC20MetaData.ABI instead.
+var QuirkyERC20ABI = QuirkyERC20MetaData.ABI
+
+// QuirkyERC20Bin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use QuirkyERC20MetaData.Bin instead.
+var QuirkyERC20Bin = QuirkyERC20MetaData.Bin
+
+// DeployQuirkyERC20 deploys a new Ethereum contract, binding an instance of QuirkyERC20 to it.
+func DeployQuirkyERC20(auth *bind.TransactOpts, backend bind.ContractBackend, flags *big.Int) (common.Address, *types.Transaction, *QuirkyERC20, error) {
+	parsed, err := QuirkyERC20MetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(QuirkyERC20Bin), backend, flags)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &QuirkyERC20{QuirkyERC20Caller: QuirkyERC20Caller{contract: contract}, QuirkyERC20Transactor: QuirkyERC20Transactor{contract: contract}, QuirkyERC20Filterer: QuirkyERC20Filterer{contract: contract}}, nil
+}
+
+// QuirkyERC20 is an auto generated Go binding around an Ethereum contract.
+type QuirkyERC20 struct {
+	QuirkyERC20Caller     // Read-only binding to the contract
+	QuirkyERC20Transactor // Write-only binding to the contract
+	QuirkyERC20Filterer   // Log filterer for contract events
+}
+
+// QuirkyERC20Caller is an auto generated read-only Go binding around an Ethereum contract.
+type QuirkyERC20Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// QuirkyERC20Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type QuirkyERC20Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// QuirkyERC20Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type QuirkyERC20Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// QuirkyERC20Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type QuirkyERC20Session struct {
+	Contract     *QuirkyERC20      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// QuirkyERC20CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type QuirkyERC20CallerSession struct {
+	Contract *QuirkyERC20Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// QuirkyERC20TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type QuirkyERC20TransactorSession struct {
+	Contract     *QuirkyERC20Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// QuirkyERC20Raw is an auto generated low-level Go binding around an Ethereum contract.
+type QuirkyERC20Raw struct {
+	Contract *QuirkyERC20 // Generic contract binding to access the raw methods on
+}
+
+// QuirkyERC20CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type QuirkyERC20CallerRaw struct {
+	Contract *QuirkyERC20Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// QuirkyERC20TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type QuirkyERC20TransactorRaw struct {
+	Contract *QuirkyERC20Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewQuirkyERC20 creates a new instance of QuirkyERC20, bound to a specific deployed contract.
+func NewQuirkyERC20(address common.Address, backend bind.ContractBackend) (*QuirkyERC20, error) {
+	contract, err := bindQuirkyERC20(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &QuirkyERC20{QuirkyERC20Caller: QuirkyERC20Caller{contract: contract}, QuirkyERC20Transactor: QuirkyERC20Transactor{contract: contract}, QuirkyERC20Filterer: QuirkyERC20Filterer{contract: contract}}, nil
+}
+
+// NewQuirkyERC20Caller creates a new read-only instance of QuirkyERC20, bound to a specific deployed contract.
+func NewQuirkyERC20Caller(address common.Address, caller bind.ContractCaller) (*QuirkyERC20Caller, error) {
+	contract, err := bindQuirkyERC20(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &QuirkyERC20Caller{contract: contract}, nil
+}
+
+// NewQuirkyERC20Transactor creates a new write-only instance of QuirkyERC20, bound to a specific deployed contract.
+func NewQuirkyERC20Transactor(address common.Address, transactor bind.ContractTransactor) (*QuirkyERC20Transactor, error) {
+	contract, err := bindQuirkyERC20(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &QuirkyERC20Transactor{contract: contract}, nil
+}
+
+// NewQuirkyERC20Filterer creates a new log filterer instance of QuirkyERC20, bound to a specific deployed contract.
+func NewQuirkyERC20Filterer(address common.Address, filterer bind.ContractFilterer) (*QuirkyERC20Filterer, error) {
+	contract, err := bindQuirkyERC20(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &QuirkyERC20Filterer{contract: contract}, nil
+}
+
+// bindQuirkyERC20 binds a generic wrapper to an already deployed contract.
+func bindQuirkyERC20(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := QuirkyERC20MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_QuirkyERC20 *QuirkyERC20Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _QuirkyERC20.Contract.QuirkyERC20Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_QuirkyERC20 *QuirkyERC20Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _QuirkyERC20.Contract.QuirkyERC20Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_QuirkyERC20 *QuirkyERC20Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _QuirkyERC20.Contract.QuirkyERC20Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_QuirkyERC20 *QuirkyERC20CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _QuirkyERC20.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_QuirkyERC20 *QuirkyERC20TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _QuirkyERC20.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_QuirkyERC20 *QuirkyERC20TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _QuirkyERC20.Contract.contract.Transact(opts, method, params...)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_QuirkyERC20 *QuirkyERC20Caller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _QuirkyERC20.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_QuirkyERC20 *QuirkyERC20Session) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _QuirkyERC20.Contract.Allowance(&_QuirkyERC20.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_QuirkyERC20 *QuirkyERC20CallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _QuirkyERC20.Contract.Allowance(&_QuirkyERC20.CallOpts, owner, spender)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_QuirkyERC20 *QuirkyERC20Caller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _QuirkyERC20.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_QuirkyERC20 *QuirkyERC20Session) BalanceOf(account common.Address) (*big.Int, error) {
+	return _QuirkyERC20.Contract.BalanceOf(&_QuirkyERC20.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_QuirkyERC20 *QuirkyERC20CallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _QuirkyERC20.Contract.BalanceOf(&_QuirkyERC20.CallOpts, account)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() pure returns(uint8)
+func (_QuirkyERC20 *QuirkyERC20Caller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _QuirkyERC20.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() pure returns(uint8)
+func (_QuirkyERC20 *QuirkyERC20Session) Decimals() (uint8, error) {
+	return _QuirkyERC20.Contract.Decimals(&_QuirkyERC20.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() pure returns(uint8)
+func (_QuirkyERC20 *QuirkyERC20CallerSession) Decimals() (uint8, error) {
+	return _QuirkyERC20.Contract.Decimals(&_QuirkyERC20.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_QuirkyERC20 *QuirkyERC20Caller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _QuirkyERC20.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_QuirkyERC20 *QuirkyERC20Session) Name() (string, error) {
+	return _QuirkyERC20.Contract.Name(&_QuirkyERC20.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_QuirkyERC20 *QuirkyERC20CallerSession) Name() (string, error) {
+	return _QuirkyERC20.Contract.Name(&_QuirkyERC20.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_QuirkyERC20 *QuirkyERC20Caller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _QuirkyERC20.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_QuirkyERC20 *QuirkyERC20Session) Symbol() (string, error) {
+	return _QuirkyERC20.Contract.Symbol(&_QuirkyERC20.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_QuirkyERC20 *QuirkyERC20CallerSession) Symbol() (string, error) {
+	return _QuirkyERC20.Contract.Symbol(&_QuirkyERC20.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_QuirkyERC20 *QuirkyERC20Caller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _QuirkyERC20.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_QuirkyERC20 *QuirkyERC20Session) TotalSupply() (*big.Int, error) {
+	return _QuirkyERC20.Contract.TotalSupply(&_QuirkyERC20.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_QuirkyERC20 *QuirkyERC20CallerSession) TotalSupply() (*big.Int, error) {
+	return _QuirkyERC20.Contract.TotalSupply(&_QuirkyERC20.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_QuirkyERC20 *QuirkyERC20Transactor) Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _QuirkyERC20.contract.Transact(opts, "approve", spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_QuirkyERC20 *QuirkyERC20Session) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _QuirkyERC20.Contract.Approve(&_QuirkyERC20.TransactOpts, spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_QuirkyERC20 *QuirkyERC20TransactorSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _QuirkyERC20.Contract.Approve(&_QuirkyERC20.TransactOpts, spender, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_QuirkyERC20 *QuirkyERC20Transactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _QuirkyERC20.contract.Transact(opts, "transfer", to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_QuirkyERC20 *QuirkyERC20Session) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _QuirkyERC20.Contract.Transfer(&_QuirkyERC20.TransactOpts, to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_QuirkyERC20 *QuirkyERC20TransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _QuirkyERC20.Contract.Transfer(&_QuirkyERC20.TransactOpts, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_QuirkyERC20 *QuirkyERC20Transactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _QuirkyERC20.contract.Transact(opts, "transferFrom", from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_QuirkyERC20 *QuirkyERC20Session) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _QuirkyERC20.Contract.TransferFrom(&_QuirkyERC20.TransactOpts, from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_QuirkyERC20 *QuirkyERC20TransactorSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _QuirkyERC20.Contract.TransferFrom(&_QuirkyERC20.TransactOpts, from, to, value)
+}
+
+// QuirkyERC20ApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the QuirkyERC20 contract.
+type QuirkyERC20ApprovalIterator struct {
+	Event *QuirkyERC20Approval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *QuirkyERC20ApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(QuirkyERC20Approval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(QuirkyERC20Approval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *QuirkyERC20ApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *QuirkyERC20ApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// QuirkyERC20Approval represents a Approval event raised by the QuirkyERC20 contract.
+type QuirkyERC20Approval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_QuirkyERC20 *QuirkyERC20Filterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*QuirkyERC20ApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _QuirkyERC20.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &QuirkyERC20ApprovalIterator{contract: _QuirkyERC20.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_QuirkyERC20 *QuirkyERC20Filterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *QuirkyERC20Approval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _QuirkyERC20.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(QuirkyERC20Approval)
+				if err := _QuirkyERC20.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_QuirkyERC20 *QuirkyERC20Filterer) ParseApproval(log types.Log) (*QuirkyERC20Approval, error) {
+	event := new(QuirkyERC20Approval)
+	if err := _QuirkyERC20.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// QuirkyERC20TransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the QuirkyERC20 contract.
+type QuirkyERC20TransferIterator struct {
+	Event *QuirkyERC20Transfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *QuirkyERC20TransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(QuirkyERC20Transfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(QuirkyERC20Transfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *QuirkyERC20TransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *QuirkyERC20TransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// QuirkyERC20Transfer represents a Transfer event raised by the QuirkyERC20 contract.
+type QuirkyERC20Transfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_QuirkyERC20 *QuirkyERC20Filterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*QuirkyERC20TransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _QuirkyERC20.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &QuirkyERC20TransferIterator{contract: _QuirkyERC20.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_QuirkyERC20 *QuirkyERC20Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *QuirkyERC20Transfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _QuirkyERC20.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(QuirkyERC20Transfer)
+				if err := _QuirkyERC20.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_QuirkyERC20 *QuirkyERC20Filterer) ParseTransfer(log types.Log) (*QuirkyERC20Transfer, error) {
+	event := new(QuirkyERC20Transfer)
+	if err := _QuirkyERC20.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -32,12 +32,34 @@ var (
 // DisperseMetaData contains all meta data concerning the Disperse contract.
 var DisperseMetaData = &bind.MetaData{
 	ABI: "[{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"recipients\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"disperseEther\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIERC20\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"address[]\",\"name\":\"recipients\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"disperseToken\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIERC20\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"address[]\",\"name\":\"recipients\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"disperseTokenSimple\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x6080604052348015600e575f5ffd5b50610d118061001c5f395ff3fe608060405260043610610033575f3560e01c806351ba162c14610037578063c73a2d601461005f578063e63d38ed14610087575b5f5ffd5b348015610042575f5ffd5b5061005d600480360381019061005891906107ae565b6100a3565b005b34801561006a575f5ffd5b50610085600480360381019061008091906107ae565b61020c565b005b6100a1600480360381019061009c919061083f565b610479565b005b8181905084849050146100eb576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016100e290610917565b60405180910390fd5b5f5f90505b84849050811015610204578573ffffffffffffffffffffffffffffffffffffffff166323b872dd3387878581811061012b5761012a610935565b5b9050602002016020810190610140919061098c565b86868681811061015357610152610935565b5b905060200201356040518463ffffffff1660e01b8152600401610178939291906109de565b6020604051808303815f875af1158015610194573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906101b89190610a48565b6101f7576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016101ee90610abd565b60405180910390fd5b80806001019150506100f0565b505050505050565b818190508484905014610254576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161024b90610917565b60405180910390fd5b5f5f90505f5f90505b8585905081101561029c5783838281811061027b5761027a610935565b5b905060200201358261028d9190610b08565b9150808060010191505061025d565b508573ffffffffffffffffffffffffffffffffffffffff166323b872dd3330846040518463ffffffff1660e01b81526004016102da939291906109de565b6020604051808303815f875af11580156102f6573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061031a9190610a48565b610359576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161035090610abd565b60405180910390fd5b5f5f90505b85859050811015610470578673ffffffffffffffffffffffffffffffffffffffff1663a9059cbb87878481811061039857610397610935565b5b90506020020160208101906103ad919061098c565b8686858181106103c0576103bf610935565b5b905060200201356040518363ffffffff1660e01b81526004016103e4929190610b3b565b6020604051808303815f875af1158015610400573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104249190610a48565b610463576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161045a90610bac565b60405180910390fd5b808060010191505061035e565b50505050505050565b8181905084849050146104c1576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016104b890610917565b60405180910390fd5b5f5f90505b848490508110156105c7575f8585838181106104e5576104e4610935565b5b90506020020160208101906104fa919061098c565b73ffffffffffffffffffffffffffffffffffffffff1684848481811061052357610522610935565b5b9050602002013560405161053690610bf7565b5f6040518083038185875af1925050503d805f8114610570576040519150601f19603f3d011682016040523d82523d5f602084013e610575565b606091505b50509050806105b9576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016105b090610c55565b60405180910390fd5b5080806001019150506104c6565b505f4790505f81111561067e575f3373ffffffffffffffffffffffffffffffffffffffff16826040516105f990610bf7565b5f6040518083038185875af1925050503d805f8114610633576040519150601f19603f3d011682016040523d82523d5f602084013e610638565b606091505b505090508061067c576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161067390610cbd565b60405180910390fd5b505b5050505050565b5f5ffd5b5f5ffd5b5f73ffffffffffffffffffffffffffffffffffffffff82169050919050565b5f6106b68261068d565b9050919050565b5f6106c7826106ac565b9050919050565b6106d7816106bd565b81146106e1575f5ffd5b50565b5f813590506106f2816106ce565b92915050565b5f5ffd5b5f5ffd5b5f5ffd5b5f5f83601f840112610719576107186106f8565b5b8235905067ffffffffffffffff811115610736576107356106fc565b5b60208301915083602082028301111561075257610751610700565b5b9250929050565b5f5f83601f84011261076e5761076d6106f8565b5b8235905067ffffffffffffffff81111561078b5761078a6106fc565b5b6020830191508360208202830111156107a7576107a6610700565b5b9250929050565b5f5f5f5f5f606086880312156107c7576107c6610685565b5b5f6107d4888289016106e4565b955050602086013567ffffffffffffffff8111156107f5576107f4610689565b5b61080188828901610704565b9450945050604086013567ffffffffffffffff81111561082457610823610689565b5b61083088828901610759565b92509250509295509295909350565b5f5f5f5f6040858703121561085757610856610685565b5b5f85013567ffffffffffffffff81111561087457610873610689565b5b61088087828801610704565b9450945050602085013567ffffffffffffffff8111156108a3576108a2610689565b5b6108af87828801610759565b925092505092959194509250565b5f82825260208201905092915050565b7f4172726179206c656e677468206d69736d6174636800000000000000000000005f82015250565b5f6109016015836108bd565b915061090c826108cd565b602082019050919050565b5f6020820190508181035f83015261092e816108f5565b9050919050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52603260045260245ffd5b61096b816106ac565b8114610975575f5ffd5b50565b5f8135905061098681610962565b92915050565b5f602082840312156109a1576109a0610685565b5b5f6109ae84828501610978565b91505092915050565b6109c0816106ac565b82525050565b5f819050919050565b6109d8816109c6565b82525050565b5f6060820190506109f15f8301866109b7565b6109fe60208301856109b7565b610a0b60408301846109cf565b949350505050565b5f8115159050919050565b610a2781610a13565b8114610a31575f5ffd5b50565b5f81519050610a4281610a1e565b92915050565b5f60208284031215610a5d57610a5c610685565b5b5f610a6a84828501610a34565b91505092915050565b7f5472616e7366657246726f6d206661696c6564000000000000000000000000005f82015250565b5f610aa76013836108bd565b9150610ab282610a73565b602082019050919050565b5f6020820190508181035f830152610ad481610a9b565b9050919050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52601160045260245ffd5b5f610b12826109c6565b9150610b1d836109c6565b9250828201905080821115610b3557610b34610adb565b5b92915050565b5f604082019050610b4e5f8301856109b7565b610b5b60208301846109cf565b9392505050565b7f5472616e73666572206661696c656400000000000000000000000000000000005f82015250565b5f610b96600f836108bd565b9150610ba182610b62565b602082019050919050565b5f6020820190508181035f830152610bc381610b8a565b9050919050565b5f81905092915050565b50565b5f610be25f83610bca565b9150610bed82610bd4565b5f82019050919050565b5f610c0182610bd7565b9150819050919050565b7f455448207472616e73666572206661696c6564000000000000000000000000005f82015250565b5f610c3f6013836108bd565b9150610c4a82610c0b565b602082019050919050565b5f6020820190508181035f830152610c6c81610c33565b9050919050565b7f4661696c656420746f2072657475726e2072656d61696e696e672045544800005f82015250565b5f610ca7601e836108bd565b9150610cb282610c73565b602082019050919050565b5f6020820190508181035f830152610cd481610c9b565b905091905056fea264697066735822122074c3d91b00f03eabc612fdf40327d775b6d072b33b2e46d9e2aff848c5300ff464736f6c634300081c0033",
 }
 
 // DisperseABI is the input ABI used to generate the binding from.
 // Deprecated: Use DisperseMetaData.ABI instead.
 var DisperseABI = DisperseMetaData.ABI
 
+// DisperseBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use DisperseMetaData.Bin instead.
+var DisperseBin = DisperseMetaData.Bin
+
+// DeployDisperse deploys a new Ethereum contract, binding an instance of Disperse to it.
+func DeployDisperse(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Disperse, error) {
+	parsed, err := DisperseMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(DisperseBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Disperse{DisperseCaller: DisperseCaller{contract: contract}, DisperseTransactor: DisperseTransactor{contract: contract}, DisperseFilterer: DisperseFilterer{contract: contract}}, nil
+}
+
 // Disperse is an auto generated Go binding around an Ethereum contract.
 type Disperse struct {
 	DisperseCaller     // Read-only binding to the contract
@@ -3,12 +3,14 @@ package merged
 // Package merged provides a unified interface that combines multiple NFT interaction extensions such as enumerable and royalties.
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/OCharless/eth-interfaces/models"
 	"github.com/OCharless/eth-interfaces/nft"
 	"github.com/OCharless/eth-interfaces/nft/enumerable"
 	"github.com/OCharless/eth-interfaces/nft/royalties"
+	"github.com/OCharless/eth-interfaces/probe"
 	"github.com/OCharless/eth-interfaces/utils"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -46,7 +48,10 @@ func NewERC721SummedInteractions(baseIERC721 *nft.ERC721Interactions, signatures
 		case Enumerable:
 			enum, err = enumerable.NewERC721EnumerableInteractions(
 				baseIERC721,
-				[]enumerable.IERC721EnumerableSignature{},
+				[]enumerable.IERC721EnumerableSignature{
+					enumerable.TokenByIndex,
+					enumerable.TokenOfOwnerByIndex,
+				},
 			)
 			if err != nil {
 				return nil, err
@@ -54,7 +59,7 @@ func NewERC721SummedInteractions(baseIERC721 *nft.ERC721Interactions, signatures
 		case Royalties:
 			roy, err = royalties.NewERC721RoyaltiesInteractions(
 				baseIERC721,
-				[]royalties.IERC721RoyaltiesSignature{},
+				[]royalties.IERC721RoyaltiesSignature{royalties.RoyaltyInfo},
 			)
 			if err != nil {
 				return nil, err
@@ -65,7 +70,31 @@ func NewERC721SummedInteractions(baseIERC721 *nft.ERC721Interactions, signatures
 	return &IERC721SummedInteractions{baseIERC721, roy, enum}, nil
 }
 
-// AllInfos retrieves combined information for a given token, including base metadata, total supply, and royalty information.
+// ExtensionsFromCapabilities returns the extensions supported according to a probe report.
+func ExtensionsFromCapabilities(capabilities *probe.Capabilities) []ExtensionEnum {
+	extensions := []ExtensionEnum{}
+	if capabilities.Enumerable {
+		extensions = append(extensions, Enumerable)
+	}
+	if capabilities.Royalties {
+		extensions = append(extensions, Royalties)
+	}
+	return extensions
+}
+
+// NewProbedERC721SummedInteractions probes the NFT contract and creates an IERC721SummedInteractions with every extension it supports enabled.
+func NewProbedERC721SummedInteractions(baseIERC721 *nft.ERC721Interactions) (*IERC721SummedInteractions, error) {
+	capabilities, err := probe.Probe(baseIERC721.BaseInteractions, baseIERC721.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	if !capabilities.ERC721 {
+		return nil, fmt.Errorf("contract %s does not implement ERC721", baseIERC721.GetAddress().Hex())
+	}
+	return NewERC721SummedInteractions(baseIERC721, []utils.Signature{}, ExtensionsFromCapabilities(capabilities)...)
+}
+
+// AllInfos retrieves combined information for a given token, including base metadata, total supply, and royalty rate when the royalties extension is enabled.
 func (s *IERC721SummedInteractions) AllInfos(tokenIDs ...*big.Int) (*models.TokenMeta, *big.Int, *royalties.RoyaltyInfos, error) {
 	var tokenID *big.Int
 	if len(tokenIDs) == 0 {
@@ -84,7 +113,11 @@ func (s *IERC721SummedInteractions) AllInfos(tokenIDs ...*big.Int) (*models.Toke
 		return baseInfos, nil, nil, err
 	}
 
-	royalties, err := s.RoyaltiesInfos(tokenID, big.NewInt(1))
+	if s.IERC721RoyaltiesInteractions == nil {
+		return baseInfos, supply, nil, nil
+	}
+
+	royalties, err := s.RoyaltyRate(tokenID)
 	if err != nil {
 		return baseInfos, supply, nil, err
 	}
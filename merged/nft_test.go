@@ -106,3 +106,56 @@ func Test_Instantiation(t *testing.T) {
 	}
 
 }
+
+// Test_ProbedInstantiation verifies that the extensions are enabled from the capabilities detected on the contract.
+func Test_ProbedInstantiation(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		abiString        string
+		byteCodeString   string
+		ExpectEnumerable bool
+		ExpectRoyalties  bool
+	}{
+		{
+			Name:             "OK - Enumerable only",
+			abiString:        ERC721A.ERC721AABI,
+			byteCodeString:   ERC721A.ERC721ABin,
+			ExpectEnumerable: true,
+		},
+		{
+			Name:             "OK - Enumerable and royalties",
+			abiString:        ERC721Complete.ERC721CompleteABI,
+			byteCodeString:   ERC721Complete.ERC721CompleteBin,
+			ExpectEnumerable: true,
+			ExpectRoyalties:  true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			backend, _, contractAddr, privKey, err := utils.SetupBlockchain(t,
+				tt.abiString,
+				tt.byteCodeString,
+				"MyNFT",
+				"MNFT",
+			)
+			assert.Nil(t, err)
+			defer backend.Close()
+
+			baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+			nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{})
+			assert.Nil(t, err)
+
+			summed, err := merged.NewProbedERC721SummedInteractions(nftA)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.ExpectEnumerable, summed.ERC721EnumerableInteractions != nil)
+			assert.Equal(t, tt.ExpectRoyalties, summed.IERC721RoyaltiesInteractions != nil)
+
+			meta, supply, royaltyInfos, err := summed.AllInfos()
+			assert.Nil(t, err)
+			assert.Equal(t, "MyNFT", meta.Name)
+			assert.Equal(t, int64(30), supply.Int64())
+			assert.Equal(t, tt.ExpectRoyalties, royaltyInfos != nil)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/cache"
 	"github.com/OCharless/eth-interfaces/contractextension"
 	"github.com/OCharless/eth-interfaces/customerrors"
 	"github.com/OCharless/eth-interfaces/inferences/ERC721Complete"
@@ -26,6 +27,8 @@ type ERC721Interactions struct {
 	erc721Session *ERC721Complete.ERC721CompleteSession
 	nftAddress    common.Address
 	callError     func(string, error) *base.CallError
+	metaCache     *cache.ContractCache
+	tokenIndex    TokenIndex
 }
 
 // NewERC721Interactions creates a new instance of ERC721Interactions from a base interaction interface and an NFT contract address.
@@ -74,6 +77,8 @@ func NewERC721Interactions(
 		&erc721ASession,
 		address,
 		callError,
+		nil,
+		nil,
 	}
 
 	if err := contractextension.SimulateCall(baseInteractions.Ctx, ERC721Complete.ERC721CompleteABI, "name", erc721Interactions); err != nil {
@@ -83,6 +88,30 @@ func NewERC721Interactions(
 	return erc721Interactions, nil
 }
 
+// SetCache sets the cache used for the name, symbol and token URIs of the NFT, nil disables caching.
+func (d *ERC721Interactions) SetCache(c *cache.Cache) error {
+	if c == nil {
+		d.metaCache = nil
+		return nil
+	}
+	chainID, err := base.Observe(d.BaseInteractions, base.ReadOperation, "nft.ChainID()", func() (*big.Int, error) {
+		return d.Client.ChainID(d.Ctx)
+	})
+	if err != nil {
+		return customerrors.WrapinterfacingError("ChainID", err)
+	}
+	d.metaCache = c.Contract(chainID.Uint64(), d.nftAddress)
+	return nil
+}
+
+// WatchMetadataUpdates invalidates the cached token URIs whenever the contract emits an ERC-4906 metadata update event.
+func (d *ERC721Interactions) WatchMetadataUpdates() (*cache.Watcher, error) {
+	if d.metaCache == nil {
+		return nil, errors.New("no metadata cache set")
+	}
+	return d.metaCache.Watch(d.Ctx, d.Client)
+}
+
 // GetNFTAddress returns the NFT contract address.
 func (d *ERC721Interactions) GetAddress() common.Address {
 	return d.nftAddress
@@ -95,7 +124,9 @@ func (d *ERC721Interactions) GetSession() ERC721Complete.ERC721CompleteSession {
 
 // GetBalance retrieves the balance of NFTs for the associated address.
 func (d *ERC721Interactions) GetBalance() (*big.Int, error) {
-	balance, err := d.erc721Session.BalanceOf(d.Address)
+	balance, err := base.Observe(d.BaseInteractions, base.ReadOperation, "nft.BalanceOf()", func() (*big.Int, error) {
+		return d.erc721Session.BalanceOf(d.Address)
+	})
 	if err != nil {
 		return nil, d.callError("nft.BalanceOf()", err)
 	}
@@ -104,7 +135,9 @@ func (d *ERC721Interactions) GetBalance() (*big.Int, error) {
 
 // TransferTo transfers a specific token to another address after verifying ownership.
 func (d *ERC721Interactions) TransferTo(to common.Address, tokenID *big.Int) (*types.Transaction, error) {
-	tx, err := d.erc721Session.TransferFrom(d.Address, to, tokenID)
+	tx, err := base.Observe(d.BaseInteractions, base.SendOperation, "nft.TransferFrom()", func() (*types.Transaction, error) {
+		return d.erc721Session.TransferFrom(d.Address, to, tokenID)
+	})
 	if err != nil {
 		return nil, d.callError("nft.TransferFrom()", err)
 	}
@@ -135,7 +168,9 @@ func (d *ERC721Interactions) TransferFirstOwnedTo(to common.Address) (*types.Tra
 
 // TotalSupply returns the total number of NFTs minted.
 func (d *ERC721Interactions) TotalSupply() (*big.Int, error) {
-	supply, err := d.erc721Session.TotalSupply()
+	supply, err := base.Observe(d.BaseInteractions, base.ReadOperation, "nft.TotalSupply()", func() (*big.Int, error) {
+		return d.erc721Session.TotalSupply()
+	})
 	if err != nil {
 		return nil, d.callError("nft.TotalSupply()", err)
 	}
@@ -144,7 +179,9 @@ func (d *ERC721Interactions) TotalSupply() (*big.Int, error) {
 
 // BalanceOf retrieves the NFT balance for a given owner.
 func (d *ERC721Interactions) BalanceOf(owner common.Address) (*big.Int, error) {
-	balance, err := d.erc721Session.BalanceOf(owner)
+	balance, err := base.Observe(d.BaseInteractions, base.ReadOperation, "nft.BalanceOf()", func() (*big.Int, error) {
+		return d.erc721Session.BalanceOf(owner)
+	})
 	if err != nil {
 		return nil, d.callError("nft.BalanceOf()", err)
 	}
@@ -153,7 +190,9 @@ func (d *ERC721Interactions) BalanceOf(owner common.Address) (*big.Int, error) {
 
 // OwnerOf retrieves the owner of a specific token.
 func (d *ERC721Interactions) OwnerOf(tokenID *big.Int) (common.Address, error) {
-	owner, err := d.erc721Session.OwnerOf(tokenID)
+	owner, err := base.Observe(d.BaseInteractions, base.ReadOperation, "nft.OwnerOf()", func() (common.Address, error) {
+		return d.erc721Session.OwnerOf(tokenID)
+	})
 	if err != nil {
 		return common.Address{}, d.callError("nft.OwnerOf()", err)
 	}
@@ -162,7 +201,9 @@ func (d *ERC721Interactions) OwnerOf(tokenID *big.Int) (common.Address, error) {
 
 // Approve approves an address to transfer a specific token.
 func (d *ERC721Interactions) Approve(to common.Address, tokenID *big.Int) (*types.Transaction, error) {
-	tx, err := d.erc721Session.Approve(to, tokenID)
+	tx, err := base.Observe(d.BaseInteractions, base.SendOperation, "nft.Approve()", func() (*types.Transaction, error) {
+		return d.erc721Session.Approve(to, tokenID)
+	})
 	if err != nil {
 		return nil, d.callError("nft.Approve()", err)
 	}
@@ -188,36 +229,50 @@ func (d *ERC721Interactions) TokenMetaInfos(tokenID *big.Int) (*models.TokenMeta
 	return &models.TokenMeta{Name: name, Symbol: symbol, URI: uri}, nil
 }
 
-// Name returns the name of the NFT.
+// Name returns the name of the NFT, from the metadata cache when one is set.
 func (d *ERC721Interactions) Name() (string, error) {
-	name, err := d.erc721Session.Name()
-	if err != nil {
-		return "", d.callError("nft.Name()", err)
-	}
-	return name, nil
+	return d.metaCache.Fetch("name", nil, func() (string, error) {
+		name, err := base.Observe(d.BaseInteractions, base.ReadOperation, "nft.Name()", func() (string, error) {
+			return d.erc721Session.Name()
+		})
+		if err != nil {
+			return "", d.callError("nft.Name()", err)
+		}
+		return name, nil
+	})
 }
 
-// Symbol returns the symbol of the NFT.
+// Symbol returns the symbol of the NFT, from the metadata cache when one is set.
 func (d *ERC721Interactions) Symbol() (string, error) {
-	symbol, err := d.erc721Session.Symbol()
-	if err != nil {
-		return "", d.callError("nft.Symbol()", err)
-	}
-	return symbol, nil
+	return d.metaCache.Fetch("symbol", nil, func() (string, error) {
+		symbol, err := base.Observe(d.BaseInteractions, base.ReadOperation, "nft.Symbol()", func() (string, error) {
+			return d.erc721Session.Symbol()
+		})
+		if err != nil {
+			return "", d.callError("nft.Symbol()", err)
+		}
+		return symbol, nil
+	})
 }
 
-// TokenURI returns the URI of the NFT.
+// TokenURI returns the URI of the NFT, from the metadata cache when one is set.
 func (d *ERC721Interactions) TokenURI(tokenID *big.Int) (string, error) {
-	uri, err := d.erc721Session.TokenURI(tokenID)
-	if err != nil {
-		return "", d.callError("nft.TokenURI()", err)
-	}
-	return uri, nil
+	return d.metaCache.Fetch("tokenURI", tokenID, func() (string, error) {
+		uri, err := base.Observe(d.BaseInteractions, base.ReadOperation, "nft.TokenURI()", func() (string, error) {
+			return d.erc721Session.TokenURI(tokenID)
+		})
+		if err != nil {
+			return "", d.callError("nft.TokenURI()", err)
+		}
+		return uri, nil
+	})
 }
 
 // GetApproved returns the approved address for a specific token.
 func (d *ERC721Interactions) GetApproved(tokenID *big.Int) (common.Address, error) {
-	approved, err := d.erc721Session.GetApproved(tokenID)
+	approved, err := base.Observe(d.BaseInteractions, base.ReadOperation, "nft.GetApproved()", func() (common.Address, error) {
+		return d.erc721Session.GetApproved(tokenID)
+	})
 	if err != nil {
 		return common.Address{}, d.callError("nft.GetApproved()", err)
 	}
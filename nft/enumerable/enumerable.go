@@ -73,8 +73,8 @@ func (e *ERC721EnumerableInteractions) GetAllTokenIDs() ([]*big.Int, error) {
 		return nil, err
 	}
 	tokenIDs := []*big.Int{}
-	for i := range supply.Int64() {
-		tokenID, err := e.TokenByIndex(big.NewInt(i))
+	for i := new(big.Int); i.Cmp(supply) < 0; i = new(big.Int).Add(i, common.Big1) {
+		tokenID, err := e.TokenByIndex(i)
 		if err != nil {
 			return nil, e.callError("nft.TokenByIndex()", err)
 		}
@@ -85,7 +85,9 @@ func (e *ERC721EnumerableInteractions) GetAllTokenIDs() ([]*big.Int, error) {
 
 // TokenOfOwnerByIndex returns the token ID belonging to a specified address at a given index.
 func (e *ERC721EnumerableInteractions) TokenOfOwnerByIndex(to common.Address, index *big.Int) (*big.Int, error) {
-	tokenID, err := e.ierc721Enumerable.TokenOfOwnerByIndex(to, index)
+	tokenID, err := base.Observe(e.BaseInteractions, base.ReadOperation, "nft.TokenOfOwnerByIndex()", func() (*big.Int, error) {
+		return e.ierc721Enumerable.TokenOfOwnerByIndex(to, index)
+	})
 	if err != nil {
 		return nil, e.callError("nft.TokenOfOwnerByIndex()", err)
 	}
@@ -94,7 +96,9 @@ func (e *ERC721EnumerableInteractions) TokenOfOwnerByIndex(to common.Address, in
 
 // TokenByIndex returns the token ID at a specific index in the contract.
 func (e *ERC721EnumerableInteractions) TokenByIndex(index *big.Int) (*big.Int, error) {
-	tokenID, err := e.ierc721Enumerable.TokenByIndex(index)
+	tokenID, err := base.Observe(e.BaseInteractions, base.ReadOperation, "nft.TokenByIndex()", func() (*big.Int, error) {
+		return e.ierc721Enumerable.TokenByIndex(index)
+	})
 	if err != nil {
 		return nil, e.callError("nft.TokenByIndex()", err)
 	}
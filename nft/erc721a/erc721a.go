@@ -0,0 +1,339 @@
+package erc721a
+
+// Package erc721a provides functions to interact with ERC721A specific features such as queryable ownerships and consecutive transfers.
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/customerrors"
+	"github.com/OCharless/eth-interfaces/inferences/ERC721A"
+	"github.com/OCharless/eth-interfaces/inferences/IERC721AQueryable"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TokenOwnership holds the explicit ownership data ERC721A stores for a token.
+type TokenOwnership = IERC721AQueryable.IERC721ATokenOwnership
+
+// ConsecutiveTransferBatch describes a range of tokens moved by a single ERC-2309 ConsecutiveTransfer event.
+type ConsecutiveTransferBatch struct {
+	FromTokenID *big.Int
+	ToTokenID   *big.Int
+	From        common.Address
+	To          common.Address
+	BlockNumber uint64
+	TxHash      common.Hash
+}
+
+// TokenIDs expands the batch into the list of token IDs it covers, bounds included.
+// It fails on batches that are reversed or larger than nft.MaxConsecutiveTransfer tokens, which the standard forbids.
+func (b ConsecutiveTransferBatch) TokenIDs() ([]*big.Int, error) {
+	size := new(big.Int).Sub(b.ToTokenID, b.FromTokenID)
+	if size.Sign() < 0 || size.Cmp(big.NewInt(nft.MaxConsecutiveTransfer)) >= 0 {
+		return nil, fmt.Errorf("malformed ConsecutiveTransfer batch in tx %s: range %s to %s exceeds %d tokens", b.TxHash.Hex(), b.FromTokenID, b.ToTokenID, nft.MaxConsecutiveTransfer)
+	}
+	tokenIDs := []*big.Int{}
+	for id := new(big.Int).Set(b.FromTokenID); id.Cmp(b.ToTokenID) <= 0; id = new(big.Int).Add(id, common.Big1) {
+		tokenIDs = append(tokenIDs, id)
+	}
+	return tokenIDs, nil
+}
+
+// ERC721AInteractions wraps interactions with an ERC721A contract, extending basic NFT interactions.
+type ERC721AInteractions struct {
+	*nft.ERC721Interactions
+	erc721a   *ERC721A.ERC721ASession
+	queryable *IERC721AQueryable.IERC721AQueryableSession
+	callError func(string, error) *base.CallError
+}
+
+// NewERC721AInteractions creates a new ERC721A interaction instance using the provided base NFT interactions.
+// The ERC721AQueryable functions are used when the contract exposes them, otherwise lookups fall back to ownerOf scans.
+func NewERC721AInteractions(baseIERC721 *nft.ERC721Interactions, signatures []IERC721ASignature) (*ERC721AInteractions, error) {
+	erc721a, err := ERC721A.NewERC721A(baseIERC721.GetAddress(), baseIERC721.Client)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("erc721a", err)
+	}
+	session := ERC721A.ERC721ASession{
+		Contract:     erc721a,
+		CallOpts:     baseIERC721.GetSession().CallOpts,
+		TransactOpts: baseIERC721.GetSession().TransactOpts,
+	}
+
+	var converted []utils.Signature
+	for _, sig := range signatures {
+		converted = append(converted, sig)
+	}
+
+	err = baseIERC721.CheckSignatures(baseIERC721.GetAddress(), converted)
+	if err != nil {
+		return nil, customerrors.WrapinterfacingError("erc721a", err)
+	}
+
+	var queryableSession *IERC721AQueryable.IERC721AQueryableSession
+	if baseIERC721.CheckSignatures(baseIERC721.GetAddress(), []utils.Signature{ExplicitOwnershipOf, TokensOfOwner, TokensOfOwnerIn}) == nil {
+		queryable, err := IERC721AQueryable.NewIERC721AQueryable(baseIERC721.GetAddress(), baseIERC721.Client)
+		if err != nil {
+			return nil, customerrors.WrapinterfacingError("erc721aQueryable", err)
+		}
+		queryableSession = &IERC721AQueryable.IERC721AQueryableSession{
+			Contract:     queryable,
+			CallOpts:     baseIERC721.GetSession().CallOpts,
+			TransactOpts: baseIERC721.GetSession().TransactOpts,
+		}
+	}
+
+	callError := func(field string, err error) *base.CallError {
+		return baseIERC721.WrapCallError(IERC721AQueryable.IERC721AQueryableABI, field, err)
+	}
+
+	return &ERC721AInteractions{baseIERC721, &session, queryableSession, callError}, nil
+}
+
+// IsQueryable reports whether the contract implements the ERC721AQueryable extension.
+func (e *ERC721AInteractions) IsQueryable() bool {
+	return e.queryable != nil
+}
+
+// OwnerOf retrieves the owner of a specific token, decoding ERC721A custom errors.
+func (e *ERC721AInteractions) OwnerOf(tokenID *big.Int) (common.Address, error) {
+	owner, err := base.Observe(e.BaseInteractions, base.ReadOperation, "erc721a.OwnerOf()", func() (common.Address, error) {
+		return e.erc721a.OwnerOf(tokenID)
+	})
+	if err != nil {
+		return common.Address{}, e.callError("erc721a.OwnerOf()", err)
+	}
+	return owner, nil
+}
+
+// ExplicitOwnershipOf returns the ownership data of a token. Contracts without the queryable extension only report the owner address.
+func (e *ERC721AInteractions) ExplicitOwnershipOf(tokenID *big.Int) (TokenOwnership, error) {
+	if e.queryable == nil {
+		owner, err := e.OwnerOf(tokenID)
+		if err != nil {
+			return TokenOwnership{}, err
+		}
+		return TokenOwnership{Addr: owner}, nil
+	}
+
+	ownership, err := base.Observe(e.BaseInteractions, base.ReadOperation, "erc721a.ExplicitOwnershipOf()", func() (TokenOwnership, error) {
+		return e.queryable.ExplicitOwnershipOf(tokenID)
+	})
+	if err != nil {
+		return TokenOwnership{}, e.callError("erc721a.ExplicitOwnershipOf()", err)
+	}
+	return ownership, nil
+}
+
+// ExplicitOwnershipsOf returns the ownership data of several tokens, in order.
+func (e *ERC721AInteractions) ExplicitOwnershipsOf(tokenIDs []*big.Int) ([]TokenOwnership, error) {
+	if e.queryable == nil {
+		ownerships := []TokenOwnership{}
+		for _, tokenID := range tokenIDs {
+			ownership, err := e.ExplicitOwnershipOf(tokenID)
+			if err != nil {
+				return nil, err
+			}
+			ownerships = append(ownerships, ownership)
+		}
+		return ownerships, nil
+	}
+
+	ownerships, err := base.Observe(e.BaseInteractions, base.ReadOperation, "erc721a.ExplicitOwnershipsOf()", func() ([]TokenOwnership, error) {
+		return e.queryable.ExplicitOwnershipsOf(tokenIDs)
+	})
+	if err != nil {
+		return nil, e.callError("erc721a.ExplicitOwnershipsOf()", err)
+	}
+	return ownerships, nil
+}
+
+// TokensOfOwner returns every token ID owned by the given address.
+// Without the queryable extension, token IDs are scanned from 0 until every token of the supply has been seen, so that
+// burned tokens and a start token ID above 0 do not cut the scan short.
+func (e *ERC721AInteractions) TokensOfOwner(owner common.Address) ([]*big.Int, error) {
+	if e.queryable == nil {
+		supply, err := e.TotalSupply()
+		if err != nil {
+			return nil, err
+		}
+		balance, err := e.BalanceOf(owner)
+		if err != nil {
+			return nil, err
+		}
+		tokenIDs := []*big.Int{}
+		seen := new(big.Int)
+		for id := new(big.Int); seen.Cmp(supply) < 0 && balance.Cmp(big.NewInt(int64(len(tokenIDs)))) > 0; id = new(big.Int).Add(id, common.Big1) {
+			tokenOwner, exists, err := e.existingOwnerOf(id)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				continue
+			}
+			seen.Add(seen, common.Big1)
+			if tokenOwner == owner {
+				tokenIDs = append(tokenIDs, id)
+			}
+		}
+		return tokenIDs, nil
+	}
+
+	tokenIDs, err := base.Observe(e.BaseInteractions, base.ReadOperation, "erc721a.TokensOfOwner()", func() ([]*big.Int, error) {
+		return e.queryable.TokensOfOwner(owner)
+	})
+	if err != nil {
+		return nil, e.callError("erc721a.TokensOfOwner()", err)
+	}
+	return tokenIDs, nil
+}
+
+// TokensOfOwnerIn returns the token IDs owned by the given address in the range [start, stop).
+func (e *ERC721AInteractions) TokensOfOwnerIn(owner common.Address, start, stop *big.Int) ([]*big.Int, error) {
+	if e.queryable == nil {
+		tokenIDs := []*big.Int{}
+		for id := new(big.Int).Set(start); id.Cmp(stop) < 0; id = new(big.Int).Add(id, common.Big1) {
+			tokenOwner, exists, err := e.existingOwnerOf(id)
+			if err != nil {
+				return nil, err
+			}
+			if exists && tokenOwner == owner {
+				tokenIDs = append(tokenIDs, id)
+			}
+		}
+		return tokenIDs, nil
+	}
+
+	tokenIDs, err := base.Observe(e.BaseInteractions, base.ReadOperation, "erc721a.TokensOfOwnerIn()", func() ([]*big.Int, error) {
+		return e.queryable.TokensOfOwnerIn(owner, start, stop)
+	})
+	if err != nil {
+		return nil, e.callError("erc721a.TokensOfOwnerIn()", err)
+	}
+	return tokenIDs, nil
+}
+
+// existingOwnerOf retrieves the owner of a token, reporting whether it exists instead of failing on unminted and burned tokens.
+func (e *ERC721AInteractions) existingOwnerOf(tokenID *big.Int) (common.Address, bool, error) {
+	owner, err := e.OwnerOf(tokenID)
+	if err != nil {
+		var callErr *base.CallError
+		if errors.As(err, &callErr) && callErr.Err.Error() == "OwnerQueryForNonexistentToken" {
+			return common.Address{}, false, nil
+		}
+		return common.Address{}, false, err
+	}
+	return owner, true, nil
+}
+
+// ConsecutiveTransfers returns the ERC-2309 batches emitted between the given blocks. A nil end block means the latest block.
+func (e *ERC721AInteractions) ConsecutiveTransfers(start uint64, end *uint64) ([]ConsecutiveTransferBatch, error) {
+	it, err := base.Observe(e.BaseInteractions, base.ReadOperation, "erc721a.FilterConsecutiveTransfer()", func() (*ERC721A.ERC721AConsecutiveTransferIterator, error) {
+		return e.erc721a.Contract.FilterConsecutiveTransfer(e.filterOpts(start, end), nil, nil, nil)
+	})
+	if err != nil {
+		return nil, e.callError("erc721a.FilterConsecutiveTransfer()", err)
+	}
+	defer it.Close()
+
+	batches := []ConsecutiveTransferBatch{}
+	for it.Next() {
+		batches = append(batches, ConsecutiveTransferBatch{
+			FromTokenID: it.Event.FromTokenId,
+			ToTokenID:   it.Event.ToTokenId,
+			From:        it.Event.From,
+			To:          it.Event.To,
+			BlockNumber: it.Event.Raw.BlockNumber,
+			TxHash:      it.Event.Raw.TxHash,
+		})
+	}
+	if err := it.Error(); err != nil {
+		return nil, e.callError("erc721a.FilterConsecutiveTransfer()", err)
+	}
+	return batches, nil
+}
+
+// ReplayOwnerships rebuilds the owner of every token by replaying Transfer and ConsecutiveTransfer events in chain order.
+// Since ERC721A initialises ownership lazily, batch mints are expanded token by token. Burned tokens are left out of the result.
+func (e *ERC721AInteractions) ReplayOwnerships(start uint64, end *uint64) (map[string]common.Address, error) {
+	type ownershipChange struct {
+		blockNumber uint64
+		logIndex    uint
+		tokenIDs    []*big.Int
+		to          common.Address
+	}
+	changes := []ownershipChange{}
+
+	transfers, err := base.Observe(e.BaseInteractions, base.ReadOperation, "erc721a.FilterTransfer()", func() (*ERC721A.ERC721ATransferIterator, error) {
+		return e.erc721a.Contract.FilterTransfer(e.filterOpts(start, end), nil, nil, nil)
+	})
+	if err != nil {
+		return nil, e.callError("erc721a.FilterTransfer()", err)
+	}
+	defer transfers.Close()
+	for transfers.Next() {
+		changes = append(changes, ownershipChange{
+			blockNumber: transfers.Event.Raw.BlockNumber,
+			logIndex:    transfers.Event.Raw.Index,
+			tokenIDs:    []*big.Int{transfers.Event.TokenId},
+			to:          transfers.Event.To,
+		})
+	}
+	if err := transfers.Error(); err != nil {
+		return nil, e.callError("erc721a.FilterTransfer()", err)
+	}
+
+	consecutives, err := base.Observe(e.BaseInteractions, base.ReadOperation, "erc721a.FilterConsecutiveTransfer()", func() (*ERC721A.ERC721AConsecutiveTransferIterator, error) {
+		return e.erc721a.Contract.FilterConsecutiveTransfer(e.filterOpts(start, end), nil, nil, nil)
+	})
+	if err != nil {
+		return nil, e.callError("erc721a.FilterConsecutiveTransfer()", err)
+	}
+	defer consecutives.Close()
+	for consecutives.Next() {
+		batch := ConsecutiveTransferBatch{FromTokenID: consecutives.Event.FromTokenId, ToTokenID: consecutives.Event.ToTokenId, TxHash: consecutives.Event.Raw.TxHash}
+		tokenIDs, err := batch.TokenIDs()
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, ownershipChange{
+			blockNumber: consecutives.Event.Raw.BlockNumber,
+			logIndex:    consecutives.Event.Raw.Index,
+			tokenIDs:    tokenIDs,
+			to:          consecutives.Event.To,
+		})
+	}
+	if err := consecutives.Error(); err != nil {
+		return nil, e.callError("erc721a.FilterConsecutiveTransfer()", err)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].blockNumber != changes[j].blockNumber {
+			return changes[i].blockNumber < changes[j].blockNumber
+		}
+		return changes[i].logIndex < changes[j].logIndex
+	})
+
+	owners := map[string]common.Address{}
+	for _, change := range changes {
+		for _, tokenID := range change.tokenIDs {
+			if change.to == (common.Address{}) {
+				delete(owners, tokenID.String())
+				continue
+			}
+			owners[tokenID.String()] = change.to
+		}
+	}
+	return owners, nil
+}
+
+// filterOpts builds the log filtering options for the given block range.
+func (e *ERC721AInteractions) filterOpts(start uint64, end *uint64) *bind.FilterOpts {
+	return &bind.FilterOpts{Start: start, End: end, Context: e.Ctx}
+}
@@ -0,0 +1,318 @@
+package erc721a_test
+
+// Package erc721a_test contains tests for ERC721A interactions.
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/inferences/ERC721A"
+	"github.com/OCharless/eth-interfaces/inferences/ERC721ABurnable"
+	"github.com/OCharless/eth-interfaces/inferences/ERC721AQueryable"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/nft/erc721a"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupQueryable deploys an ERC721AQueryable collection whose tokens 0 to 9 are minted to the deployer by a single ERC-2309 batch.
+func setupQueryable(t *testing.T) (*simulated.Backend, *bind.TransactOpts, *erc721a.ERC721AInteractions) {
+	backend, auth, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC721AQueryable.ERC721AQueryableABI,
+		ERC721AQueryable.ERC721AQueryableBin,
+	)
+	assert.Nil(t, err)
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{nft.TransferFrom}, auth)
+	assert.Nil(t, err)
+	session, err := erc721a.NewERC721AInteractions(nftA, []erc721a.IERC721ASignature{erc721a.ExplicitOwnershipOf, erc721a.TokensOfOwner})
+	assert.Nil(t, err)
+	return backend, auth, session
+}
+
+// Test_Instantiation verifies that the ERC721A interactions are created and that a contract without ERC721AQueryable is detected as such.
+func Test_Instantiation(t *testing.T) {
+	backend, _, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC721A.ERC721AABI,
+		ERC721A.ERC721ABin,
+		"MyNFT",
+		"MNFT",
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{nft.OwnerOf})
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		Name          string
+		Signatures    []erc721a.IERC721ASignature
+		ExpectError   bool
+		ExpectedError string
+	}{
+		{
+			Name: "OK - Successfully instantiated",
+		},
+		{
+			Name:          "KO - Queryable functions required but not implemented",
+			Signatures:    []erc721a.IERC721ASignature{erc721a.TokensOfOwner},
+			ExpectError:   true,
+			ExpectedError: "not supported functions: tokensOfOwner(address)",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			session, err := erc721a.NewERC721AInteractions(nftA, tt.Signatures)
+			if tt.ExpectError {
+				if err == nil {
+					t.Error("expected error but there's none")
+					return
+				}
+				assert.Contains(t, err.Error(), tt.ExpectedError)
+			} else {
+				assert.Nil(t, err)
+				assert.False(t, session.IsQueryable())
+			}
+		})
+	}
+
+	t.Run("OK - Queryable contract", func(t *testing.T) {
+		queryableBackend, _, session := setupQueryable(t)
+		defer queryableBackend.Close()
+		assert.True(t, session.IsQueryable())
+	})
+}
+
+// Test_QueryableLookups verifies the lookups served by the ERC721AQueryable functions, including tokens whose ownership
+// is only stored at the start of their ERC-2309 batch.
+func Test_QueryableLookups(t *testing.T) {
+	backend, auth, session := setupQueryable(t)
+	defer backend.Close()
+
+	receiver := common.HexToAddress("1")
+	_, err := session.TransferTo(receiver, big.NewInt(3))
+	assert.Nil(t, err)
+	backend.Commit()
+
+	t.Run("OK - Explicit ownership of a transferred token", func(t *testing.T) {
+		ownership, err := session.ExplicitOwnershipOf(big.NewInt(3))
+		assert.Nil(t, err)
+		assert.Equal(t, receiver, ownership.Addr)
+		assert.NotZero(t, ownership.StartTimestamp)
+		assert.False(t, ownership.Burned)
+	})
+
+	t.Run("OK - Explicit ownerships inside and outside the batch", func(t *testing.T) {
+		ownerships, err := session.ExplicitOwnershipsOf([]*big.Int{big.NewInt(2), big.NewInt(3), big.NewInt(4), big.NewInt(100)})
+		assert.Nil(t, err)
+		assert.Len(t, ownerships, 4)
+		assert.Equal(t, auth.From, ownerships[0].Addr)
+		assert.Equal(t, receiver, ownerships[1].Addr)
+		assert.Equal(t, auth.From, ownerships[2].Addr)
+		assert.Equal(t, common.Address{}, ownerships[3].Addr)
+	})
+
+	t.Run("OK - Tokens of owner", func(t *testing.T) {
+		tokenIDs, err := session.TokensOfOwner(receiver)
+		assert.Nil(t, err)
+		assert.Equal(t, []*big.Int{big.NewInt(3)}, tokenIDs)
+		tokenIDs, err = session.TokensOfOwner(auth.From)
+		assert.Nil(t, err)
+		assert.Len(t, tokenIDs, 9)
+		assert.NotContains(t, tokenIDs, big.NewInt(3))
+	})
+
+	t.Run("OK - Tokens of owner in a range", func(t *testing.T) {
+		tokenIDs, err := session.TokensOfOwnerIn(auth.From, big.NewInt(2), big.NewInt(5))
+		assert.Nil(t, err)
+		assert.Equal(t, []*big.Int{big.NewInt(2), big.NewInt(4)}, tokenIDs)
+	})
+
+	t.Run("KO - Nonexistent token", func(t *testing.T) {
+		_, err := session.OwnerOf(big.NewInt(10))
+		assert.ErrorContains(t, err, "OwnerQueryForNonexistentToken")
+	})
+}
+
+// Test_OwnershipLookups verifies ownership lookups and the decoding of ERC721A custom errors.
+func Test_OwnershipLookups(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC721A.ERC721AABI,
+		ERC721A.ERC721ABin,
+		"MyNFT",
+		"MNFT",
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{nft.OwnerOf})
+	assert.Nil(t, err)
+
+	session, err := erc721a.NewERC721AInteractions(nftA, []erc721a.IERC721ASignature{})
+	assert.Nil(t, err)
+
+	t.Run("OK - Explicit ownership of a minted token", func(t *testing.T) {
+		ownership, err := session.ExplicitOwnershipOf(big.NewInt(2))
+		assert.Nil(t, err)
+		assert.Equal(t, auth.From, ownership.Addr)
+	})
+
+	t.Run("OK - Tokens of owner matches balance", func(t *testing.T) {
+		tokenIDs, err := session.TokensOfOwner(auth.From)
+		assert.Nil(t, err)
+		balance, err := session.BalanceOf(auth.From)
+		assert.Nil(t, err)
+		assert.Equal(t, balance.Int64(), int64(len(tokenIDs)))
+	})
+
+	t.Run("KO - Nonexistent token", func(t *testing.T) {
+		_, err := session.OwnerOf(big.NewInt(1_000))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "call error on erc721a.OwnerOf(): OwnerQueryForNonexistentToken")
+	})
+}
+
+// Test_FallbackScans verifies the ownerOf scans of a collection without ERC721AQueryable whose token IDs start at 1 and
+// where tokens were burned.
+func Test_FallbackScans(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC721ABurnable.ERC721ABurnableABI,
+		ERC721ABurnable.ERC721ABurnableBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	burnable, err := ERC721ABurnable.NewERC721ABurnable(*contractAddr, backend.Client())
+	assert.Nil(t, err)
+	_, err = burnable.Burn(auth, big.NewInt(2))
+	assert.Nil(t, err)
+	backend.Commit()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{nft.OwnerOf})
+	assert.Nil(t, err)
+	session, err := erc721a.NewERC721AInteractions(nftA, []erc721a.IERC721ASignature{})
+	assert.Nil(t, err)
+	assert.False(t, session.IsQueryable())
+
+	t.Run("OK - Tokens of owner up to the last token", func(t *testing.T) {
+		tokenIDs, err := session.TokensOfOwner(auth.From)
+		assert.Nil(t, err)
+		assert.Equal(t, []*big.Int{big.NewInt(1), big.NewInt(3), big.NewInt(4), big.NewInt(5)}, tokenIDs)
+	})
+
+	t.Run("OK - Tokens of owner in a range skip burned and unminted tokens", func(t *testing.T) {
+		tokenIDs, err := session.TokensOfOwnerIn(auth.From, big.NewInt(0), big.NewInt(4))
+		assert.Nil(t, err)
+		assert.Equal(t, []*big.Int{big.NewInt(1), big.NewInt(3)}, tokenIDs)
+	})
+
+	t.Run("OK - No tokens", func(t *testing.T) {
+		tokenIDs, err := session.TokensOfOwner(common.HexToAddress("1"))
+		assert.Nil(t, err)
+		assert.Empty(t, tokenIDs)
+	})
+}
+
+// Test_ReplayOwnerships verifies that replaying transfer logs rebuilds the same ownership as the contract.
+func Test_ReplayOwnerships(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC721A.ERC721AABI,
+		ERC721A.ERC721ABin,
+		"MyNFT",
+		"MNFT",
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{nft.TransferFrom}, auth)
+	assert.Nil(t, err)
+
+	session, err := erc721a.NewERC721AInteractions(nftA, []erc721a.IERC721ASignature{})
+	assert.Nil(t, err)
+
+	receiver := common.HexToAddress("1")
+	_, err = session.TransferTo(receiver, big.NewInt(3))
+	assert.Nil(t, err)
+	backend.Commit()
+
+	batches, err := session.ConsecutiveTransfers(0, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, batches)
+
+	owners, err := session.ReplayOwnerships(0, nil)
+	assert.Nil(t, err)
+
+	supply, err := session.TotalSupply()
+	assert.Nil(t, err)
+	assert.Equal(t, supply.Int64(), int64(len(owners)))
+	assert.Equal(t, receiver, owners["3"])
+	assert.Equal(t, auth.From, owners["0"])
+
+	t.Run("OK - ERC-2309 batch", func(t *testing.T) {
+		backend, auth, session := setupQueryable(t)
+		defer backend.Close()
+		_, err := session.TransferTo(receiver, big.NewInt(3))
+		assert.Nil(t, err)
+		backend.Commit()
+
+		batches, err := session.ConsecutiveTransfers(0, nil)
+		assert.Nil(t, err)
+		assert.Len(t, batches, 1)
+		assert.Equal(t, "0", batches[0].FromTokenID.String())
+		assert.Equal(t, "9", batches[0].ToTokenID.String())
+		assert.Equal(t, common.Address{}, batches[0].From)
+		assert.Equal(t, auth.From, batches[0].To)
+
+		owners, err := session.ReplayOwnerships(0, nil)
+		assert.Nil(t, err)
+		assert.Len(t, owners, 10)
+		for id, owner := range owners {
+			tokenID, _ := new(big.Int).SetString(id, 10)
+			ownership, err := session.ExplicitOwnershipOf(tokenID)
+			assert.Nil(t, err)
+			assert.Equal(t, ownership.Addr, owner, id)
+		}
+		assert.Equal(t, receiver, owners["3"])
+	})
+}
+
+// Test_ConsecutiveTransferBatch verifies the expansion of a consecutive transfer batch and its ERC-2309 size limit.
+func Test_ConsecutiveTransferBatch(t *testing.T) {
+	t.Run("OK - Batch expanded", func(t *testing.T) {
+		batch := erc721a.ConsecutiveTransferBatch{FromTokenID: big.NewInt(5), ToTokenID: big.NewInt(8)}
+		tokenIDs, err := batch.TokenIDs()
+		assert.Nil(t, err)
+		assert.Len(t, tokenIDs, 4)
+		assert.Equal(t, int64(5), tokenIDs[0].Int64())
+		assert.Equal(t, int64(8), tokenIDs[3].Int64())
+	})
+
+	t.Run("OK - Largest batch", func(t *testing.T) {
+		batch := erc721a.ConsecutiveTransferBatch{FromTokenID: big.NewInt(1), ToTokenID: big.NewInt(nft.MaxConsecutiveTransfer)}
+		tokenIDs, err := batch.TokenIDs()
+		assert.Nil(t, err)
+		assert.Len(t, tokenIDs, nft.MaxConsecutiveTransfer)
+	})
+
+	t.Run("KO - Batch above the ERC-2309 limit", func(t *testing.T) {
+		batch := erc721a.ConsecutiveTransferBatch{FromTokenID: big.NewInt(0), ToTokenID: new(big.Int).Lsh(common.Big1, 255)}
+		_, err := batch.TokenIDs()
+		assert.ErrorContains(t, err, "exceeds 5000 tokens")
+	})
+
+	t.Run("KO - Reversed batch", func(t *testing.T) {
+		batch := erc721a.ConsecutiveTransferBatch{FromTokenID: big.NewInt(8), ToTokenID: big.NewInt(5)}
+		_, err := batch.TokenIDs()
+		assert.Error(t, err)
+	})
+}
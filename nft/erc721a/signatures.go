@@ -0,0 +1,24 @@
+package erc721a
+
+import (
+	"encoding/hex"
+
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type IERC721ASignature nft.BaseNFTSignature
+
+const (
+	ExplicitOwnershipOf  IERC721ASignature = "explicitOwnershipOf(uint256)"
+	ExplicitOwnershipsOf IERC721ASignature = "explicitOwnershipsOf(uint256[])"
+	TokensOfOwner        IERC721ASignature = "tokensOfOwner(address)"
+	TokensOfOwnerIn      IERC721ASignature = "tokensOfOwnerIn(address,uint256,uint256)"
+)
+
+func (s IERC721ASignature) GetHex() string {
+	hash := crypto.NewKeccakState()
+	hash.Write([]byte(s))
+	selector := hash.Sum(nil)[:4]
+	return hex.EncodeToString(selector)
+}
@@ -0,0 +1,26 @@
+package nft
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TokenIndex answers ownership queries from an off-chain index, such as the indexer package, for collections without the Enumerable extension.
+type TokenIndex interface {
+	TokensOf(contract, owner common.Address) ([]*big.Int, error)
+}
+
+// SetTokenIndex sets the index used by OwnedTokensFromIndex, nil disables it.
+func (d *ERC721Interactions) SetTokenIndex(index TokenIndex) {
+	d.tokenIndex = index
+}
+
+// OwnedTokensFromIndex returns the ids of the tokens owned by an address according to the token index.
+func (d *ERC721Interactions) OwnedTokensFromIndex(owner common.Address) ([]*big.Int, error) {
+	if d.tokenIndex == nil {
+		return nil, errors.New("no token index set")
+	}
+	return d.tokenIndex.TokensOf(d.nftAddress, owner)
+}
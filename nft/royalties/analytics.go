@@ -0,0 +1,111 @@
+package royalties
+
+// Collection-wide royalty helpers built on top of the per-token RoyaltiesInfos call.
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/nft/enumerable"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BasisPointsDenominator is the canonical sale price used to read a royalty rate: querying royaltyInfo
+// with 10000 returns the rate directly in basis points, without the rounding a 1 wei sale price causes.
+const BasisPointsDenominator = 10_000
+
+// Sale describes a single token sale used to compute royalty payouts.
+type Sale struct {
+	TokenID *big.Int
+	Price   *big.Int
+}
+
+// CollectionRoyalties holds the royalty configuration shared by most tokens of a collection and the tokens overriding it.
+type CollectionRoyalties struct {
+	Default   RoyaltyInfos
+	Overrides map[string]RoyaltyInfos
+}
+
+// basisPoints computes the royalty rate in basis points for the given amount and sale price.
+func basisPoints(royaltyAmount, salePrice *big.Int) *big.Int {
+	if royaltyAmount == nil || salePrice == nil || salePrice.Sign() == 0 {
+		return big.NewInt(0)
+	}
+	rate := new(big.Int).Mul(royaltyAmount, big.NewInt(BasisPointsDenominator))
+	return rate.Div(rate, salePrice)
+}
+
+// RoyaltyRate retrieves the royalty receiver and basis-point rate of a token by querying it with the canonical sale price.
+func (e *IERC721RoyaltiesInteractions) RoyaltyRate(tokenID *big.Int) (RoyaltyInfos, error) {
+	return e.RoyaltiesInfos(tokenID, big.NewInt(BasisPointsDenominator))
+}
+
+// DetectOverrides reads the royalty rate of each token and reports the most common configuration as the collection
+// default, along with every token whose receiver or rate differs from it. When no token IDs are given, the collection
+// must implement ERC721Enumerable and every token is listed through tokenByIndex, since ids need not run from 0 to the supply.
+func (e *IERC721RoyaltiesInteractions) DetectOverrides(tokenIDs ...*big.Int) (*CollectionRoyalties, error) {
+	if len(tokenIDs) == 0 {
+		collection, err := enumerable.NewERC721EnumerableInteractions(e.ERC721Interactions, []enumerable.IERC721EnumerableSignature{enumerable.TokenByIndex})
+		if err != nil {
+			return nil, fmt.Errorf("token IDs are required for collections without ERC721Enumerable: %w", err)
+		}
+		tokenIDs, err = collection.GetAllTokenIDs()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the token IDs: %w", err)
+		}
+	}
+
+	rates := make(map[string]RoyaltyInfos, len(tokenIDs))
+	counts := map[string]int{}
+	var defaultKey string
+	for _, tokenID := range tokenIDs {
+		infos, err := e.RoyaltyRate(tokenID)
+		if err != nil {
+			return nil, err
+		}
+		rates[tokenID.String()] = infos
+
+		key := configurationKey(infos)
+		counts[key]++
+		if counts[key] > counts[defaultKey] {
+			defaultKey = key
+		}
+	}
+
+	report := &CollectionRoyalties{Overrides: map[string]RoyaltyInfos{}}
+	for _, tokenID := range tokenIDs {
+		infos := rates[tokenID.String()]
+		if configurationKey(infos) == defaultKey {
+			report.Default = infos
+			continue
+		}
+		report.Overrides[tokenID.String()] = infos
+	}
+	return report, nil
+}
+
+// AggregatePayouts queries the royalty owed for every sale and sums the amounts per receiver.
+func (e *IERC721RoyaltiesInteractions) AggregatePayouts(sales []Sale) (map[common.Address]*big.Int, error) {
+	payouts := map[common.Address]*big.Int{}
+	for _, sale := range sales {
+		infos, err := e.RoyaltiesInfos(sale.TokenID, sale.Price)
+		if err != nil {
+			return nil, err
+		}
+		if infos.RoyaltyAmount == nil || infos.RoyaltyAmount.Sign() == 0 {
+			continue
+		}
+		total, ok := payouts[infos.Receiver]
+		if !ok {
+			total = big.NewInt(0)
+			payouts[infos.Receiver] = total
+		}
+		total.Add(total, infos.RoyaltyAmount)
+	}
+	return payouts, nil
+}
+
+// configurationKey identifies a royalty configuration by its receiver and rate.
+func configurationKey(infos RoyaltyInfos) string {
+	return fmt.Sprintf("%s:%s", infos.Receiver.Hex(), infos.BasisPoints.String())
+}
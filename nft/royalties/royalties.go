@@ -20,10 +20,11 @@ type IERC721RoyaltiesInteractions struct {
 	callError        func(string, error) *base.CallError
 }
 
-// RoyaltyInfos holds the royalty receiver and amount for a token sale.
+// RoyaltyInfos holds the royalty receiver, amount and basis-point rate for a token sale.
 type RoyaltyInfos struct {
 	Receiver      common.Address
 	RoyaltyAmount *big.Int
+	BasisPoints   *big.Int
 }
 
 // NewIERC721RoyaltiesInteractions creates a new instance of IERC721RoyaltiesInteractions.
@@ -57,9 +58,13 @@ func NewERC721RoyaltiesInteractions(baseIERC721 *nft.ERC721Interactions, signatu
 
 // RoyaltiesInfos retrieves the royalty information for a given token and sale price.
 func (e *IERC721RoyaltiesInteractions) RoyaltiesInfos(tokenID *big.Int, salePrice *big.Int) (RoyaltyInfos, error) {
-	rInfos, err := e.ierc721Royalties.RoyaltyInfo(tokenID, salePrice)
+	rInfos, err := base.Observe(e.BaseInteractions, base.ReadOperation, "nft.RoyaltyInfo()", func() (RoyaltyInfos, error) {
+		rInfos, err := e.ierc721Royalties.RoyaltyInfo(tokenID, salePrice)
+		return RoyaltyInfos{Receiver: rInfos.Receiver, RoyaltyAmount: rInfos.RoyaltyAmount}, err
+	})
 	if err != nil {
 		return RoyaltyInfos{}, e.callError("nft.RoyaltyInfo()", err)
 	}
+	rInfos.BasisPoints = basisPoints(rInfos.RoyaltyAmount, salePrice)
 	return rInfos, nil
 }
@@ -8,13 +8,53 @@ import (
 
 	"github.com/OCharless/eth-interfaces/base"
 	"github.com/OCharless/eth-interfaces/inferences/ERC721Complete"
+	"github.com/OCharless/eth-interfaces/inferences/ERC721Royalties"
 	"github.com/OCharless/eth-interfaces/nft"
 	"github.com/OCharless/eth-interfaces/nft/royalties"
 	"github.com/OCharless/eth-interfaces/utils"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
 	"github.com/stretchr/testify/assert"
 )
 
+var (
+	defaultReceiver  = common.HexToAddress("0x000000000000000000000000000000000000beef")
+	overrideReceiver = common.HexToAddress("0x000000000000000000000000000000000000cafe")
+)
+
+// setupRoyalties deploys a collection of tokens 10, 20, 30 and 40 paying 5% to defaultReceiver,
+// except token 20 paying 10% to overrideReceiver and token 40 paying 2.5% to defaultReceiver.
+func setupRoyalties(t *testing.T) (*simulated.Backend, *royalties.IERC721RoyaltiesInteractions) {
+	backend, auth, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC721Royalties.ERC721RoyaltiesABI,
+		ERC721Royalties.ERC721RoyaltiesBin,
+	)
+	assert.Nil(t, err)
+
+	collection, err := ERC721Royalties.NewERC721Royalties(*contractAddr, backend.Client())
+	assert.Nil(t, err)
+	for _, id := range []int64{10, 20, 30, 40} {
+		_, err = collection.Mint(auth, auth.From, big.NewInt(id))
+		assert.Nil(t, err)
+		// Gas is estimated against the last block, on which the later mints of a same block run out of gas.
+		backend.Commit()
+	}
+	_, err = collection.SetDefaultRoyalty(auth, defaultReceiver, big.NewInt(500))
+	assert.Nil(t, err)
+	_, err = collection.SetTokenRoyalty(auth, big.NewInt(20), overrideReceiver, big.NewInt(1000))
+	assert.Nil(t, err)
+	_, err = collection.SetTokenRoyalty(auth, big.NewInt(40), defaultReceiver, big.NewInt(250))
+	assert.Nil(t, err)
+	backend.Commit()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{nft.BalanceOf})
+	assert.Nil(t, err)
+	royInteractions, err := royalties.NewERC721RoyaltiesInteractions(nftA, []royalties.IERC721RoyaltiesSignature{royalties.RoyaltyInfo})
+	assert.Nil(t, err)
+	return backend, royInteractions
+}
+
 // Test_RoyaltiesInfos verifies the RoyaltiesInfos method for valid and invalid token IDs using a table-driven approach.
 func Test_RoyaltiesInfos(t *testing.T) {
 	backend, _, contractAddr, privKey, err := utils.SetupBlockchain(t,
@@ -59,3 +99,154 @@ func Test_RoyaltiesInfos(t *testing.T) {
 		})
 	}
 }
+
+// Test_RoyaltyRate verifies that the royalty rate is read with the canonical sale price and reported in basis points.
+func Test_RoyaltyRate(t *testing.T) {
+	backend, _, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC721Complete.ERC721CompleteABI,
+		ERC721Complete.ERC721CompleteBin,
+		"MyNFT",
+		"MNFT",
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{nft.BalanceOf})
+	assert.Nil(t, err)
+
+	royInteractions, err := royalties.NewERC721RoyaltiesInteractions(nftA, []royalties.IERC721RoyaltiesSignature{royalties.RoyaltyInfo})
+	assert.Nil(t, err)
+
+	infos, err := royInteractions.RoyaltyRate(common.Big0)
+	assert.Nil(t, err)
+	assert.Equal(t, common.Address{}, infos.Receiver)
+	assert.Zero(t, infos.BasisPoints.Sign())
+
+	t.Run("OK - Token royalty", func(t *testing.T) {
+		backend, royInteractions := setupRoyalties(t)
+		defer backend.Close()
+
+		infos, err := royInteractions.RoyaltyRate(big.NewInt(40))
+		assert.Nil(t, err)
+		assert.Equal(t, defaultReceiver, infos.Receiver)
+		assert.Equal(t, "250", infos.BasisPoints.String())
+	})
+}
+
+// Test_DetectOverrides verifies that a collection without per-token royalties reports a single default configuration.
+func Test_DetectOverrides(t *testing.T) {
+	backend, _, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC721Complete.ERC721CompleteABI,
+		ERC721Complete.ERC721CompleteBin,
+		"MyNFT",
+		"MNFT",
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{nft.BalanceOf})
+	assert.Nil(t, err)
+
+	royInteractions, err := royalties.NewERC721RoyaltiesInteractions(nftA, []royalties.IERC721RoyaltiesSignature{royalties.RoyaltyInfo})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name     string
+		tokenIDs []*big.Int
+	}{
+		{
+			name: "OK - Whole collection",
+		},
+		{
+			name:     "OK - Selected tokens",
+			tokenIDs: []*big.Int{big.NewInt(1), big.NewInt(5)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := royInteractions.DetectOverrides(tt.tokenIDs...)
+			assert.Nil(t, err)
+			assert.Empty(t, report.Overrides)
+			assert.Zero(t, report.Default.BasisPoints.Sign())
+		})
+	}
+
+	royaltiesBackend, withOverrides := setupRoyalties(t)
+	defer royaltiesBackend.Close()
+
+	overrideTests := []struct {
+		name              string
+		tokenIDs          []*big.Int
+		expectedOverrides map[string]royalties.RoyaltyInfos
+	}{
+		{
+			name: "OK - Whole collection with overrides",
+			expectedOverrides: map[string]royalties.RoyaltyInfos{
+				"20": {Receiver: overrideReceiver, RoyaltyAmount: big.NewInt(1000), BasisPoints: big.NewInt(1000)},
+				"40": {Receiver: defaultReceiver, RoyaltyAmount: big.NewInt(250), BasisPoints: big.NewInt(250)},
+			},
+		},
+		{
+			name:     "OK - Selected tokens with overrides",
+			tokenIDs: []*big.Int{big.NewInt(10), big.NewInt(20), big.NewInt(30)},
+			expectedOverrides: map[string]royalties.RoyaltyInfos{
+				"20": {Receiver: overrideReceiver, RoyaltyAmount: big.NewInt(1000), BasisPoints: big.NewInt(1000)},
+			},
+		},
+	}
+
+	for _, tt := range overrideTests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := withOverrides.DetectOverrides(tt.tokenIDs...)
+			assert.Nil(t, err)
+			assert.Equal(t, defaultReceiver, report.Default.Receiver)
+			assert.Equal(t, "500", report.Default.BasisPoints.String())
+			assert.Equal(t, tt.expectedOverrides, report.Overrides)
+		})
+	}
+}
+
+// Test_AggregatePayouts verifies that sales without royalties produce no payouts and that the others are summed per receiver.
+func Test_AggregatePayouts(t *testing.T) {
+	backend, _, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC721Complete.ERC721CompleteABI,
+		ERC721Complete.ERC721CompleteBin,
+		"MyNFT",
+		"MNFT",
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	nftA, err := nft.NewERC721Interactions(baseInteractions, *contractAddr, []nft.BaseNFTSignature{nft.BalanceOf})
+	assert.Nil(t, err)
+
+	royInteractions, err := royalties.NewERC721RoyaltiesInteractions(nftA, []royalties.IERC721RoyaltiesSignature{royalties.RoyaltyInfo})
+	assert.Nil(t, err)
+
+	payouts, err := royInteractions.AggregatePayouts([]royalties.Sale{
+		{TokenID: big.NewInt(0), Price: big.NewInt(1e18)},
+		{TokenID: big.NewInt(2), Price: big.NewInt(5e17)},
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, payouts)
+
+	t.Run("OK - Default and token royalties", func(t *testing.T) {
+		backend, royInteractions := setupRoyalties(t)
+		defer backend.Close()
+
+		payouts, err := royInteractions.AggregatePayouts([]royalties.Sale{
+			{TokenID: big.NewInt(10), Price: big.NewInt(1e18)},
+			{TokenID: big.NewInt(20), Price: big.NewInt(1e18)},
+			{TokenID: big.NewInt(40), Price: big.NewInt(2e18)},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, map[common.Address]*big.Int{
+			defaultReceiver:  big.NewInt(1e17),
+			overrideReceiver: big.NewInt(1e17),
+		}, payouts)
+	})
+}
@@ -0,0 +1,137 @@
+package nft
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrNotReceived is returned when the recipient of a mined transfer does not own the token or no Transfer event credited it.
+var ErrNotReceived = errors.New("transfer not received")
+
+// MaxConsecutiveTransfer is the largest number of tokens an ERC2309 ConsecutiveTransfer event may describe, per the standard.
+const MaxConsecutiveTransfer = 5000
+
+// TransferEvent is a Transfer event emitted by the collection in a transaction.
+type TransferEvent struct {
+	From     common.Address
+	To       common.Address
+	TokenID  *big.Int
+	LogIndex uint
+}
+
+// TransferResult is a mined token transfer, along with the ownership it resulted in.
+type TransferResult struct {
+	Tx      *types.Transaction
+	Receipt *types.Receipt
+	TokenID *big.Int
+	// From is the owner of the token before the transaction, Owner the one after it.
+	From  common.Address
+	To    common.Address
+	Owner common.Address
+	// Received is the balance change of the recipient, other transfers of the block included.
+	Received *big.Int
+	// GasCost is the ether paid for the gas of the transaction.
+	GasCost *big.Int
+	// Events holds the Transfer events emitted by the collection in the transaction.
+	Events []TransferEvent
+	// Link is the explorer link of the transaction, empty when no explorer is set.
+	Link string
+}
+
+// TransferConfirmed transfers a token to another address and waits for the transfer to be confirmed. See ConfirmTransfer.
+func (d *ERC721Interactions) TransferConfirmed(to common.Address, tokenID *big.Int) (*TransferResult, error) {
+	tx, err := d.TransferTo(to, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	return d.ConfirmTransfer(tx, to, tokenID)
+}
+
+// ConfirmTransfer waits for a transfer of the token to be mined, decodes its Transfer events and checks the token is owned by the recipient.
+// ErrNotReceived is returned along with the result when the recipient does not own the token or no event credited it.
+func (d *ERC721Interactions) ConfirmTransfer(tx *types.Transaction, to common.Address, tokenID *big.Int) (*TransferResult, error) {
+	receipt, err := d.WaitMined(tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for tx %s: %w", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("transfer reverted in tx %s", tx.Hash().Hex())
+	}
+
+	before := &bind.CallOpts{BlockNumber: new(big.Int).Sub(receipt.BlockNumber, common.Big1), Context: d.Ctx}
+	after := &bind.CallOpts{BlockNumber: receipt.BlockNumber, Context: d.Ctx}
+	from, err := d.ownerAt(before, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := d.ownerAt(after, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	balanceBefore, err := d.balanceAt(before, to)
+	if err != nil {
+		return nil, err
+	}
+	balanceAfter, err := d.balanceAt(after, to)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TransferResult{
+		Tx:       tx,
+		Receipt:  receipt,
+		TokenID:  tokenID,
+		From:     from,
+		To:       to,
+		Owner:    owner,
+		Received: new(big.Int).Sub(balanceAfter, balanceBefore),
+		GasCost:  new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice),
+		Link:     d.Explorer().TxURL(tx.Hash()),
+	}
+
+	credited := false
+	for _, log := range receipt.Logs {
+		// ERC20 Transfer events share the topic of ERC721 ones but do not index the value.
+		if log.Address != d.nftAddress || len(log.Topics) != 4 {
+			continue
+		}
+		event, err := d.erc721Session.Contract.ParseTransfer(*log)
+		if err != nil {
+			continue
+		}
+		result.Events = append(result.Events, TransferEvent{event.From, event.To, event.TokenId, log.Index})
+		credited = credited || (event.To == to && event.TokenId.Cmp(tokenID) == 0)
+	}
+	if !credited || owner != to {
+		return result, fmt.Errorf("%w: token %s is owned by %s in tx %s", ErrNotReceived, tokenID, owner.Hex(), tx.Hash().Hex())
+	}
+	return result, nil
+}
+
+// ownerAt returns the owner of a token at the block of opts.
+func (d *ERC721Interactions) ownerAt(opts *bind.CallOpts, tokenID *big.Int) (common.Address, error) {
+	owner, err := base.Observe(d.BaseInteractions, base.ReadOperation, "nft.OwnerOf()", func() (common.Address, error) {
+		return d.erc721Session.Contract.OwnerOf(opts, tokenID)
+	})
+	if err != nil {
+		return common.Address{}, d.callError("nft.OwnerOf()", err)
+	}
+	return owner, nil
+}
+
+// balanceAt returns the balance of owner at the block of opts.
+func (d *ERC721Interactions) balanceAt(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	balance, err := base.Observe(d.BaseInteractions, base.ReadOperation, "nft.BalanceOf()", func() (*big.Int, error) {
+		return d.erc721Session.Contract.BalanceOf(opts, owner)
+	})
+	if err != nil {
+		return nil, d.callError("nft.BalanceOf()", err)
+	}
+	return balance, nil
+}
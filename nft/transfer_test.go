@@ -0,0 +1,54 @@
+package nft_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/testkit"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_TransferConfirmed verifies the ownership and events reported for confirmed token transfers.
+func Test_TransferConfirmed(t *testing.T) {
+	kit := testkit.New(t).WithAccounts(3).WithERC721("Collection", "COL").Build()
+	owner, recipient, other := kit.Accounts[0], kit.Accounts[1], kit.Accounts[2]
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+				kit.Commit()
+			}
+		}
+	}()
+
+	t.Run("OK - Confirmed transfer", func(t *testing.T) {
+		result, err := owner.ERC721.TransferConfirmed(recipient.Address, big.NewInt(1))
+		assert.Nil(t, err)
+		assert.Equal(t, owner.Address, result.From)
+		assert.Equal(t, recipient.Address, result.Owner)
+		assert.Equal(t, "1", result.Received.String())
+		assert.Equal(t, 1, result.GasCost.Sign())
+		assert.Equal(t, []nft.TransferEvent{{From: owner.Address, To: recipient.Address, TokenID: big.NewInt(1), LogIndex: result.Events[0].LogIndex}}, result.Events)
+	})
+
+	t.Run("KO - Other recipient", func(t *testing.T) {
+		tx, err := owner.ERC721.TransferTo(recipient.Address, big.NewInt(2))
+		assert.Nil(t, err)
+		result, err := owner.ERC721.ConfirmTransfer(tx, other.Address, big.NewInt(2))
+		assert.ErrorIs(t, err, nft.ErrNotReceived)
+		assert.Equal(t, recipient.Address, result.Owner)
+		assert.Equal(t, "0", result.Received.String())
+	})
+
+	t.Run("KO - Not owned", func(t *testing.T) {
+		_, err := other.ERC721.TransferConfirmed(recipient.Address, big.NewInt(3))
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,40 @@
+package observer
+
+// Package observer provides base.Observer implementations reporting calls to log/slog, Prometheus and OpenTelemetry.
+
+import (
+	"context"
+
+	"github.com/OCharless/eth-interfaces/base"
+)
+
+// MultiObserver forwards every notification to several observers.
+type MultiObserver []base.Observer
+
+// Multi combines observers, notifying them in order.
+func Multi(observers ...base.Observer) MultiObserver {
+	return MultiObserver(observers)
+}
+
+// Before notifies every observer, threading the context through them.
+func (m MultiObserver) Before(ctx context.Context, op base.Operation) context.Context {
+	for _, o := range m {
+		ctx = o.Before(ctx, op)
+	}
+	return ctx
+}
+
+// After notifies every observer in reverse order, so that nested spans are closed last in, first out.
+func (m MultiObserver) After(ctx context.Context, op base.Operation, result base.OperationResult) {
+	for idx := len(m) - 1; idx >= 0; idx-- {
+		m[idx].After(ctx, op, result)
+	}
+}
+
+// status labels the outcome of an operation.
+func status(result base.OperationResult) string {
+	if result.Err != nil {
+		return "error"
+	}
+	return "ok"
+}
@@ -0,0 +1,123 @@
+package observer_test
+
+// Package observer_test contains tests for the slog, Prometheus and OpenTelemetry observers.
+
+import (
+	"bytes"
+	"log/slog"
+	"math/big"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/OCharless/eth-interfaces/observer"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// counterValue returns the value of the counter of the given family matching all the labels.
+func counterValue(t *testing.T, registry *prometheus.Registry, name string, labels map[string]string) float64 {
+	families, err := registry.Gather()
+	assert.Nil(t, err)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			matches := 0
+			for _, label := range metric.GetLabel() {
+				if labels[label.GetName()] == label.GetValue() {
+					matches++
+				}
+			}
+			if matches == len(labels) {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+// Test_Observers verifies that reads, sends and failures are reported to every adapter with the CallError field as method.
+func Test_Observers(t *testing.T) {
+	backend, auth, contractAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC20Burnable.ERC20BurnableABI,
+		ERC20Burnable.ERC20BurnableBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	logs := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	registry := prometheus.NewRegistry()
+	prometheusObserver, err := observer.NewPrometheusObserver(registry, "eth")
+	assert.Nil(t, err)
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	baseInteractions.SetObserver(observer.Multi(
+		observer.NewSlogObserver(logger),
+		prometheusObserver,
+		observer.NewOTelObserver(tracer),
+	))
+	token, err := erc20.NewIERC20Interactions(baseInteractions, *contractAddr, []erc20.BaseERC20Signature{}, auth)
+	assert.Nil(t, err)
+
+	_, err = token.BalanceOf(auth.From)
+	assert.Nil(t, err)
+	tx, err := token.TransferTo(common.HexToAddress("0x000000000000000000000000000000000000beef"), big.NewInt(1))
+	assert.Nil(t, err)
+	backend.Commit()
+	_, err = token.TransferTo(common.Address{}, big.NewInt(1))
+	assert.Error(t, err)
+	_, err = baseInteractions.BaseTxSetup()
+	assert.Nil(t, err)
+	sent, err := baseInteractions.SendAllFunds(common.HexToAddress("0x000000000000000000000000000000000000beef"))
+	assert.Nil(t, err)
+	backend.Commit()
+	_, err = baseInteractions.CatchTx(sent, nil)
+	assert.Nil(t, err)
+
+	t.Run("OK - slog", func(t *testing.T) {
+		assert.Contains(t, logs.String(), "method=erc20.BalanceOf()")
+		assert.Contains(t, logs.String(), "level=ERROR msg=\"eth call failed\" kind=simulation method=erc20.Transfer()")
+	})
+
+	t.Run("OK - Prometheus", func(t *testing.T) {
+		assert.Equal(t, 1.0, counterValue(t, registry, "eth_calls_total", map[string]string{"kind": "read", "method": "erc20.BalanceOf()", "status": "ok"}))
+		assert.Equal(t, 1.0, counterValue(t, registry, "eth_calls_total", map[string]string{"kind": "send", "method": "erc20.Transfer()", "status": "ok"}))
+		// The failing transfer is stopped by its simulation, before being sent.
+		assert.Equal(t, 1.0, counterValue(t, registry, "eth_calls_total", map[string]string{"kind": "simulation", "method": "erc20.Transfer()", "status": "ok"}))
+		assert.Equal(t, 1.0, counterValue(t, registry, "eth_calls_total", map[string]string{"kind": "simulation", "method": "erc20.Transfer()", "status": "error"}))
+		assert.Equal(t, 0.0, counterValue(t, registry, "eth_calls_total", map[string]string{"kind": "send", "method": "erc20.Transfer()", "status": "error"}))
+		assert.Equal(t, float64(tx.Gas()), counterValue(t, registry, "eth_gas_total", map[string]string{"method": "erc20.Transfer()"}))
+		for method, count := range map[string]float64{
+			"base.SuggestGasPrice()": 2, "base.PendingNonceAt()": 2, "base.ChainID()": 2, "base.BalanceAt()": 1, "base.WaitMined()": 1,
+		} {
+			assert.Equal(t, count, counterValue(t, registry, "eth_calls_total", map[string]string{"kind": "read", "method": method, "status": "ok"}), method)
+		}
+		assert.Equal(t, 1.0, counterValue(t, registry, "eth_calls_total", map[string]string{"kind": "simulation", "method": "base.EstimateGas()", "status": "ok"}))
+	})
+
+	t.Run("OK - OpenTelemetry", func(t *testing.T) {
+		spans := recorder.Ended()
+		names := []string{}
+		var transfer sdktrace.ReadOnlySpan
+		for _, span := range spans {
+			names = append(names, span.Name())
+			if span.Name() == "erc20.Transfer()" {
+				transfer = span
+			}
+		}
+		assert.Contains(t, names, "erc20.BalanceOf()")
+		assert.Contains(t, names, "base.WaitMined()")
+		assert.Equal(t, codes.Error, transfer.Status().Code)
+	})
+}
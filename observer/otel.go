@@ -0,0 +1,42 @@
+package observer
+
+import (
+	"context"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OTelObserver traces every operation as an OpenTelemetry span named after its method.
+type OTelObserver struct {
+	tracer trace.Tracer
+}
+
+// NewOTelObserver creates a new OTelObserver starting spans with the given tracer.
+func NewOTelObserver(tracer trace.Tracer) *OTelObserver {
+	return &OTelObserver{tracer}
+}
+
+// Before starts the span of the operation.
+func (o *OTelObserver) Before(ctx context.Context, op base.Operation) context.Context {
+	ctx, _ = o.tracer.Start(ctx, op.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("eth.operation", string(op.Kind))),
+	)
+	return ctx
+}
+
+// After ends the span of the operation, recording its gas and error.
+func (o *OTelObserver) After(ctx context.Context, op base.Operation, result base.OperationResult) {
+	span := trace.SpanFromContext(ctx)
+	if result.Gas > 0 {
+		span.SetAttributes(attribute.Int64("eth.gas", int64(result.Gas)))
+	}
+	if result.Err != nil {
+		span.RecordError(result.Err)
+		span.SetStatus(codes.Error, result.Err.Error())
+	}
+	span.End()
+}
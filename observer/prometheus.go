@@ -0,0 +1,57 @@
+package observer
+
+import (
+	"context"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrometheusObserver records the count, latency and gas of operations as Prometheus metrics labelled by kind and method.
+type PrometheusObserver struct {
+	calls    *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	gas      *prometheus.CounterVec
+}
+
+// NewPrometheusObserver creates the metrics under the given namespace and registers them.
+func NewPrometheusObserver(registerer prometheus.Registerer, namespace string) (*PrometheusObserver, error) {
+	p := &PrometheusObserver{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "calls_total",
+			Help:      "Number of chain operations, by kind, method and status.",
+		}, []string{"kind", "method", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "call_duration_seconds",
+			Help:      "Duration of chain operations, by kind and method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"kind", "method"}),
+		gas: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "gas_total",
+			Help:      "Gas limit of the transactions sent, by method.",
+		}, []string{"method"}),
+	}
+	for _, collector := range []prometheus.Collector{p.calls, p.duration, p.gas} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Before does nothing, metrics are recorded once operations complete.
+func (p *PrometheusObserver) Before(ctx context.Context, op base.Operation) context.Context {
+	return ctx
+}
+
+// After records the operation.
+func (p *PrometheusObserver) After(ctx context.Context, op base.Operation, result base.OperationResult) {
+	p.calls.WithLabelValues(string(op.Kind), op.Method, status(result)).Inc()
+	p.duration.WithLabelValues(string(op.Kind), op.Method).Observe(result.Duration.Seconds())
+	if result.Gas > 0 {
+		p.gas.WithLabelValues(op.Method).Add(float64(result.Gas))
+	}
+}
@@ -0,0 +1,43 @@
+package observer
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/OCharless/eth-interfaces/base"
+)
+
+// SlogObserver logs every operation with log/slog: failures at error level, successes at debug level.
+type SlogObserver struct {
+	logger *slog.Logger
+}
+
+// NewSlogObserver creates a new SlogObserver, using slog.Default() when logger is nil.
+func NewSlogObserver(logger *slog.Logger) *SlogObserver {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SlogObserver{logger}
+}
+
+// Before does nothing, operations are logged once they complete.
+func (s *SlogObserver) Before(ctx context.Context, op base.Operation) context.Context {
+	return ctx
+}
+
+// After logs the operation with its kind, method, duration, gas and error.
+func (s *SlogObserver) After(ctx context.Context, op base.Operation, result base.OperationResult) {
+	attrs := []slog.Attr{
+		slog.String("kind", string(op.Kind)),
+		slog.String("method", op.Method),
+		slog.Duration("duration", result.Duration),
+	}
+	if result.Gas > 0 {
+		attrs = append(attrs, slog.Uint64("gas", result.Gas))
+	}
+	if result.Err != nil {
+		s.logger.LogAttrs(ctx, slog.LevelError, "eth call failed", append(attrs, slog.String("error", result.Err.Error()))...)
+		return
+	}
+	s.logger.LogAttrs(ctx, slog.LevelDebug, "eth call", attrs...)
+}
@@ -0,0 +1,61 @@
+package portfolio
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CSVHeader lists the columns written by WriteCSV.
+var CSVHeader = []string{"holder", "standard", "token", "symbol", "token_id", "token_ids", "decimals", "balance", "amount", "error"}
+
+// WriteJSON writes the snapshot as indented JSON.
+func (s *Snapshot) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s)
+}
+
+// WriteCSV writes one row per entry, preceded by CSVHeader. Owned ERC721 ids are joined with semicolons.
+func (s *Snapshot) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(CSVHeader); err != nil {
+		return err
+	}
+	for _, entry := range s.Entries {
+		token, tokenID, balance := "", "", ""
+		if entry.Standard != ETH {
+			token = entry.Token.Hex()
+		}
+		if entry.TokenID != nil {
+			tokenID = entry.TokenID.String()
+		}
+		if entry.Balance != nil {
+			balance = entry.Balance.String()
+		}
+		tokenIDs := make([]string, len(entry.TokenIDs))
+		for i, id := range entry.TokenIDs {
+			tokenIDs[i] = id.String()
+		}
+
+		err := writer.Write([]string{
+			entry.Holder.Hex(),
+			string(entry.Standard),
+			token,
+			entry.Symbol,
+			tokenID,
+			strings.Join(tokenIDs, ";"),
+			strconv.Itoa(int(entry.Decimals)),
+			balance,
+			entry.Amount,
+			entry.Error,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,329 @@
+package portfolio
+
+// Package portfolio builds snapshots of the ETH, ERC20, ERC721 and ERC1155 holdings of many wallets.
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/basetokens"
+	"github.com/OCharless/eth-interfaces/erc1155"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/ERC1155Complete"
+	"github.com/OCharless/eth-interfaces/inferences/ERC721Complete"
+	"github.com/OCharless/eth-interfaces/inferences/IERC20"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/probe"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ETH is the standard reported for native ether holdings.
+const ETH basetokens.Standard = "ETH"
+
+// Token describes a token contract to include in a snapshot. IDs lists the ERC1155 token ids to query and is ignored for other standards.
+type Token struct {
+	Address common.Address
+	IDs     []*big.Int
+}
+
+// Config configures how a snapshot is fetched.
+type Config struct {
+	// Concurrency bounds the number of calls, or batches of calls, in flight. Zero means one at a time.
+	Concurrency int
+	// BatchSize is the number of balance reads sent per JSON-RPC batch when the client is backed by one,
+	// such as ethclient.Client. One or less, or a client without JSON-RPC connection such as MultiClient, sends each read on its own.
+	BatchSize int
+	// BlockNumber pins the snapshot to a past block, nil snapshots the latest block.
+	BlockNumber *big.Int
+	// SkipETH leaves the native ether balances out of the snapshot.
+	SkipETH bool
+}
+
+// DefaultConfig fetches the latest block in batches of 100 reads, up to 8 batches at a time.
+var DefaultConfig = Config{Concurrency: 8, BatchSize: 100}
+
+// Entry is the holding of a wallet for a token, or for a single id of an ERC1155 token.
+// Token is the zero address for ETH. TokenIDs lists the owned ids of enumerable ERC721 collections.
+type Entry struct {
+	Holder   common.Address      `json:"holder"`
+	Standard basetokens.Standard `json:"standard"`
+	Token    common.Address      `json:"token"`
+	Symbol   string              `json:"symbol,omitempty"`
+	TokenID  *big.Int            `json:"tokenId,omitempty"`
+	TokenIDs []*big.Int          `json:"tokenIds,omitempty"`
+	Decimals uint8               `json:"decimals"`
+	Balance  *big.Int            `json:"balance"`
+	Amount   string              `json:"amount"`
+	Error    string              `json:"error,omitempty"`
+}
+
+// Snapshot is the result of Portfolio.Snapshot. Entries are ordered by holder, then by token in the order they were given.
+type Snapshot struct {
+	BlockNumber uint64  `json:"blockNumber"`
+	Entries     []Entry `json:"entries"`
+}
+
+// Portfolio fetches holdings through a base interaction.
+type Portfolio struct {
+	*base.BaseInteractions
+	config Config
+}
+
+// NewPortfolio creates a new Portfolio.
+func NewPortfolio(baseInteractions *base.BaseInteractions, config Config) *Portfolio {
+	return &Portfolio{baseInteractions, config}
+}
+
+var (
+	erc20ABI, _   = IERC20.IERC20MetaData.GetAbi()
+	erc721ABI, _  = ERC721Complete.ERC721CompleteMetaData.GetAbi()
+	erc1155ABI, _ = ERC1155Complete.ERC1155CompleteMetaData.GetAbi()
+)
+
+// tokenReader prepares the reads of the balances of a token.
+type tokenReader struct {
+	token      common.Address
+	standard   basetokens.Standard
+	symbol     string
+	decimals   uint8
+	ids        []*big.Int
+	enumerable bool
+	// reads returns the reads filling the entries of the token, rows[h] holding the entries of holders[h].
+	reads func(holders []common.Address, rows [][]Entry) []read
+}
+
+// Snapshot fetches the holdings of every holder for every token. Tokens are probed to find their standard.
+// Every balance is read at the block of the snapshot, in JSON-RPC batches when the client supports them. The ids owned
+// in enumerable ERC721 collections are read in a second round, once the balances are known.
+// A failure to read a single balance is reported in the Error field of its entry, failing to set up a token fails the snapshot.
+func (p *Portfolio) Snapshot(holders []common.Address, tokens []Token) (*Snapshot, error) {
+	block := p.config.BlockNumber
+	if block == nil {
+		head, err := base.Observe(p.BaseInteractions, base.ReadOperation, "portfolio.BlockNumber()", func() (uint64, error) {
+			return p.Client.BlockNumber(p.Ctx)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get block number: %w", err)
+		}
+		block = new(big.Int).SetUint64(head)
+	}
+
+	readers := []*tokenReader{}
+	for _, token := range tokens {
+		reader, err := p.newTokenReader(token)
+		if err != nil {
+			return nil, err
+		}
+		readers = append(readers, reader)
+	}
+
+	// entries[holder][column] where the columns are ETH followed by each token, ERC1155 tokens taking one column per id.
+	entries := make([][][]Entry, len(holders))
+	for h, holder := range holders {
+		entries[h] = make([][]Entry, len(readers)+1)
+		if !p.config.SkipETH {
+			entries[h][0] = []Entry{{Holder: holder, Standard: ETH, Decimals: 18}}
+		}
+		for r, reader := range readers {
+			count := 1
+			if reader.standard == basetokens.ERC1155 {
+				count = len(reader.ids)
+			}
+			entries[h][r+1] = make([]Entry, count)
+			for i := range entries[h][r+1] {
+				entries[h][r+1][i] = Entry{Holder: holder, Standard: reader.standard, Token: reader.token, Symbol: reader.symbol, Decimals: reader.decimals}
+				if reader.standard == basetokens.ERC1155 {
+					entries[h][r+1][i].TokenID = reader.ids[i]
+				}
+			}
+		}
+	}
+
+	reads := []read{}
+	for h, holder := range holders {
+		if !p.config.SkipETH {
+			entry := &entries[h][0][0]
+			reads = append(reads, read{holder: holder, done: func(result []byte, err error) {
+				balance, err := word(result, err)
+				setBalance(entry, balance, err)
+			}})
+		}
+	}
+	for r, reader := range readers {
+		rows := make([][]Entry, len(holders))
+		for h := range holders {
+			rows[h] = entries[h][r+1]
+		}
+		reads = append(reads, reader.reads(holders, rows)...)
+	}
+	p.execute(reads, block)
+
+	reads, merges := []read{}, []func(){}
+	for r, reader := range readers {
+		if !reader.enumerable {
+			continue
+		}
+		for h, holder := range holders {
+			idReads, merge := ownedTokenReads(reader.token, holder, &entries[h][r+1][0])
+			reads = append(reads, idReads...)
+			merges = append(merges, merge)
+		}
+	}
+	p.execute(reads, block)
+	for _, merge := range merges {
+		merge()
+	}
+
+	snapshot := &Snapshot{BlockNumber: block.Uint64(), Entries: []Entry{}}
+	for h := range holders {
+		for _, column := range entries[h] {
+			snapshot.Entries = append(snapshot.Entries, column...)
+		}
+	}
+	return snapshot, nil
+}
+
+// newTokenReader probes a token and prepares the reads of its balances.
+func (p *Portfolio) newTokenReader(token Token) (*tokenReader, error) {
+	capabilities, err := probe.Probe(p.BaseInteractions, token.Address)
+	if err != nil {
+		return nil, err
+	}
+	asset, err := basetokens.NewAssetFromCapabilities(p.BaseInteractions, capabilities)
+	if err != nil {
+		return nil, err
+	}
+
+	address := token.Address
+	reader := &tokenReader{token: address, standard: basetokens.StandardOf(asset)}
+	switch interactions := asset.(type) {
+	case *erc20.ERC20Interactions:
+		reader.symbol, _ = interactions.Symbol()
+		reader.decimals, err = interactions.Decimals()
+		if err != nil {
+			return nil, err
+		}
+		reader.reads = balanceReads(address, erc20ABI)
+
+	case *nft.ERC721Interactions:
+		reader.symbol, _ = interactions.Symbol()
+		reader.enumerable = capabilities.Enumerable
+		reader.reads = balanceReads(address, erc721ABI)
+
+	case *erc1155.ERC1155Interactions:
+		reader.ids = token.IDs
+		reader.reads = func(holders []common.Address, rows [][]Entry) []read {
+			owners, ids := []common.Address{}, []*big.Int{}
+			for _, holder := range holders {
+				for _, id := range token.IDs {
+					owners = append(owners, holder)
+					ids = append(ids, id)
+				}
+			}
+			if len(owners) == 0 {
+				return nil
+			}
+			// fill sets the balances of every holder and id, or the error on all of them.
+			fill := func(balances []*big.Int, err error) {
+				for h := range holders {
+					for i := range token.IDs {
+						if err != nil {
+							setBalance(&rows[h][i], nil, err)
+							continue
+						}
+						setBalance(&rows[h][i], balances[h*len(token.IDs)+i], nil)
+					}
+				}
+			}
+			data, err := erc1155ABI.Pack("balanceOfBatch", owners, ids)
+			if err != nil {
+				fill(nil, err)
+				return nil
+			}
+			return []read{{to: &address, data: data, done: func(result []byte, err error) {
+				var balances []*big.Int
+				if err == nil {
+					err = erc1155ABI.UnpackIntoInterface(&balances, "balanceOfBatch", result)
+				}
+				if err == nil && len(balances) != len(owners) {
+					err = fmt.Errorf("balanceOfBatch returned %d balances for %d queries", len(balances), len(owners))
+				}
+				fill(balances, err)
+			}}}
+		}
+	}
+	return reader, nil
+}
+
+// balanceReads returns the reads of the balanceOf(holder) of a token, one per holder.
+func balanceReads(token common.Address, parsed *abi.ABI) func(holders []common.Address, rows [][]Entry) []read {
+	return func(holders []common.Address, rows [][]Entry) []read {
+		reads := []read{}
+		for h, holder := range holders {
+			entry := &rows[h][0]
+			data, err := parsed.Pack("balanceOf", holder)
+			if err != nil {
+				setBalance(entry, nil, err)
+				continue
+			}
+			reads = append(reads, read{to: &token, data: data, done: func(result []byte, err error) {
+				balance, err := word(result, err)
+				setBalance(entry, balance, err)
+			}})
+		}
+		return reads
+	}
+}
+
+// ownedTokenReads returns the tokenOfOwnerByIndex reads listing the ids owned by a holder whose balance is in entry,
+// and the function setting them on the entry once they are read.
+func ownedTokenReads(token, holder common.Address, entry *Entry) ([]read, func()) {
+	if entry.Error != "" || entry.Balance == nil || !entry.Balance.IsInt64() {
+		return nil, func() {}
+	}
+	tokenIDs := make([]*big.Int, entry.Balance.Int64())
+	errs := make([]error, len(tokenIDs))
+	reads := []read{}
+	for i := range tokenIDs {
+		data, err := erc721ABI.Pack("tokenOfOwnerByIndex", holder, big.NewInt(int64(i)))
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		reads = append(reads, read{to: &token, data: data, done: func(result []byte, err error) {
+			tokenIDs[i], errs[i] = word(result, err)
+		}})
+	}
+	return reads, func() {
+		if err := errors.Join(errs...); err != nil {
+			entry.Error = err.Error()
+			return
+		}
+		entry.TokenIDs = tokenIDs
+	}
+}
+
+// word decodes the uint256 returned by a read.
+func word(result []byte, err error) (*big.Int, error) {
+	if err != nil {
+		return nil, err
+	}
+	if len(result) < 32 {
+		return nil, fmt.Errorf("unexpected result 0x%x", result)
+	}
+	return new(big.Int).SetBytes(result[:32]), nil
+}
+
+// setBalance fills the balance and the normalized amount of an entry, or its error.
+func setBalance(entry *Entry, balance *big.Int, err error) {
+	if err != nil {
+		entry.Error = err.Error()
+		return
+	}
+	entry.Balance = balance
+	entry.Amount = utils.FormatUnits(balance, entry.Decimals)
+}
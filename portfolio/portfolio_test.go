@@ -0,0 +1,206 @@
+package portfolio_test
+
+// Package portfolio_test contains tests for the holdings snapshots and their exports.
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/basetokens"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/ERC1155Complete"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/OCharless/eth-interfaces/inferences/ERC721Complete"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/portfolio"
+	"github.com/OCharless/eth-interfaces/testkit"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Snapshot verifies the holdings reported for two wallets across every supported standard.
+func Test_Snapshot(t *testing.T) {
+	backend, auth, erc20Addr, privKey, err := utils.SetupBlockchain(t,
+		ERC20Burnable.ERC20BurnableABI,
+		ERC20Burnable.ERC20BurnableBin,
+	)
+	assert.Nil(t, err)
+	defer backend.Close()
+
+	erc721Addr, _, _, err := utils.DeployContract(auth, backend.Client(), ERC721Complete.ERC721CompleteABI, ERC721Complete.ERC721CompleteBin, "MyNFT", "MNFT")
+	assert.Nil(t, err)
+	erc1155Addr, _, erc1155Contract, err := utils.DeployContract(auth, backend.Client(), ERC1155Complete.ERC1155CompleteABI, ERC1155Complete.ERC1155CompleteBin)
+	assert.Nil(t, err)
+	backend.Commit()
+
+	recipient := common.HexToAddress("0x000000000000000000000000000000000000beef")
+	_, err = erc1155Contract.Transact(auth, "mint", recipient, big.NewInt(7), big.NewInt(3))
+	assert.Nil(t, err)
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	token, err := erc20.NewIERC20Interactions(baseInteractions, *erc20Addr, []erc20.BaseERC20Signature{}, auth)
+	assert.Nil(t, err)
+	_, err = token.TransferTo(recipient, big.NewInt(1_500_000_000_000_000_000))
+	assert.Nil(t, err)
+	collection, err := nft.NewERC721Interactions(baseInteractions, erc721Addr, []nft.BaseNFTSignature{}, auth)
+	assert.Nil(t, err)
+	_, err = collection.TransferTo(recipient, big.NewInt(4))
+	assert.Nil(t, err)
+	backend.Commit()
+	_, err = baseInteractions.TransferETH(recipient, big.NewInt(250_000_000_000_000_000))
+	assert.Nil(t, err)
+	backend.Commit()
+
+	tokens := []portfolio.Token{
+		{Address: *erc20Addr},
+		{Address: erc721Addr},
+		{Address: erc1155Addr, IDs: []*big.Int{big.NewInt(7), big.NewInt(8)}},
+	}
+	snapshot, err := portfolio.NewPortfolio(baseInteractions, portfolio.DefaultConfig).Snapshot([]common.Address{auth.From, recipient}, tokens)
+	assert.Nil(t, err)
+	// ETH, ERC20, ERC721 and two ERC1155 ids for each holder.
+	assert.Len(t, snapshot.Entries, 10)
+	for _, entry := range snapshot.Entries {
+		assert.Empty(t, entry.Error)
+	}
+
+	t.Run("OK - Holder entries", func(t *testing.T) {
+		entries := snapshot.Entries[5:]
+		assert.Equal(t, recipient, entries[0].Holder)
+
+		assert.Equal(t, portfolio.ETH, entries[0].Standard)
+		assert.Equal(t, "0.25", entries[0].Amount)
+
+		assert.Equal(t, basetokens.ERC20, entries[1].Standard)
+		assert.Equal(t, "TT", entries[1].Symbol)
+		assert.Equal(t, uint8(18), entries[1].Decimals)
+		assert.Equal(t, "1.5", entries[1].Amount)
+
+		assert.Equal(t, basetokens.ERC721, entries[2].Standard)
+		assert.Equal(t, "1", entries[2].Amount)
+		assert.Equal(t, []*big.Int{big.NewInt(4)}, entries[2].TokenIDs)
+
+		assert.Equal(t, basetokens.ERC1155, entries[3].Standard)
+		assert.Equal(t, big.NewInt(7), entries[3].TokenID)
+		assert.Equal(t, "3", entries[3].Amount)
+		assert.Equal(t, "0", entries[4].Amount)
+	})
+
+	t.Run("OK - Deployer NFTs", func(t *testing.T) {
+		assert.Equal(t, "29", snapshot.Entries[2].Amount)
+		assert.Len(t, snapshot.Entries[2].TokenIDs, 29)
+		assert.NotContains(t, snapshot.Entries[2].TokenIDs, big.NewInt(4))
+	})
+
+	t.Run("OK - JSON", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		assert.Nil(t, snapshot.WriteJSON(buffer))
+		decoded := &portfolio.Snapshot{}
+		assert.Nil(t, json.Unmarshal(buffer.Bytes(), decoded))
+		assert.Equal(t, snapshot.BlockNumber, decoded.BlockNumber)
+		assert.Equal(t, snapshot.Entries[6].Amount, decoded.Entries[6].Amount)
+		assert.Equal(t, snapshot.Entries[7].TokenIDs, decoded.Entries[7].TokenIDs)
+	})
+
+	t.Run("OK - CSV", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		assert.Nil(t, snapshot.WriteCSV(buffer))
+		rows, err := csv.NewReader(buffer).ReadAll()
+		assert.Nil(t, err)
+		assert.Len(t, rows, 11)
+		assert.Equal(t, portfolio.CSVHeader, rows[0])
+		assert.Equal(t, []string{recipient.Hex(), "ERC721", erc721Addr.Hex(), "MNFT", "", "4", "0", "1", "1", ""}, rows[8])
+	})
+
+	t.Run("OK - Pinned block", func(t *testing.T) {
+		// The block of the deployments, before the transfers to the recipient.
+		config := portfolio.DefaultConfig
+		config.BlockNumber = new(big.Int).SetUint64(snapshot.BlockNumber - 2)
+		pinned, err := portfolio.NewPortfolio(baseInteractions, config).Snapshot([]common.Address{auth.From, recipient}, tokens)
+		assert.Nil(t, err)
+		assert.Equal(t, snapshot.BlockNumber-2, pinned.BlockNumber)
+		assert.Equal(t, "30", pinned.Entries[2].Amount)
+		assert.Len(t, pinned.Entries[2].TokenIDs, 30)
+		for _, entry := range pinned.Entries[5:] {
+			assert.Empty(t, entry.Error)
+			assert.Equal(t, "0", entry.Amount)
+		}
+	})
+
+	t.Run("OK - Single reads", func(t *testing.T) {
+		config := portfolio.DefaultConfig
+		config.BatchSize = 1
+		config.BlockNumber = new(big.Int).SetUint64(snapshot.BlockNumber)
+		single, err := portfolio.NewPortfolio(baseInteractions, config).Snapshot([]common.Address{auth.From, recipient}, tokens)
+		assert.Nil(t, err)
+		assert.Equal(t, snapshot, single)
+	})
+
+	t.Run("KO - Not a token", func(t *testing.T) {
+		_, err := portfolio.NewPortfolio(baseInteractions, portfolio.DefaultConfig).Snapshot(
+			[]common.Address{auth.From},
+			[]portfolio.Token{{Address: recipient}},
+		)
+		assert.Error(t, err)
+	})
+}
+
+// Test_Batches verifies that the reads go through JSON-RPC batches when the client has a JSON-RPC connection,
+// and that they report the same holdings as single reads.
+func Test_Batches(t *testing.T) {
+	kit := testkit.New(t).WithAccounts(3).WithERC20Balance(big.NewInt(1000)).WithERC721Tokens(2).Build()
+	holders := []common.Address{}
+	for _, account := range kit.Accounts {
+		holders = append(holders, account.Address)
+	}
+	tokens := []portfolio.Token{{Address: kit.ERC20}, {Address: kit.ERC721}}
+
+	calls := &callCounter{counts: map[string]int{}}
+	rpcInteractions := base.NewBaseInteractions(ethclient.NewClient(kit.RPC()), kit.Accounts[0].Key, nil)
+	rpcInteractions.SetObserver(calls)
+	config := portfolio.DefaultConfig
+	config.BatchSize = 4
+	batched, err := portfolio.NewPortfolio(rpcInteractions, config).Snapshot(holders, tokens)
+	assert.Nil(t, err)
+	assert.Positive(t, calls.count("portfolio.Batch()"))
+	assert.Zero(t, calls.count("portfolio.BalanceAt()"))
+	assert.Zero(t, calls.count("portfolio.CallContract()"))
+
+	// The simulated client has no JSON-RPC connection, its reads are sent on their own.
+	single, err := portfolio.NewPortfolio(kit.Accounts[0].Base, portfolio.DefaultConfig).Snapshot(holders, tokens)
+	assert.Nil(t, err)
+	assert.Equal(t, single, batched)
+	// ETH, ERC20 and ERC721 for each holder, the third one owning tokens 2 and 3.
+	assert.Len(t, batched.Entries, 9)
+	assert.Equal(t, []*big.Int{big.NewInt(2), big.NewInt(3)}, batched.Entries[8].TokenIDs)
+}
+
+// callCounter counts the observed calls per method.
+type callCounter struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func (c *callCounter) Before(ctx context.Context, op base.Operation) context.Context {
+	return ctx
+}
+
+func (c *callCounter) After(ctx context.Context, op base.Operation, result base.OperationResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counts[op.Method]++
+}
+
+func (c *callCounter) count(method string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.counts[method]
+}
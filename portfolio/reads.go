@@ -0,0 +1,98 @@
+package portfolio
+
+import (
+	"math/big"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"golang.org/x/sync/errgroup"
+)
+
+// read is a single read of a snapshot: the ether balance of holder when to is nil, a contract call otherwise.
+// done receives the result, ether balances being returned as a 32 bytes word like the uint256 of balanceOf.
+type read struct {
+	holder common.Address
+	to     *common.Address
+	data   []byte
+	done   func(result []byte, err error)
+}
+
+// rpcClient is implemented by the clients backed by a JSON-RPC connection, such as ethclient.Client.
+// The client of the simulated backend does not expose its connection.
+type rpcClient interface {
+	Client() *rpc.Client
+}
+
+// execute runs the reads at the given block. They are sent in JSON-RPC batches of BatchSize when the client supports it,
+// one call each otherwise, with up to Concurrency batches or calls in flight.
+func (p *Portfolio) execute(reads []read, block *big.Int) {
+	group := errgroup.Group{}
+	group.SetLimit(max(p.config.Concurrency, 1))
+	if client, ok := p.Client.(rpcClient); ok && p.config.BatchSize > 1 {
+		for start := 0; start < len(reads); start += p.config.BatchSize {
+			chunk := reads[start:min(start+p.config.BatchSize, len(reads))]
+			group.Go(func() error {
+				p.batch(client.Client(), chunk, block)
+				return nil
+			})
+		}
+	} else {
+		for _, r := range reads {
+			group.Go(func() error {
+				r.done(p.single(r, block))
+				return nil
+			})
+		}
+	}
+	_ = group.Wait()
+}
+
+// batch sends the reads in a single JSON-RPC batch.
+func (p *Portfolio) batch(client *rpc.Client, reads []read, block *big.Int) {
+	elems := make([]rpc.BatchElem, len(reads))
+	balances := make([]hexutil.Big, len(reads))
+	results := make([]hexutil.Bytes, len(reads))
+	for i, r := range reads {
+		if r.to == nil {
+			elems[i] = rpc.BatchElem{Method: "eth_getBalance", Args: []any{r.holder, hexutil.EncodeBig(block)}, Result: &balances[i]}
+			continue
+		}
+		call := map[string]any{"to": r.to, "data": hexutil.Bytes(r.data)}
+		elems[i] = rpc.BatchElem{Method: "eth_call", Args: []any{call, hexutil.EncodeBig(block)}, Result: &results[i]}
+	}
+
+	_, err := base.Observe(p.BaseInteractions, base.ReadOperation, "portfolio.Batch()", func() (struct{}, error) {
+		return struct{}{}, client.BatchCallContext(p.Ctx, elems)
+	})
+	for i, r := range reads {
+		switch {
+		case err != nil:
+			r.done(nil, err)
+		case elems[i].Error != nil:
+			r.done(nil, elems[i].Error)
+		case r.to == nil:
+			r.done(common.BigToHash(balances[i].ToInt()).Bytes(), nil)
+		default:
+			r.done(results[i], nil)
+		}
+	}
+}
+
+// single sends a read on its own.
+func (p *Portfolio) single(r read, block *big.Int) ([]byte, error) {
+	if r.to == nil {
+		balance, err := base.Observe(p.BaseInteractions, base.ReadOperation, "portfolio.BalanceAt()", func() (*big.Int, error) {
+			return p.Client.BalanceAt(p.Ctx, r.holder, block)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return common.BigToHash(balance).Bytes(), nil
+	}
+	return base.Observe(p.BaseInteractions, base.ReadOperation, "portfolio.CallContract()", func() ([]byte, error) {
+		return p.Client.CallContract(p.Ctx, ethereum.CallMsg{To: r.to, Data: r.data}, block)
+	})
+}
@@ -0,0 +1,117 @@
+package probe
+
+// Package probe detects the token standards and extensions a contract implements, combining ERC-165 queries with bytecode selector scanning.
+
+import (
+	"fmt"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/erc20/burnable"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/nft/enumerable"
+	"github.com/OCharless/eth-interfaces/nft/royalties"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Capabilities is the report returned by Probe, telling which standards and extensions a contract implements.
+type Capabilities struct {
+	Address    common.Address
+	ERC165     bool
+	ERC20      bool
+	ERC721     bool
+	Enumerable bool
+	Metadata   bool
+	Royalties  bool
+	ERC1155    bool
+	Permit     bool
+	Burnable   bool
+}
+
+var (
+	erc20Signatures      = []utils.Signature{erc20.TotalSupply, erc20.BalanceOf, Transfer, erc20.TransferFrom, erc20.Approve, Allowance}
+	erc721Signatures     = []utils.Signature{nft.BalanceOf, nft.OwnerOf, nft.TransferFrom, nft.Approve, nft.GetApproved, SetApprovalForAll, IsApprovedForAll}
+	enumerableSignatures = []utils.Signature{enumerable.TokenByIndex, enumerable.TokenOfOwnerByIndex}
+	metadataSignatures   = []utils.Signature{nft.Name, nft.Symbol, nft.TokenURI}
+	royaltiesSignatures  = []utils.Signature{royalties.RoyaltyInfo}
+	permitSignatures     = []utils.Signature{Permit, Nonces, DomainSeparator}
+	burnableSignatures   = []utils.Signature{burnable.Burn}
+)
+
+// Probe inspects the contract deployed at the given address. A capability is reported when the contract either
+// advertises it through a compliant ERC-165 supportsInterface or exposes all of its function selectors in its bytecode.
+// ERC1155 is only reported through ERC-165, which the standard requires and NewERC1155Interactions checks.
+func Probe(baseInteractions *base.BaseInteractions, address common.Address) (*Capabilities, error) {
+	byteCode, err := baseInteractions.Client.CodeAt(baseInteractions.Ctx, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contract bytecode: %w", err)
+	}
+	if len(byteCode) == 0 {
+		return nil, fmt.Errorf("no contract deployed at %s", address.Hex())
+	}
+
+	supports := func(interfaceID [4]byte) bool {
+		supported, err := baseInteractions.SupportsInterfaceWithGasCap(address, interfaceID)
+		return err == nil && supported
+	}
+
+	capabilities := &Capabilities{Address: address}
+	capabilities.ERC165, err = baseInteractions.SupportsERC165(address)
+	if err != nil {
+		return nil, err
+	}
+	if capabilities.ERC165 {
+		capabilities.ERC721 = supports(utils.IERC721_INTERFACE_ID)
+		capabilities.Metadata = supports(utils.IERC721_METADATA_INTERFACE_ID)
+		capabilities.Enumerable = supports(utils.IERC721_ENUMERABLE_INTERFACE_ID)
+		capabilities.Royalties = supports(utils.IERC2981_INTERFACE_ID)
+		capabilities.ERC1155 = supports(utils.IERC1155_INTERFACE_ID)
+	}
+
+	capabilities.ERC721 = capabilities.ERC721 || containsSelectors(byteCode, erc721Signatures)
+	capabilities.ERC20 = !capabilities.ERC721 && !capabilities.ERC1155 && containsSelectors(byteCode, erc20Signatures)
+	if capabilities.ERC721 {
+		capabilities.Enumerable = capabilities.Enumerable || containsSelectors(byteCode, enumerableSignatures)
+		capabilities.Metadata = capabilities.Metadata || containsSelectors(byteCode, metadataSignatures)
+	}
+	capabilities.Royalties = capabilities.Royalties || containsSelectors(byteCode, royaltiesSignatures)
+	capabilities.Permit = containsSelectors(byteCode, permitSignatures)
+	capabilities.Burnable = containsSelectors(byteCode, burnableSignatures)
+
+	return capabilities, nil
+}
+
+// Names returns the names of the capabilities the contract supports.
+func (c *Capabilities) Names() []string {
+	names := []string{}
+	for _, capability := range []struct {
+		name      string
+		supported bool
+	}{
+		{"ERC165", c.ERC165},
+		{"ERC20", c.ERC20},
+		{"ERC721", c.ERC721},
+		{"ERC721Enumerable", c.Enumerable},
+		{"ERC721Metadata", c.Metadata},
+		{"ERC2981", c.Royalties},
+		{"ERC1155", c.ERC1155},
+		{"ERC20Permit", c.Permit},
+		{"Burnable", c.Burnable},
+	} {
+		if capability.supported {
+			names = append(names, capability.name)
+		}
+	}
+	return names
+}
+
+// containsSelectors checks that every function selector of the given signatures appears in the bytecode.
+func containsSelectors(byteCode []byte, signatures []utils.Signature) bool {
+	for _, signature := range signatures {
+		if !utils.ContainsSelector(byteCode, signature) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+package probe_test
+
+// Package probe_test contains tests for the capability detection.
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/inferences/ERC1155Complete"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/OCharless/eth-interfaces/inferences/ERC721A"
+	"github.com/OCharless/eth-interfaces/inferences/ERC721Complete"
+	"github.com/OCharless/eth-interfaces/probe"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Probe verifies the capability report of the bundled contracts.
+func Test_Probe(t *testing.T) {
+	backend, auth, erc721CompleteAddr, privKey, err := utils.SetupBlockchain(t,
+		ERC721Complete.ERC721CompleteABI,
+		ERC721Complete.ERC721CompleteBin,
+		"MyNFT",
+		"MNFT",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer backend.Close()
+
+	emptyContract, err := utils.DeployEmptyContract(auth, backend)
+	if err != nil {
+		t.Fatalf("failed to deploy empty contract: %s", err)
+	}
+
+	deploy := func(abiString, byteCode string, params ...interface{}) common.Address {
+		address, tx, _, err := utils.DeployContract(auth, backend.Client(), abiString, byteCode, params...)
+		if err != nil {
+			t.Fatalf("failed to deploy contract: %s", err)
+		}
+		backend.Commit()
+		receipt, err := backend.Client().TransactionReceipt(context.Background(), tx.Hash())
+		if err != nil || receipt.Status != 1 {
+			t.Fatalf("failed to deploy contract: %s", err)
+		}
+		return address
+	}
+	erc20Addr := deploy(ERC20Burnable.ERC20BurnableABI, ERC20Burnable.ERC20BurnableBin)
+	erc721AAddr := deploy(ERC721A.ERC721AABI, ERC721A.ERC721ABin, "MyNFT", "MNFT")
+	erc1155Addr := deploy(ERC1155Complete.ERC1155CompleteABI, ERC1155Complete.ERC1155CompleteBin)
+	// A contract stopping right away whose bytecode holds the ERC1155 selectors as PUSH4 data, without supportsInterface.
+	runtime := "00"
+	for _, signature := range []probe.CapabilitySignature{probe.BalanceOfBatch, probe.SafeBatchTransferFrom, probe.SetApprovalForAll, probe.IsApprovedForAll} {
+		runtime += "63" + utils.GetFunctionSelector(signature)
+	}
+	selectorsOnlyAddr := deploy("[]", fmt.Sprintf("60%02x600c60003960%02x6000f3%s", len(runtime)/2, len(runtime)/2, runtime))
+
+	testCases := []struct {
+		Name           string
+		ContractAddr   common.Address
+		ExpectedResult []string
+		ExpectError    bool
+		ExpectedError  string
+	}{
+		{
+			Name:           "OK - ERC721 with every extension",
+			ContractAddr:   *erc721CompleteAddr,
+			ExpectedResult: []string{"ERC165", "ERC721", "ERC721Enumerable", "ERC721Metadata", "ERC2981"},
+		},
+		{
+			Name:           "OK - ERC721A enumerable detected from bytecode",
+			ContractAddr:   erc721AAddr,
+			ExpectedResult: []string{"ERC165", "ERC721", "ERC721Enumerable", "ERC721Metadata"},
+		},
+		{
+			Name:           "OK - Burnable ERC20",
+			ContractAddr:   erc20Addr,
+			ExpectedResult: []string{"ERC20", "Burnable"},
+		},
+		{
+			Name:           "OK - ERC1155",
+			ContractAddr:   erc1155Addr,
+			ExpectedResult: []string{"ERC165", "ERC1155"},
+		},
+		{
+			Name:           "OK - ERC1155 selectors without ERC-165",
+			ContractAddr:   selectorsOnlyAddr,
+			ExpectedResult: []string{},
+		},
+		{
+			Name:           "OK - Empty contract",
+			ContractAddr:   *emptyContract,
+			ExpectedResult: []string{},
+		},
+		{
+			Name:          "KO - No contract",
+			ContractAddr:  common.HexToAddress("1"),
+			ExpectError:   true,
+			ExpectedError: "no contract deployed at",
+		},
+	}
+
+	baseInteractions := base.NewBaseInteractions(backend.Client(), privKey, nil)
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			capabilities, err := probe.Probe(baseInteractions, tt.ContractAddr)
+			if tt.ExpectError {
+				if err == nil {
+					t.Error("expected error but there's none")
+					return
+				}
+				assert.Contains(t, err.Error(), tt.ExpectedError)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.ExpectedResult, capabilities.Names())
+			}
+		})
+	}
+}
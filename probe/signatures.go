@@ -0,0 +1,29 @@
+package probe
+
+import (
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type CapabilitySignature string
+
+const (
+	Transfer              CapabilitySignature = "transfer(address,uint256)"
+	Allowance             CapabilitySignature = "allowance(address,address)"
+	SetApprovalForAll     CapabilitySignature = "setApprovalForAll(address,bool)"
+	IsApprovedForAll      CapabilitySignature = "isApprovedForAll(address,address)"
+	BalanceOfBatch        CapabilitySignature = "balanceOfBatch(address[],uint256[])"
+	SafeBatchTransferFrom CapabilitySignature = "safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)"
+	URI                   CapabilitySignature = "uri(uint256)"
+	Permit                CapabilitySignature = "permit(address,address,uint256,uint256,uint8,bytes32,bytes32)"
+	Nonces                CapabilitySignature = "nonces(address)"
+	DomainSeparator       CapabilitySignature = "DOMAIN_SEPARATOR()"
+)
+
+func (s CapabilitySignature) GetHex() string {
+	hash := crypto.NewKeccakState()
+	hash.Write([]byte(s))
+	selector := hash.Sum(nil)[:4]
+	return hex.EncodeToString(selector)
+}
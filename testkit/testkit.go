@@ -0,0 +1,406 @@
+package testkit
+
+// Package testkit sets up simulated chains with funded accounts and the bundled contracts, for the tests of this library and of its users.
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/base"
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/inferences/ERC20Burnable"
+	"github.com/OCharless/eth-interfaces/inferences/ERC721Complete"
+	Disperse "github.com/OCharless/eth-interfaces/inferences/disperse"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ChainID is the chain id of the simulated chains.
+var ChainID = big.NewInt(1337)
+
+// settleTimeout bounds each wait of Revert for the pool to move to the snapshot.
+const settleTimeout = 5 * time.Second
+
+// ERC721Supply is the number of tokens the bundled ERC721Complete mints to its deployer, with ids 0 to 29.
+const ERC721Supply = 30
+
+// Account is a funded account of the kit along with its interactions. ERC20 and ERC721 are nil when the contract is not deployed.
+type Account struct {
+	Key     *ecdsa.PrivateKey
+	Address common.Address
+	Auth    *bind.TransactOpts
+	Base    *base.BaseInteractions
+	ERC20   *erc20.ERC20Interactions
+	ERC721  *nft.ERC721Interactions
+}
+
+// Builder configures a Kit.
+type Builder struct {
+	t            testing.TB
+	accounts     int
+	balance      *big.Int
+	gasLimit     uint64
+	erc20        bool
+	erc721       bool
+	disperse     bool
+	erc20Balance *big.Int
+	erc721Tokens int
+	erc721Name   string
+	erc721Symbol string
+	keys         []*ecdsa.PrivateKey
+	state        types.GenesisAlloc
+	stateFiles   []string
+}
+
+// New creates a builder of a single account funded with 1,000,000 ether and no deployed contract.
+func New(t testing.TB) *Builder {
+	return &Builder{
+		t:            t,
+		accounts:     1,
+		balance:      new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(1e18)),
+		gasLimit:     9_000_000,
+		erc721Name:   "MyNFT",
+		erc721Symbol: "MNFT",
+	}
+}
+
+// WithAccounts sets the number of funded accounts, the first one deploys the contracts.
+func (b *Builder) WithAccounts(n int) *Builder {
+	b.accounts = n
+	return b
+}
+
+// WithKeys sets the keys of the first accounts, the number of accounts is raised to match if needed.
+func (b *Builder) WithKeys(keys ...*ecdsa.PrivateKey) *Builder {
+	b.keys = keys
+	b.accounts = max(b.accounts, len(keys))
+	return b
+}
+
+// WithState seeds the genesis with the given accounts, such as a state dump parsed by utils.ParseStateDump.
+// Their balance, nonce, code and storage take precedence over the funding of the kit accounts.
+func (b *Builder) WithState(alloc types.GenesisAlloc) *Builder {
+	if b.state == nil {
+		b.state = types.GenesisAlloc{}
+	}
+	for address, account := range alloc {
+		b.state[address] = account
+	}
+	return b
+}
+
+// WithStateFile seeds the genesis with a state dump file, in the debug_dumpBlock or anvil --dump-state format.
+func (b *Builder) WithStateFile(path string) *Builder {
+	b.stateFiles = append(b.stateFiles, path)
+	return b
+}
+
+// WithBalance sets the ether balance of every account, in wei.
+func (b *Builder) WithBalance(balance *big.Int) *Builder {
+	b.balance = balance
+	return b
+}
+
+// WithGasLimit sets the block gas limit.
+func (b *Builder) WithGasLimit(gasLimit uint64) *Builder {
+	b.gasLimit = gasLimit
+	return b
+}
+
+// WithERC20 deploys the bundled ERC20Burnable, minting its whole supply to the first account.
+func (b *Builder) WithERC20() *Builder {
+	b.erc20 = true
+	return b
+}
+
+// WithERC20Balance deploys the bundled ERC20Burnable and transfers the given amount from the first account to each other account.
+func (b *Builder) WithERC20Balance(amount *big.Int) *Builder {
+	b.erc20 = true
+	b.erc20Balance = amount
+	return b
+}
+
+// WithERC721 deploys the bundled ERC721Complete with the given name and symbol, minting ERC721Supply tokens to the first account.
+func (b *Builder) WithERC721(name, symbol string) *Builder {
+	b.erc721 = true
+	b.erc721Name, b.erc721Symbol = name, symbol
+	return b
+}
+
+// WithERC721Tokens deploys the bundled ERC721Complete and transfers n tokens from the first account to each other account,
+// the second account receiving tokens 0 to n-1, the third n to 2n-1 and so on.
+func (b *Builder) WithERC721Tokens(n int) *Builder {
+	b.erc721 = true
+	b.erc721Tokens = n
+	return b
+}
+
+// WithDisperse deploys the bundled Disperse contract and sets it on the base interactions of every account.
+func (b *Builder) WithDisperse() *Builder {
+	b.disperse = true
+	return b
+}
+
+// Build starts the simulated chain, deploys the contracts and distributes the tokens. The chain is closed when the test ends.
+func (b *Builder) Build() *Kit {
+	b.t.Helper()
+	if b.accounts < 1 {
+		b.t.Fatalf("testkit: at least one account is required, got %d", b.accounts)
+	}
+	if b.erc721Tokens*(b.accounts-1) > ERC721Supply {
+		b.t.Fatalf("testkit: cannot distribute %d tokens to %d accounts out of %d", b.erc721Tokens, b.accounts-1, ERC721Supply)
+	}
+
+	kit := &Kit{t: b.t}
+	keys := make([]*ecdsa.PrivateKey, b.accounts)
+	alloc := types.GenesisAlloc{}
+	copy(keys, b.keys)
+	for i := range keys {
+		if keys[i] == nil {
+			key, err := crypto.GenerateKey()
+			if err != nil {
+				b.t.Fatalf("testkit: failed to generate key: %v", err)
+			}
+			keys[i] = key
+		}
+		alloc[crypto.PubkeyToAddress(keys[i].PublicKey)] = types.Account{Balance: b.balance}
+	}
+	for _, path := range b.stateFiles {
+		state, err := utils.LoadStateDump(path)
+		if err != nil {
+			b.t.Fatalf("testkit: failed to load state %s: %v", path, err)
+		}
+		b.WithState(state)
+	}
+	for address, account := range b.state {
+		alloc[address] = account
+	}
+	// The node also serves its JSON-RPC API over IPC, for the namespaces and batches the simulated client does not expose.
+	dir, err := os.MkdirTemp("", "testkit")
+	if err != nil {
+		b.t.Fatalf("testkit: failed to create IPC directory: %v", err)
+	}
+	b.t.Cleanup(func() { os.RemoveAll(dir) })
+	endpoint := filepath.Join(dir, "kit.ipc")
+	kit.Backend = simulated.NewBackend(alloc, simulated.WithBlockGasLimit(b.gasLimit), func(nodeConf *node.Config, _ *ethconfig.Config) {
+		nodeConf.IPCPath = endpoint
+	})
+	b.t.Cleanup(func() { kit.Backend.Close() })
+	kit.rpc, err = rpc.Dial(endpoint)
+	if err != nil {
+		b.t.Fatalf("testkit: failed to dial %s: %v", endpoint, err)
+	}
+	b.t.Cleanup(kit.rpc.Close)
+	// The genesis block predates the merge, which leaves out opcodes such as PUSH0 when estimating gas on top of it.
+	kit.Backend.Commit()
+
+	for _, key := range keys {
+		auth, err := bind.NewKeyedTransactorWithChainID(key, ChainID)
+		if err != nil {
+			b.t.Fatalf("testkit: failed to create transactor: %v", err)
+		}
+		kit.Accounts = append(kit.Accounts, &Account{
+			Key:     key,
+			Address: auth.From,
+			Auth:    auth,
+			Base:    base.NewBaseInteractions(kit.Backend.Client(), key, nil),
+		})
+	}
+
+	deployer := kit.Accounts[0].Auth
+	if b.erc20 {
+		kit.ERC20, _, _, err = ERC20Burnable.DeployERC20Burnable(deployer, kit.Backend.Client())
+		kit.check("deploy ERC20Burnable", err)
+	}
+	if b.erc721 {
+		kit.ERC721, _, _, err = ERC721Complete.DeployERC721Complete(deployer, kit.Backend.Client(), b.erc721Name, b.erc721Symbol)
+		kit.check("deploy ERC721Complete", err)
+	}
+	if b.disperse {
+		kit.Disperse, _, _, err = Disperse.DeployDisperse(deployer, kit.Backend.Client())
+		kit.check("deploy Disperse", err)
+	}
+	kit.Backend.Commit()
+
+	for _, account := range kit.Accounts {
+		if b.erc20 {
+			account.ERC20, err = erc20.NewIERC20Interactions(account.Base, kit.ERC20, []erc20.BaseERC20Signature{}, account.Auth)
+			kit.check("create ERC20 interactions", err)
+		}
+		if b.erc721 {
+			account.ERC721, err = nft.NewERC721Interactions(account.Base, kit.ERC721, []nft.BaseNFTSignature{}, account.Auth)
+			kit.check("create ERC721 interactions", err)
+		}
+		if b.disperse {
+			kit.check("set Disperse", account.Base.SetDisperse(kit.Disperse.Hex()))
+		}
+	}
+
+	owner := kit.Accounts[0]
+	for i, account := range kit.Accounts[1:] {
+		if b.erc20Balance != nil {
+			_, err := owner.ERC20.TransferTo(account.Address, b.erc20Balance)
+			kit.check("transfer ERC20", err)
+		}
+		for id := i * b.erc721Tokens; id < (i+1)*b.erc721Tokens; id++ {
+			_, err := owner.ERC721.TransferTo(account.Address, big.NewInt(int64(id)))
+			kit.check("transfer ERC721", err)
+		}
+	}
+	kit.Backend.Commit()
+	return kit
+}
+
+// Kit is a simulated chain with funded accounts and the deployed contracts. Undeployed contracts have the zero address.
+type Kit struct {
+	t        testing.TB
+	Backend  *simulated.Backend
+	rpc      *rpc.Client
+	Accounts []*Account
+	ERC20    common.Address
+	ERC721   common.Address
+	Disperse common.Address
+}
+
+// Snapshot is a block the chain can be reverted to.
+type Snapshot common.Hash
+
+// Client returns the client of the simulated chain.
+func (k *Kit) Client() simulated.Client {
+	return k.Backend.Client()
+}
+
+// RPC returns a JSON-RPC client of the simulated chain, for the batches and the namespaces such as txpool
+// the simulated client does not expose. ethclient.NewClient turns it into a full ethclient.Client.
+func (k *Kit) RPC() *rpc.Client {
+	return k.rpc
+}
+
+// Commit mines the pending transactions in a new block.
+func (k *Kit) Commit() common.Hash {
+	return k.Backend.Commit()
+}
+
+// Mine mines n blocks.
+func (k *Kit) Mine(n int) {
+	for range n {
+		k.Backend.Commit()
+	}
+}
+
+// AdvanceTime mines the pending transactions, then mines a block whose timestamp is the given duration after the last one.
+func (k *Kit) AdvanceTime(d time.Duration) {
+	k.t.Helper()
+	k.Backend.Commit()
+	k.check("adjust time", k.Backend.AdjustTime(d))
+}
+
+// Snapshot returns the current head, pending transactions are not part of it.
+func (k *Kit) Snapshot() Snapshot {
+	k.t.Helper()
+	header, err := k.Client().HeaderByNumber(k.Accounts[0].Base.Ctx, nil)
+	k.check("get head", err)
+	return Snapshot(header.Hash())
+}
+
+// Revert sets the head back to a snapshot and drops the pending transactions, along with those of the reverted blocks.
+func (k *Kit) Revert(snapshot Snapshot) {
+	k.t.Helper()
+	ctx := k.Accounts[0].Base.Ctx
+	parent, err := k.Client().HeaderByHash(ctx, common.Hash(snapshot))
+	k.check("get snapshot", err)
+	head, err := k.Client().BlockNumber(ctx)
+	k.check("get head", err)
+	reverted := 0
+	for number := parent.Number.Uint64() + 1; number <= head; number++ {
+		block, err := k.Client().BlockByNumber(ctx, new(big.Int).SetUint64(number))
+		k.check("get reverted block", err)
+		reverted += block.Transactions().Len()
+	}
+
+	k.Backend.Rollback()
+	k.check("revert", k.Backend.Fork(common.Hash(snapshot)))
+	k.check("drop reverted transactions", k.dropReverted(reverted))
+	// Dropping the transactions leaves the pool with the nonces of the reverted blocks, forking again on the empty pool
+	// moves it to the snapshot once more, which resets them.
+	k.check("reset pool", k.Backend.Fork(common.Hash(snapshot)))
+	k.check("reset pool", k.waitNonces())
+}
+
+// dropReverted waits for the pool, which moves to the new head in the background, to add back the transactions of the
+// reverted blocks, then drops them.
+func (k *Kit) dropReverted(reverted int) error {
+	ctx, cancel := context.WithTimeout(k.Accounts[0].Base.Ctx, settleTimeout)
+	defer cancel()
+	for reverted > 0 {
+		status := map[string]hexutil.Uint{}
+		if err := k.rpc.CallContext(ctx, &status, "txpool_status"); err != nil {
+			return err
+		}
+		if int(status["pending"]+status["queued"]) >= reverted {
+			break
+		}
+		if err := wait(ctx); err != nil {
+			return fmt.Errorf("%d transactions of the reverted blocks not added back: %w", reverted, err)
+		}
+	}
+	k.Backend.Rollback()
+	return nil
+}
+
+// waitNonces waits for the pending nonce of every account, which the pool computes from its own head, to be the one at the chain head.
+func (k *Kit) waitNonces() error {
+	ctx, cancel := context.WithTimeout(k.Accounts[0].Base.Ctx, settleTimeout)
+	defer cancel()
+	for _, account := range k.Accounts {
+		for {
+			pending, err := k.Client().PendingNonceAt(ctx, account.Address)
+			if err != nil {
+				return err
+			}
+			nonce, err := k.Client().NonceAt(ctx, account.Address, nil)
+			if err != nil {
+				return err
+			}
+			if pending == nonce {
+				break
+			}
+			if err := wait(ctx); err != nil {
+				return fmt.Errorf("pending nonce %d of %s not reset to %d: %w", pending, account.Address.Hex(), nonce, err)
+			}
+		}
+	}
+	return nil
+}
+
+// wait sleeps for 10ms, unless ctx is done first.
+func wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(10 * time.Millisecond):
+		return nil
+	}
+}
+
+func (k *Kit) check(action string, err error) {
+	k.t.Helper()
+	if err != nil {
+		k.t.Fatalf("testkit: failed to %s: %v", action, err)
+	}
+}
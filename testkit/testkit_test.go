@@ -0,0 +1,179 @@
+package testkit_test
+
+// Package testkit_test contains tests for the simulated chain builder.
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OCharless/eth-interfaces/erc20"
+	"github.com/OCharless/eth-interfaces/nft"
+	"github.com/OCharless/eth-interfaces/testkit"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_Build verifies the accounts, contracts and token distribution of a kit.
+func Test_Build(t *testing.T) {
+	kit := testkit.New(t).
+		WithAccounts(3).
+		WithBalance(big.NewInt(5e18)).
+		WithERC20Balance(big.NewInt(1000)).
+		WithERC721Tokens(2).
+		WithDisperse().
+		Build()
+
+	assert.Len(t, kit.Accounts, 3)
+
+	t.Run("OK - Funded accounts", func(t *testing.T) {
+		balance, err := kit.Client().BalanceAt(context.Background(), kit.Accounts[2].Address, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(5e18), balance)
+	})
+
+	t.Run("OK - ERC20 balances", func(t *testing.T) {
+		for _, account := range kit.Accounts[1:] {
+			balance, err := account.ERC20.GetBalance()
+			assert.Nil(t, err)
+			assert.Equal(t, big.NewInt(1000), balance)
+		}
+	})
+
+	t.Run("OK - ERC721 tokens", func(t *testing.T) {
+		testCases := []struct {
+			TokenID int64
+			Account int
+		}{
+			{0, 1},
+			{1, 1},
+			{2, 2},
+			{3, 2},
+			{4, 0},
+		}
+		for _, tc := range testCases {
+			owner, err := kit.Accounts[0].ERC721.OwnerOf(big.NewInt(tc.TokenID))
+			assert.Nil(t, err)
+			assert.Equal(t, kit.Accounts[tc.Account].Address, owner, fmt.Sprintf("token %d", tc.TokenID))
+		}
+		balance, err := kit.Accounts[0].ERC721.GetBalance()
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(testkit.ERC721Supply-4), balance)
+	})
+
+	t.Run("OK - Disperse", func(t *testing.T) {
+		code, err := kit.Client().CodeAt(context.Background(), kit.Disperse, nil)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, code)
+	})
+}
+
+// Test_Chain verifies the block, time and snapshot helpers.
+func Test_Chain(t *testing.T) {
+	kit := testkit.New(t).WithAccounts(2).WithERC20().Build()
+	ctx := context.Background()
+	owner, other := kit.Accounts[0], kit.Accounts[1]
+	assert.Nil(t, other.ERC721)
+
+	t.Run("OK - Mine", func(t *testing.T) {
+		before, err := kit.Client().BlockNumber(ctx)
+		assert.Nil(t, err)
+		kit.Mine(3)
+		after, err := kit.Client().BlockNumber(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, before+3, after)
+	})
+
+	t.Run("OK - Advance time", func(t *testing.T) {
+		before, err := kit.Client().HeaderByNumber(ctx, nil)
+		assert.Nil(t, err)
+		kit.AdvanceTime(time.Hour)
+		after, err := kit.Client().HeaderByNumber(ctx, nil)
+		assert.Nil(t, err)
+		assert.GreaterOrEqual(t, after.Time-before.Time, uint64(3600))
+	})
+
+	t.Run("OK - Revert", func(t *testing.T) {
+		snapshot := kit.Snapshot()
+		_, err := owner.ERC20.TransferTo(other.Address, big.NewInt(42))
+		assert.Nil(t, err)
+		kit.Commit()
+		balance, err := other.ERC20.GetBalance()
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(42), balance)
+
+		kit.Revert(snapshot)
+		balance, err = other.ERC20.GetBalance()
+		assert.Nil(t, err)
+		assert.Equal(t, "0", balance.String())
+
+		// The reverted transfer is not mined again.
+		kit.Commit()
+		balance, err = other.ERC20.GetBalance()
+		assert.Nil(t, err)
+		assert.Equal(t, "0", balance.String())
+
+		// The nonce of the reverted transfer is used again.
+		_, err = owner.ERC20.TransferTo(other.Address, big.NewInt(7))
+		assert.Nil(t, err)
+		kit.Commit()
+		balance, err = other.ERC20.GetBalance()
+		assert.Nil(t, err)
+		assert.Equal(t, "7", balance.String())
+	})
+
+	t.Run("OK - JSON-RPC", func(t *testing.T) {
+		number, err := ethclient.NewClient(kit.RPC()).BlockNumber(ctx)
+		assert.Nil(t, err)
+		expected, err := kit.Client().BlockNumber(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, number)
+	})
+}
+
+// Test_State verifies the interactions with contracts restored from state dumps.
+func Test_State(t *testing.T) {
+	// First anvil account, which deployed the dumped contracts.
+	key, err := crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	assert.Nil(t, err)
+	kit := testkit.New(t).
+		WithKeys(key).
+		WithStateFile("../utils/testdata/debug_dumpBlock.json").
+		WithStateFile("../utils/testdata/anvil_state.json").
+		Build()
+	owner := kit.Accounts[0]
+	holder := common.HexToAddress("0x000000000000000000000000000000000000beef")
+
+	t.Run("OK - ERC20", func(t *testing.T) {
+		token, err := erc20.NewIERC20Interactions(owner.Base, common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3"), []erc20.BaseERC20Signature{}, owner.Auth)
+		assert.Nil(t, err)
+		balance, err := token.BalanceOf(holder)
+		assert.Nil(t, err)
+		assert.Equal(t, "250000000000000000000", balance.String())
+
+		_, err = token.TransferTo(holder, big.NewInt(1))
+		assert.Nil(t, err)
+		kit.Commit()
+		balance, err = token.BalanceOf(holder)
+		assert.Nil(t, err)
+		assert.Equal(t, "250000000000000000001", balance.String())
+	})
+
+	t.Run("OK - ERC721", func(t *testing.T) {
+		collection, err := nft.NewERC721Interactions(owner.Base, common.HexToAddress("0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512"), []nft.BaseNFTSignature{}, owner.Auth)
+		assert.Nil(t, err)
+		name, err := collection.Name()
+		assert.Nil(t, err)
+		assert.Equal(t, "Snapshot", name)
+		holderOf, err := collection.OwnerOf(big.NewInt(3))
+		assert.Nil(t, err)
+		assert.Equal(t, holder, holderOf)
+		ownerOf, err := collection.OwnerOf(big.NewInt(4))
+		assert.Nil(t, err)
+		assert.Equal(t, owner.Address, ownerOf)
+	})
+}
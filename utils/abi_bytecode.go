@@ -1,6 +1,10 @@
 package utils
 
 import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -14,6 +18,21 @@ func GetFunctionSelector(signature Signature) string {
 	return signature.GetHex()
 }
 
+// ContainsSelector reports whether the function selector of signature appears in the bytecode. Besides the plain
+// selector, it looks for the shortest push of it, which solc uses for selectors starting with zero bytes.
+func ContainsSelector(byteCode []byte, signature Signature) bool {
+	selector := common.Hex2Bytes(GetFunctionSelector(signature))
+	if bytes.Contains(byteCode, selector) {
+		return true
+	}
+	trimmed := bytes.TrimLeft(selector, "\x00")
+	if len(trimmed) == 0 || len(trimmed) == len(selector) {
+		return false
+	}
+	// PUSH1 is 0x60, PUSHn pushes the n bytes that follow it.
+	return bytes.Contains(byteCode, append([]byte{byte(0x5f + len(trimmed))}, trimmed...))
+}
+
 func GetEncodedFunction(abiString, signature string, params ...interface{}) ([]byte, error) {
 	contractABI, err := abi.JSON(strings.NewReader(abiString))
 	if err != nil {
@@ -33,11 +52,9 @@ func DeployContract(auth *bind.TransactOpts,
 		return common.Address{}, nil, nil, err
 	}
 
-	var byteCode []byte
-	if byteCodeString[0:2] == "0x" {
-		byteCode = common.Hex2Bytes(byteCodeString[2:])
-	} else {
-		byteCode = common.Hex2Bytes(byteCodeString)
+	byteCode, err := ParseBytecode(byteCodeString)
+	if err != nil {
+		return common.Address{}, nil, nil, err
 	}
 
 	return bind.DeployContract(
@@ -48,3 +65,20 @@ func DeployContract(auth *bind.TransactOpts,
 		params...,
 	)
 }
+
+// ParseBytecode decodes a hex encoded bytecode, with or without the 0x prefix.
+// Unlinked library placeholders and other invalid characters are reported with their offset.
+func ParseBytecode(byteCodeString string) ([]byte, error) {
+	byteCodeString = strings.TrimPrefix(strings.TrimSpace(byteCodeString), "0x")
+	if byteCodeString == "" {
+		return nil, errors.New("empty bytecode")
+	}
+	if offset := strings.Index(byteCodeString, "__"); offset >= 0 {
+		return nil, fmt.Errorf("unlinked library placeholder at offset %d", offset/2)
+	}
+	byteCode, err := hex.DecodeString(byteCodeString)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bytecode: %w", err)
+	}
+	return byteCode, nil
+}
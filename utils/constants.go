@@ -6,13 +6,24 @@ import (
 )
 
 var (
-	IERC721_INTERFACE_ID  = [4]byte{0x80, 0xac, 0x58, 0xcd}
-	IERC20_INTERFACE_ID   = [4]byte{0x36, 0x37, 0x2b, 0x07}
-	IERC1155_INTERFACE_ID = [4]byte{0xd9, 0xb6, 0x7a, 0x26}
+	IERC165_INTERFACE_ID                    = ERC165Interface.ID()
+	IERC721_INTERFACE_ID                    = ERC721Interface.ID()
+	IERC721_METADATA_INTERFACE_ID           = ERC721MetadataInterface.ID()
+	IERC721_ENUMERABLE_INTERFACE_ID         = ERC721EnumerableInterface.ID()
+	IERC2981_INTERFACE_ID                   = ERC2981Interface.ID()
+	IERC1155_INTERFACE_ID                   = ERC1155Interface.ID()
+	IERC1155_METADATA_URI_INTERFACE_ID      = ERC1155MetadataURIInterface.ID()
+	IERC4906_INTERFACE_ID                   = ERC4906Interface.ID()
+	IACCESS_CONTROL_INTERFACE_ID            = AccessControlInterface.ID()
+	IACCESS_CONTROL_ENUMERABLE_INTERFACE_ID = AccessControlEnumerableInterface.ID()
+	// Deprecated: ERC-20 is not an ERC-165 interface, tokens do not report it through supportsInterface.
+	IERC20_INTERFACE_ID = ERC20Interface.ID()
+	// INVALID_INTERFACE_ID must never be supported by an ERC-165 compliant contract.
+	INVALID_INTERFACE_ID = [4]byte{0xff, 0xff, 0xff, 0xff}
 )
 
 var (
-	MAX_UINT256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(9))
+	MAX_UINT256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
 )
 
 var (
@@ -27,3 +27,38 @@ func ParseEther(a *big.Int) float64 {
 	fa, _ := a.Float64()
 	return fa / (1e18)
 }
+
+// FormatUnits formats an integer amount of the smallest unit as an exact decimal string, such as FormatUnits(1500000, 6) == "1.5".
+func FormatUnits(value *big.Int, decimals uint8) string {
+	if decimals == 0 {
+		return value.String()
+	}
+	digits := new(big.Int).Abs(value).String()
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+	integer, fraction := digits[:len(digits)-int(decimals)], strings.TrimRight(digits[len(digits)-int(decimals):], "0")
+	sign := ""
+	if value.Sign() < 0 {
+		sign = "-"
+	}
+	if fraction == "" {
+		return sign + integer
+	}
+	return sign + integer + "." + fraction
+}
+
+// ParseUnits parses a decimal amount into an integer amount of the smallest unit, such as ParseUnits("1.5", 6) == 1500000.
+// Amounts with more fractional digits than decimals are rejected.
+func ParseUnits(amount string, decimals uint8) (*big.Int, error) {
+	amount = strings.TrimSpace(amount)
+	integer, fraction, _ := strings.Cut(amount, ".")
+	if len(fraction) > int(decimals) {
+		return nil, fmt.Errorf("invalid amount %q: more than %d decimals", amount, decimals)
+	}
+	value, ok := new(big.Int).SetString(integer+fraction+strings.Repeat("0", int(decimals)-len(fraction)), 10)
+	if !ok || strings.Trim(integer+fraction, "+-") == "" {
+		return nil, fmt.Errorf("invalid amount %q", amount)
+	}
+	return value, nil
+}
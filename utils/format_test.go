@@ -0,0 +1,59 @@
+package utils_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_FormatUnits verifies the decimal formatting of token amounts.
+func Test_FormatUnits(t *testing.T) {
+	testCases := []struct {
+		Value    int64
+		Decimals uint8
+		Expected string
+	}{
+		{1500000, 6, "1.5"},
+		{1, 18, "0.000000000000000001"},
+		{2000000, 6, "2"},
+		{0, 18, "0"},
+		{42, 0, "42"},
+		{-1500, 3, "-1.5"},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.Expected, utils.FormatUnits(big.NewInt(tc.Value), tc.Decimals))
+	}
+}
+
+// Test_ParseUnits verifies the parsing of decimal token amounts.
+func Test_ParseUnits(t *testing.T) {
+	testCases := []struct {
+		Amount      string
+		Decimals    uint8
+		Expected    string
+		ExpectError bool
+	}{
+		{Amount: "1.5", Decimals: 6, Expected: "1500000"},
+		{Amount: "0.000000000000000001", Decimals: 18, Expected: "1"},
+		{Amount: "42", Decimals: 0, Expected: "42"},
+		{Amount: "-1.5", Decimals: 3, Expected: "-1500"},
+		{Amount: ".5", Decimals: 1, Expected: "5"},
+		{Amount: "1.234", Decimals: 2, ExpectError: true},
+		{Amount: "1e18", Decimals: 18, ExpectError: true},
+		{Amount: ".", Decimals: 18, ExpectError: true},
+		{Amount: "", Decimals: 18, ExpectError: true},
+	}
+
+	for _, tc := range testCases {
+		value, err := utils.ParseUnits(tc.Amount, tc.Decimals)
+		if tc.ExpectError {
+			assert.Error(t, err, tc.Amount)
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, tc.Expected, value.String())
+	}
+}
@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// InterfaceSignature is the signature of a function belonging to an ERC-165 interface.
+type InterfaceSignature string
+
+func (s InterfaceSignature) GetHex() string {
+	hash := crypto.NewKeccakState()
+	hash.Write([]byte(s))
+	selector := hash.Sum(nil)[:4]
+	return hex.EncodeToString(selector)
+}
+
+// Interface describes an ERC-165 interface by the functions it is made of.
+// StaticID is only set for interfaces whose identifier is not derived from their functions, such as ERC-4906.
+type Interface struct {
+	Name       string
+	Signatures []Signature
+	StaticID   *[4]byte
+}
+
+// ID returns the ERC-165 identifier of the interface, the XOR of all its function selectors.
+func (i Interface) ID() [4]byte {
+	if i.StaticID != nil {
+		return *i.StaticID
+	}
+	id, err := ComputeInterfaceID(i.Signatures...)
+	if err != nil {
+		panic(fmt.Sprintf("invalid selector in interface %s: %s", i.Name, err))
+	}
+	return id
+}
+
+// Hex returns the ERC-165 identifier of the interface as a 0x prefixed hex string.
+func (i Interface) Hex() string {
+	id := i.ID()
+	return "0x" + hex.EncodeToString(id[:])
+}
+
+// ComputeInterfaceID XORs the function selectors of the given signatures into an ERC-165 identifier.
+func ComputeInterfaceID(signatures ...Signature) ([4]byte, error) {
+	var id [4]byte
+	for _, signature := range signatures {
+		selector, err := hex.DecodeString(GetFunctionSelector(signature))
+		if err != nil {
+			return [4]byte{}, err
+		}
+		if len(selector) != 4 {
+			return [4]byte{}, fmt.Errorf("selector of %v is %d bytes long", signature, len(selector))
+		}
+		for idx := range id {
+			id[idx] ^= selector[idx]
+		}
+	}
+	return id, nil
+}
+
+var (
+	ERC165Interface = Interface{
+		Name: "ERC165",
+		Signatures: []Signature{
+			InterfaceSignature("supportsInterface(bytes4)"),
+		},
+	}
+	ERC721Interface = Interface{
+		Name: "ERC721",
+		Signatures: []Signature{
+			InterfaceSignature("balanceOf(address)"),
+			InterfaceSignature("ownerOf(uint256)"),
+			InterfaceSignature("safeTransferFrom(address,address,uint256,bytes)"),
+			InterfaceSignature("safeTransferFrom(address,address,uint256)"),
+			InterfaceSignature("transferFrom(address,address,uint256)"),
+			InterfaceSignature("approve(address,uint256)"),
+			InterfaceSignature("setApprovalForAll(address,bool)"),
+			InterfaceSignature("getApproved(uint256)"),
+			InterfaceSignature("isApprovedForAll(address,address)"),
+		},
+	}
+	ERC721MetadataInterface = Interface{
+		Name: "ERC721Metadata",
+		Signatures: []Signature{
+			InterfaceSignature("name()"),
+			InterfaceSignature("symbol()"),
+			InterfaceSignature("tokenURI(uint256)"),
+		},
+	}
+	ERC721EnumerableInterface = Interface{
+		Name: "ERC721Enumerable",
+		Signatures: []Signature{
+			InterfaceSignature("totalSupply()"),
+			InterfaceSignature("tokenOfOwnerByIndex(address,uint256)"),
+			InterfaceSignature("tokenByIndex(uint256)"),
+		},
+	}
+	ERC2981Interface = Interface{
+		Name: "ERC2981",
+		Signatures: []Signature{
+			InterfaceSignature("royaltyInfo(uint256,uint256)"),
+		},
+	}
+	ERC1155Interface = Interface{
+		Name: "ERC1155",
+		Signatures: []Signature{
+			InterfaceSignature("balanceOf(address,uint256)"),
+			InterfaceSignature("balanceOfBatch(address[],uint256[])"),
+			InterfaceSignature("setApprovalForAll(address,bool)"),
+			InterfaceSignature("isApprovedForAll(address,address)"),
+			InterfaceSignature("safeTransferFrom(address,address,uint256,uint256,bytes)"),
+			InterfaceSignature("safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)"),
+		},
+	}
+	ERC1155MetadataURIInterface = Interface{
+		Name: "ERC1155MetadataURI",
+		Signatures: []Signature{
+			InterfaceSignature("uri(uint256)"),
+		},
+	}
+	// ERC4906Interface only declares the MetadataUpdate and BatchMetadataUpdate events, its identifier is fixed by the EIP.
+	ERC4906Interface = Interface{
+		Name:     "ERC4906",
+		StaticID: &[4]byte{0x49, 0x06, 0x49, 0x06},
+	}
+	AccessControlInterface = Interface{
+		Name: "AccessControl",
+		Signatures: []Signature{
+			InterfaceSignature("hasRole(bytes32,address)"),
+			InterfaceSignature("getRoleAdmin(bytes32)"),
+			InterfaceSignature("grantRole(bytes32,address)"),
+			InterfaceSignature("revokeRole(bytes32,address)"),
+			InterfaceSignature("renounceRole(bytes32,address)"),
+		},
+	}
+	// AccessControlEnumerableInterface only covers the functions added on top of AccessControl, as in OpenZeppelin.
+	AccessControlEnumerableInterface = Interface{
+		Name: "AccessControlEnumerable",
+		Signatures: []Signature{
+			InterfaceSignature("getRoleMember(bytes32,uint256)"),
+			InterfaceSignature("getRoleMemberCount(bytes32)"),
+		},
+	}
+
+	// ERC20Interface groups the ERC-20 functions. ERC-20 predates ERC-165 and tokens do not advertise it, so it is not part of the registry.
+	ERC20Interface = Interface{
+		Name: "ERC20",
+		Signatures: []Signature{
+			InterfaceSignature("totalSupply()"),
+			InterfaceSignature("balanceOf(address)"),
+			InterfaceSignature("transfer(address,uint256)"),
+			InterfaceSignature("transferFrom(address,address,uint256)"),
+			InterfaceSignature("approve(address,uint256)"),
+			InterfaceSignature("allowance(address,address)"),
+		},
+	}
+)
+
+// InterfaceRegistry lists the ERC-165 interfaces known by the library.
+var InterfaceRegistry = []Interface{
+	ERC165Interface,
+	ERC721Interface,
+	ERC721MetadataInterface,
+	ERC721EnumerableInterface,
+	ERC2981Interface,
+	ERC1155Interface,
+	ERC1155MetadataURIInterface,
+	ERC4906Interface,
+	AccessControlInterface,
+	AccessControlEnumerableInterface,
+}
+
+// LookupInterface returns the registered interface with the given name.
+func LookupInterface(name string) (Interface, bool) {
+	for _, i := range InterfaceRegistry {
+		if i.Name == name {
+			return i, true
+		}
+	}
+	return Interface{}, false
+}
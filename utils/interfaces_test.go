@@ -0,0 +1,64 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_InterfaceIDs verifies that the computed interface identifiers match the ones published in the EIPs.
+func Test_InterfaceIDs(t *testing.T) {
+	testCases := []struct {
+		Interface  utils.Interface
+		ExpectedID string
+	}{
+		{utils.ERC165Interface, "0x01ffc9a7"},
+		{utils.ERC721Interface, "0x80ac58cd"},
+		{utils.ERC721MetadataInterface, "0x5b5e139f"},
+		{utils.ERC721EnumerableInterface, "0x780e9d63"},
+		{utils.ERC2981Interface, "0x2a55205a"},
+		{utils.ERC1155Interface, "0xd9b67a26"},
+		{utils.ERC1155MetadataURIInterface, "0x0e89341c"},
+		{utils.ERC4906Interface, "0x49064906"},
+		{utils.AccessControlInterface, "0x7965db0b"},
+		{utils.AccessControlEnumerableInterface, "0x5a05180f"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Interface.Name, func(t *testing.T) {
+			assert.Equal(t, tt.ExpectedID, tt.Interface.Hex())
+			registered, ok := utils.LookupInterface(tt.Interface.Name)
+			assert.True(t, ok)
+			assert.Equal(t, tt.Interface.ID(), registered.ID())
+		})
+	}
+}
+
+// Test_MaxUint256 verifies that MAX_UINT256 is 2^256 - 1.
+func Test_MaxUint256(t *testing.T) {
+	assert.Equal(t, 256, utils.MAX_UINT256.BitLen())
+	assert.Equal(t, "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", utils.MAX_UINT256.Text(16))
+}
+
+// Test_ContainsSelector verifies that selectors are found in the bytecode whether pushed in full or without their leading zero bytes.
+func Test_ContainsSelector(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		ByteCode string
+		Expected bool
+	}{
+		{Name: "OK - PUSH4", ByteCode: "6300fdd58e14", Expected: true},
+		{Name: "OK - PUSH3 of a selector starting with a zero byte", ByteCode: "8062fdd58e14", Expected: true},
+		{Name: "KO - Other push", ByteCode: "8061d58e14", Expected: false},
+		{Name: "KO - Missing", ByteCode: "6370a0823114", Expected: false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			// balanceOf(address,uint256) is 0x00fdd58e.
+			assert.Equal(t, tt.Expected, utils.ContainsSelector(common.Hex2Bytes(tt.ByteCode), utils.InterfaceSignature("balanceOf(address,uint256)")))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// KeystorePasswordEnv is the environment variable holding the password of the keystore key sources.
+const KeystorePasswordEnv = "KEYSTORE_PASSWORD"
+
+// ErrNoKey is returned when a key source is empty, such as an unset environment variable.
+var ErrNoKey = errors.New("no private key")
+
+// LoadPrivateKey loads a private key from a key source, which is one of:
+//   - a hex encoded key, with or without the 0x prefix
+//   - "env:NAME", a hex encoded key held by an environment variable
+//   - "file:PATH", a hex encoded key stored in a file
+//   - "keystore:PATH", an encrypted JSON keystore whose password is held by KEYSTORE_PASSWORD
+func LoadPrivateKey(source string) (*ecdsa.PrivateKey, error) {
+	scheme, value, found := strings.Cut(source, ":")
+	if !found {
+		scheme, value = "hex", source
+	}
+
+	switch scheme {
+	case "hex", "0x":
+		if scheme == "0x" {
+			value = "0x" + value
+		}
+	case "env":
+		value = os.Getenv(value)
+	case "file":
+		content, err := os.ReadFile(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key file: %w", err)
+		}
+		value = string(content)
+	case "keystore":
+		content, err := os.ReadFile(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read keystore: %w", err)
+		}
+		key, err := keystore.DecryptKey(content, os.Getenv(KeystorePasswordEnv))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt keystore: %w", err)
+		}
+		return key.PrivateKey, nil
+	default:
+		return nil, fmt.Errorf("unknown key source %q", scheme)
+	}
+
+	value = strings.TrimPrefix(strings.TrimSpace(value), "0x")
+	if value == "" {
+		return nil, ErrNoKey
+	}
+	key, err := crypto.HexToECDSA(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	return key, nil
+}
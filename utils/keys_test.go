@@ -0,0 +1,68 @@
+package utils_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_LoadPrivateKey verifies every key source.
+func Test_LoadPrivateKey(t *testing.T) {
+	const hexKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	expected := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "key")
+	assert.Nil(t, os.WriteFile(keyFile, []byte(hexKey+"\n"), 0o600))
+
+	privateKey, err := crypto.HexToECDSA(hexKey)
+	assert.Nil(t, err)
+	encrypted, err := keystore.EncryptKey(&keystore.Key{Id: uuid.New(), Address: expected, PrivateKey: privateKey}, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	assert.Nil(t, err)
+	keystoreFile := filepath.Join(dir, "keystore.json")
+	assert.Nil(t, os.WriteFile(keystoreFile, encrypted, 0o600))
+
+	t.Setenv("TEST_PRIVATE_KEY", "0x"+hexKey)
+	t.Setenv("TEST_EMPTY_KEY", "")
+	t.Setenv(utils.KeystorePasswordEnv, "secret")
+
+	testCases := []struct {
+		Name        string
+		Source      string
+		ExpectedErr error
+		ExpectError bool
+	}{
+		{Name: "OK - Hex", Source: hexKey},
+		{Name: "OK - Prefixed hex", Source: "0x" + hexKey},
+		{Name: "OK - Env", Source: "env:TEST_PRIVATE_KEY"},
+		{Name: "OK - File", Source: "file:" + keyFile},
+		{Name: "OK - Keystore", Source: "keystore:" + keystoreFile},
+		{Name: "KO - Empty env", Source: "env:TEST_EMPTY_KEY", ExpectedErr: utils.ErrNoKey},
+		{Name: "KO - Missing file", Source: "file:" + filepath.Join(dir, "missing"), ExpectError: true},
+		{Name: "KO - Invalid key", Source: "0x1234", ExpectError: true},
+		{Name: "KO - Unknown scheme", Source: "vault:key", ExpectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			key, err := utils.LoadPrivateKey(tc.Source)
+			if tc.ExpectedErr != nil {
+				assert.ErrorIs(t, err, tc.ExpectedErr)
+				return
+			}
+			if tc.ExpectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, expected, crypto.PubkeyToAddress(key.PublicKey))
+		})
+	}
+}
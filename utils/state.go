@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// stateDump is a state dump as returned by debug_dumpBlock or written by anvil --dump-state. A bare genesis alloc has no accounts key.
+type stateDump struct {
+	Accounts map[string]dumpAccount `json:"accounts"`
+}
+
+// dumpAccount accepts both formats: debug_dumpBlock writes decimal balances and unprefixed storage values,
+// anvil writes hex quantities.
+type dumpAccount struct {
+	Address *common.Address   `json:"address"`
+	Balance quantity          `json:"balance"`
+	Nonce   quantity          `json:"nonce"`
+	Code    string            `json:"code"`
+	Storage map[string]string `json:"storage"`
+}
+
+// quantity is a JSON number, a decimal string or a 0x prefixed hex string.
+type quantity struct {
+	*big.Int
+}
+
+func (q *quantity) UnmarshalJSON(data []byte) error {
+	text := strings.Trim(string(data), `"`)
+	if text == "" || text == "null" {
+		q.Int = new(big.Int)
+		return nil
+	}
+	// SetString with base 0 would also read octal and binary prefixes, which quantities never use.
+	base := 10
+	if strings.HasPrefix(text, "0x") || strings.HasPrefix(text, "0X") {
+		text, base = text[2:], 16
+	}
+	value, ok := new(big.Int).SetString(text, base)
+	if !ok {
+		return fmt.Errorf("invalid quantity %s", data)
+	}
+	q.Int = value
+	return nil
+}
+
+// LoadStateDump reads a state dump file, see ParseStateDump.
+func LoadStateDump(path string) (types.GenesisAlloc, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStateDump(data)
+}
+
+// ParseStateDump converts a state dump into a genesis allocation for simulated.NewBackend.
+// It reads the output of debug_dumpBlock, the files of anvil --dump-state, gzipped or not, the hex string returned by anvil_dumpState
+// and plain genesis allocations.
+func ParseStateDump(data []byte) (types.GenesisAlloc, error) {
+	data, err := decompressState(bytes.TrimSpace(data))
+	if err != nil {
+		return nil, err
+	}
+
+	dump := stateDump{}
+	if err := json.Unmarshal(data, &dump); err != nil {
+		return nil, fmt.Errorf("invalid state dump: %w", err)
+	}
+	if dump.Accounts == nil {
+		if err := json.Unmarshal(data, &dump.Accounts); err != nil {
+			return nil, fmt.Errorf("invalid state dump: %w", err)
+		}
+	}
+
+	alloc := types.GenesisAlloc{}
+	for key, account := range dump.Accounts {
+		address := account.Address
+		if address == nil {
+			if !common.IsHexAddress(key) {
+				return nil, fmt.Errorf("invalid account %s, the dump may lack address preimages", key)
+			}
+			parsed := common.HexToAddress(key)
+			address = &parsed
+		}
+
+		genesisAccount := types.Account{Balance: new(big.Int)}
+		if account.Balance.Int != nil {
+			genesisAccount.Balance = account.Balance.Int
+		}
+		if account.Nonce.Int != nil {
+			if !account.Nonce.IsUint64() {
+				return nil, fmt.Errorf("invalid nonce of account %s", address.Hex())
+			}
+			genesisAccount.Nonce = account.Nonce.Uint64()
+		}
+		if account.Code != "" && account.Code != "0x" {
+			code, err := ParseBytecode(account.Code)
+			if err != nil {
+				return nil, fmt.Errorf("invalid code of account %s: %w", address.Hex(), err)
+			}
+			genesisAccount.Code = code
+		}
+		if len(account.Storage) > 0 {
+			genesisAccount.Storage = map[common.Hash]common.Hash{}
+			for slot, value := range account.Storage {
+				if value := common.HexToHash(value); value != (common.Hash{}) {
+					genesisAccount.Storage[common.HexToHash(slot)] = value
+				}
+			}
+		}
+		alloc[*address] = genesisAccount
+	}
+	return alloc, nil
+}
+
+// decompressState unwraps the hex string returned by anvil_dumpState and gunzips the dump when compressed.
+func decompressState(data []byte) ([]byte, error) {
+	if len(data) > 0 && data[0] == '"' {
+		unquoted, err := strconv.Unquote(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("invalid state dump: %w", err)
+		}
+		if data, err = ParseBytecode(unquoted); err != nil {
+			return nil, fmt.Errorf("invalid state dump: %w", err)
+		}
+	}
+	if !bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+		return data, nil
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
@@ -0,0 +1,89 @@
+package utils_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/OCharless/eth-interfaces/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	dumpedERC20  = common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	dumpedERC721 = common.HexToAddress("0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512")
+	dumpedOwner  = common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+)
+
+// Test_ParseStateDump verifies the accounts read from the supported state dump formats.
+func Test_ParseStateDump(t *testing.T) {
+	anvilState, err := os.ReadFile("testdata/anvil_state.json")
+	assert.Nil(t, err)
+	compressed := &bytes.Buffer{}
+	writer := gzip.NewWriter(compressed)
+	_, err = writer.Write(anvilState)
+	assert.Nil(t, err)
+	assert.Nil(t, writer.Close())
+
+	t.Run("OK - debug_dumpBlock", func(t *testing.T) {
+		alloc, err := utils.LoadStateDump("testdata/debug_dumpBlock.json")
+		assert.Nil(t, err)
+		assert.Len(t, alloc, 3)
+		assert.Equal(t, uint64(4), alloc[dumpedOwner].Nonce)
+		assert.Equal(t, "99995922200548271950", alloc[dumpedOwner].Balance.String())
+		assert.NotEmpty(t, alloc[dumpedERC20].Code)
+		// totalSupply, slot 2, holds the 100,000,000 tokens minted to the deployer.
+		supply := new(big.Int).Mul(big.NewInt(100_000_000), big.NewInt(1e18))
+		assert.Equal(t, common.BigToHash(supply), alloc[dumpedERC20].Storage[common.BigToHash(big.NewInt(2))])
+	})
+
+	testCases := []struct {
+		Name        string
+		Data        []byte
+		ExpectError bool
+	}{
+		{Name: "OK - anvil --dump-state", Data: anvilState},
+		{Name: "OK - Gzipped", Data: compressed.Bytes()},
+		{Name: "OK - anvil_dumpState", Data: []byte(`"0x` + common.Bytes2Hex(compressed.Bytes()) + `"`)},
+		{Name: "KO - Missing preimage", Data: []byte(`{"accounts": {"pre(0x01)": {"balance": "1"}}}`), ExpectError: true},
+		{Name: "KO - Invalid balance", Data: []byte(`{"accounts": {"0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512": {"balance": "ten"}}}`), ExpectError: true},
+		{Name: "KO - Not JSON", Data: []byte(`accounts`), ExpectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			alloc, err := utils.ParseStateDump(tc.Data)
+			if tc.ExpectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Len(t, alloc, 1)
+			assert.Equal(t, uint64(1), alloc[dumpedERC721].Nonce)
+			assert.Equal(t, "0", alloc[dumpedERC721].Balance.String())
+			assert.Equal(t, common.BigToHash(big.NewInt(30)), alloc[dumpedERC721].Storage[common.BigToHash(big.NewInt(2))])
+		})
+	}
+
+	t.Run("OK - Genesis alloc", func(t *testing.T) {
+		alloc, err := utils.ParseStateDump([]byte(`{"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266": {"balance": "0x3e8", "storage": {"0x01": "0x00"}}}`))
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(1000), alloc[dumpedOwner].Balance)
+		assert.Empty(t, alloc[dumpedOwner].Storage)
+	})
+
+	t.Run("OK - Decimal quantities with a leading zero", func(t *testing.T) {
+		alloc, err := utils.ParseStateDump([]byte(`{"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266": {"balance": "010", "nonce": "0x10"}}`))
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(10), alloc[dumpedOwner].Balance)
+		assert.Equal(t, uint64(16), alloc[dumpedOwner].Nonce)
+	})
+
+	t.Run("KO - Binary quantity", func(t *testing.T) {
+		_, err := utils.ParseStateDump([]byte(`{"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266": {"balance": "0b1"}}`))
+		assert.Error(t, err)
+	})
+}